@@ -0,0 +1,90 @@
+package api
+
+import (
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/lib/expr"
+)
+
+// CacheFuncHashFiles returns the hash of files matched by glob patterns in the working directory,
+// e.g. `hashFiles('go.sum')`.
+const CacheFuncHashFiles = "hashFiles"
+
+// CacheKeyTemplate is a parsed key of cache. Expressions in `${...}` are replaced with their results.
+type CacheKeyTemplate struct {
+	// literals and expressions are interleaved, literals has one more element than expressions.
+	literals    []string
+	expressions []*expr.Expression
+}
+
+// ParseCacheKey parses the key or a restore key of cache. It checks unknown functions and variables.
+func ParseCacheKey(key string) (*CacheKeyTemplate, error) {
+	t := &CacheKeyTemplate{}
+	rest := key
+	for {
+		start := strings.Index(rest, "${")
+		if start < 0 {
+			t.literals = append(t.literals, rest)
+			return t, nil
+		}
+		end := closingBrace(rest, start+2)
+		if end < 0 {
+			return nil, errors.Errorf("unclosed expression in cache key '%s'", key)
+		}
+		e, err := expr.Parse(rest[start+2 : end])
+		if err != nil {
+			return nil, errors.WithMessagef(err, "invalid expression in cache key '%s'", key)
+		}
+		builtin := expr.BuiltinFunctions()
+		for _, fn := range e.Functions() {
+			if _, ok := builtin[fn]; !ok && fn != CacheFuncHashFiles {
+				return nil, errors.Errorf("unknown function '%s' in cache key '%s'", fn, key)
+			}
+		}
+		if variables := e.Variables(); len(variables) > 0 {
+			return nil, errors.Errorf("unknown variable '%s' in cache key '%s'", variables[0][0], key)
+		}
+		t.literals = append(t.literals, rest[:start])
+		t.expressions = append(t.expressions, e)
+		rest = rest[end+1:]
+	}
+}
+
+// closingBrace returns the index of `}` closes the expression starts at start, braces in strings are skipped.
+func closingBrace(s string, start int) int {
+	var quote byte
+	for i := start; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '}':
+			return i
+		}
+	}
+	return -1
+}
+
+// Render evaluates expressions with functions, and returns the key.
+func (t *CacheKeyTemplate) Render(functions map[string]expr.Function) (string, error) {
+	ctx := &expr.Context{Functions: expr.BuiltinFunctions()}
+	for name, fn := range functions {
+		ctx.Functions[name] = fn
+	}
+	b := &strings.Builder{}
+	for i, e := range t.expressions {
+		b.WriteString(t.literals[i])
+		v, err := e.Evaluate(ctx)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(expr.ToString(v))
+	}
+	b.WriteString(t.literals[len(t.literals)-1])
+	return b.String(), nil
+}
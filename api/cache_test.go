@@ -0,0 +1,45 @@
+package api
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cox96de/runner/lib/expr"
+	"gotest.tools/v3/assert"
+)
+
+func TestParseCacheKey(t *testing.T) {
+	functions := map[string]expr.Function{
+		CacheFuncHashFiles: func(args ...interface{}) (interface{}, error) {
+			patterns := make([]string, 0, len(args))
+			for _, arg := range args {
+				patterns = append(patterns, expr.ToString(arg))
+			}
+			return "hash(" + strings.Join(patterns, ",") + ")", nil
+		},
+	}
+	for _, tc := range []struct {
+		key      string
+		expected string
+	}{
+		{key: "go", expected: "go"},
+		{key: `go-${hashFiles("go.sum")}`, expected: "go-hash(go.sum)"},
+		{key: `${hashFiles('a}', "b")}-${format('{0}', 'x')}-end`, expected: "hash(a},b)-x-end"},
+	} {
+		t.Run(tc.key, func(t *testing.T) {
+			template, err := ParseCacheKey(tc.key)
+			assert.NilError(t, err)
+			key, err := template.Render(functions)
+			assert.NilError(t, err)
+			assert.Equal(t, key, tc.expected)
+		})
+	}
+	t.Run("unclosed", func(t *testing.T) {
+		_, err := ParseCacheKey(`go-${hashFiles("go.sum")`)
+		assert.ErrorContains(t, err, "unclosed expression")
+	})
+	t.Run("unknown_variable", func(t *testing.T) {
+		_, err := ParseCacheKey(`go-${env.GOOS}`)
+		assert.ErrorContains(t, err, "unknown variable 'env'")
+	})
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/lib/expr"
+	"github.com/samber/lo"
+)
+
+// Functions and variables available in the `if` condition of a step.
+const (
+	// ConditionFuncSuccess returns true if none of the previous steps failed.
+	ConditionFuncSuccess = "success"
+	// ConditionFuncFailure returns true if any of the previous steps failed.
+	ConditionFuncFailure = "failure"
+	// ConditionFuncAlways always returns true.
+	ConditionFuncAlways = "always"
+
+	// ConditionVarEnv is the environment variables of the step, e.g. `env.BRANCH`.
+	ConditionVarEnv = "env"
+	// ConditionVarSteps is the result of previous steps, e.g. `steps.build.status` or `steps.build.exit_code`.
+	ConditionVarSteps = "steps"
+)
+
+// ParseStepCondition parses the `if` condition of a step. It checks unknown functions and variables.
+func ParseStepCondition(condition string) (*expr.Expression, error) {
+	e, err := expr.Parse(condition)
+	if err != nil {
+		return nil, err
+	}
+	builtin := expr.BuiltinFunctions()
+	for _, fn := range e.Functions() {
+		if _, ok := builtin[fn]; ok {
+			continue
+		}
+		if !IsStatusConditionFunction(fn) {
+			return nil, errors.Errorf("unknown function '%s' in condition '%s'", fn, condition)
+		}
+	}
+	for _, variable := range e.Variables() {
+		if variable[0] != ConditionVarEnv && variable[0] != ConditionVarSteps {
+			return nil, errors.Errorf("unknown variable '%s' in condition '%s'", variable[0], condition)
+		}
+	}
+	return e, nil
+}
+
+// IsStatusConditionFunction returns true if fn is a status check function, such as `success()`.
+// If a condition contains no status check function, `success() && ` is implied.
+func IsStatusConditionFunction(fn string) bool {
+	return lo.Contains([]string{ConditionFuncSuccess, ConditionFuncFailure, ConditionFuncAlways}, fn)
+}
@@ -0,0 +1,58 @@
+package httpserverclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cox96de/runner/api"
+	"google.golang.org/grpc"
+)
+
+func (c *Client) RegisterAgent(ctx context.Context, in *api.RegisterAgentRequest, opts ...grpc.CallOption) (*api.RegisterAgentResponse, error) {
+	u := c.u.JoinPath("/api/v1/agents")
+	resp := &api.RegisterAgentResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodPost, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) AgentHeartbeat(ctx context.Context, in *api.AgentHeartbeatRequest, opts ...grpc.CallOption) (*api.AgentHeartbeatResponse, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/agents/%d/heartbeat", in.AgentID))
+	resp := &api.AgentHeartbeatResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodPost, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) ListAgents(ctx context.Context, in *api.ListAgentsRequest, opts ...grpc.CallOption) (*api.ListAgentsResponse, error) {
+	u := c.u.JoinPath("/api/v1/agents")
+	query := u.Query()
+	if in.Label != "" {
+		query.Add("label", in.Label)
+	}
+	if in.Status != nil {
+		query.Add("status", fmt.Sprintf("%d", *in.Status))
+	}
+	u.RawQuery = query.Encode()
+	resp := &api.ListAgentsResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodGet, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) GetAgent(ctx context.Context, in *api.GetAgentRequest, opts ...grpc.CallOption) (*api.GetAgentResponse, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/agents/%d", in.AgentID))
+	resp := &api.GetAgentResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodGet, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
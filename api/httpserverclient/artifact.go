@@ -0,0 +1,65 @@
+package httpserverclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cox96de/runner/api"
+	"google.golang.org/grpc"
+)
+
+// UploadArtifact uploads an artifact as the request body. The request is sent when the first message is sent.
+func (c *Client) UploadArtifact(ctx context.Context, opts ...grpc.CallOption) (api.Server_UploadArtifactClient, error) {
+	return &uploadArtifactStream{uploadStream: uploadStream{clientStream: clientStream{ctx: ctx}, client: c}}, nil
+}
+
+// uploadArtifactStream implements api.Server_UploadArtifactClient by streaming data as the request body.
+type uploadArtifactStream struct {
+	uploadStream
+	response *api.UploadArtifactResponse
+}
+
+func (s *uploadArtifactStream) Send(request *api.UploadArtifactRequest) error {
+	if !s.started() {
+		u := s.client.u.JoinPath(fmt.Sprintf("/api/v1/job_executions/%d/artifacts", request.JobExecutionID))
+		query := u.Query()
+		query.Add("name", request.Name)
+		query.Add("retention_days", fmt.Sprintf("%d", request.RetentionDays))
+		u.RawQuery = query.Encode()
+		s.response = &api.UploadArtifactResponse{}
+		s.start(u.String(), s.response)
+	}
+	return s.write(request.Data)
+}
+
+// CloseAndRecv finishes the request body and waits for the response.
+func (s *uploadArtifactStream) CloseAndRecv() (*api.UploadArtifactResponse, error) {
+	if err := s.closeAndWait(); err != nil {
+		return nil, err
+	}
+	return s.response, nil
+}
+
+// DownloadArtifact downloads the content of an artifact from the response body.
+func (c *Client) DownloadArtifact(ctx context.Context, in *api.DownloadArtifactRequest, opts ...grpc.CallOption) (api.Server_DownloadArtifactClient, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/artifacts/%d/download", in.ArtifactID))
+	stream, err := c.download(ctx, u.String())
+	if err != nil {
+		return nil, err
+	}
+	return &downloadArtifactStream{downloadStream: stream}, nil
+}
+
+// downloadArtifactStream implements api.Server_DownloadArtifactClient by reading the response body.
+type downloadArtifactStream struct {
+	*downloadStream
+}
+
+// Recv returns the next chunk of the artifact. It returns io.EOF when the content is ended.
+func (s *downloadArtifactStream) Recv() (*api.DownloadArtifactResponse, error) {
+	data, err := s.recv()
+	if err != nil {
+		return nil, err
+	}
+	return &api.DownloadArtifactResponse{Data: data}, nil
+}
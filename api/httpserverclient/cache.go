@@ -0,0 +1,117 @@
+package httpserverclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cox96de/runner/api"
+	"google.golang.org/grpc"
+)
+
+// UploadCache uploads a cache as the request body. The request is sent when the first message is sent.
+func (c *Client) UploadCache(ctx context.Context, opts ...grpc.CallOption) (api.Server_UploadCacheClient, error) {
+	return &uploadCacheStream{uploadStream: uploadStream{clientStream: clientStream{ctx: ctx}, client: c}}, nil
+}
+
+// uploadCacheStream implements api.Server_UploadCacheClient by streaming data as the request body.
+type uploadCacheStream struct {
+	uploadStream
+	response *api.UploadCacheResponse
+}
+
+func (s *uploadCacheStream) Send(request *api.UploadCacheRequest) error {
+	if !s.started() {
+		u := s.client.u.JoinPath(fmt.Sprintf("/api/v1/job_executions/%d/caches", request.JobExecutionID))
+		query := u.Query()
+		query.Add("key", request.Key)
+		u.RawQuery = query.Encode()
+		s.response = &api.UploadCacheResponse{}
+		s.start(u.String(), s.response)
+	}
+	return s.write(request.Data)
+}
+
+// CloseAndRecv finishes the request body and waits for the response.
+func (s *uploadCacheStream) CloseAndRecv() (*api.UploadCacheResponse, error) {
+	if err := s.closeAndWait(); err != nil {
+		return nil, err
+	}
+	return s.response, nil
+}
+
+// DownloadCache downloads the content of a cache from the response body.
+func (c *Client) DownloadCache(ctx context.Context, in *api.DownloadCacheRequest, opts ...grpc.CallOption) (api.Server_DownloadCacheClient, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/caches/%d/download", in.CacheID))
+	stream, err := c.download(ctx, u.String())
+	if err != nil {
+		return nil, err
+	}
+	return &downloadCacheStream{downloadStream: stream}, nil
+}
+
+// downloadCacheStream implements api.Server_DownloadCacheClient by reading the response body.
+type downloadCacheStream struct {
+	*downloadStream
+}
+
+// Recv returns the next chunk of the cache. It returns io.EOF when the content is ended.
+func (s *downloadCacheStream) Recv() (*api.DownloadCacheResponse, error) {
+	data, err := s.recv()
+	if err != nil {
+		return nil, err
+	}
+	return &api.DownloadCacheResponse{Data: data}, nil
+}
+
+func (c *Client) GetCache(ctx context.Context, in *api.GetCacheRequest, opts ...grpc.CallOption) (*api.GetCacheResponse, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/job_executions/%d/cache", in.JobExecutionID))
+	query := u.Query()
+	query.Add("key", in.Key)
+	for _, key := range in.RestoreKeys {
+		query.Add("restore_keys", key)
+	}
+	u.RawQuery = query.Encode()
+	resp := &api.GetCacheResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodGet, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) ListCaches(ctx context.Context, in *api.ListCachesRequest, opts ...grpc.CallOption) (*api.ListCachesResponse, error) {
+	u := c.u.JoinPath("/api/v1/caches")
+	query := u.Query()
+	query.Add("label", in.Label)
+	if in.KeyPrefix != "" {
+		query.Add("key_prefix", in.KeyPrefix)
+	}
+	u.RawQuery = query.Encode()
+	resp := &api.ListCachesResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodGet, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) DeleteCache(ctx context.Context, in *api.DeleteCacheRequest, opts ...grpc.CallOption) (*api.DeleteCacheResponse, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/caches/%d", in.CacheID))
+	resp := &api.DeleteCacheResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodDelete, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) SetCacheLimit(ctx context.Context, in *api.SetCacheLimitRequest, opts ...grpc.CallOption) (*api.SetCacheLimitResponse, error) {
+	u := c.u.JoinPath("/api/v1/cache_limits")
+	resp := &api.SetCacheLimitResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodPut, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
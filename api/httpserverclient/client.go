@@ -12,6 +12,8 @@ import (
 	"github.com/cox96de/runner/lib"
 	jsoniter "github.com/json-iterator/go"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cockroachdb/errors"
 )
@@ -76,6 +78,16 @@ func (c *Client) RerunJob(ctx context.Context, in *api.RerunJobRequest, opts ...
 	return resp, nil
 }
 
+func (c *Client) RerunPipeline(ctx context.Context, in *api.RerunPipelineRequest, opts ...grpc.CallOption) (*api.RerunPipelineResponse, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/pipelines/%d/rerun", in.PipelineID))
+	resp := &api.RerunPipelineResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodPost, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *Client) GetStepExecution(ctx context.Context, in *api.GetStepExecutionRequest, opts ...grpc.CallOption) (*api.GetStepExecutionResponse, error) {
 	u := c.u.JoinPath(fmt.Sprintf("/api/v1/step_executions/%d", in.StepExecutionID))
 	resp := &api.GetStepExecutionResponse{}
@@ -96,6 +108,16 @@ func (c *Client) UpdateStepExecution(ctx context.Context, in *api.UpdateStepExec
 	return resp, nil
 }
 
+func (c *Client) RetryStepExecution(ctx context.Context, in *api.RetryStepExecutionRequest, opts ...grpc.CallOption) (*api.RetryStepExecutionResponse, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/step_executions/%d/retry", in.StepExecutionID))
+	resp := &api.RetryStepExecutionResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodPost, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *Client) UploadLogLines(ctx context.Context, in *api.UpdateLogLinesRequest, opts ...grpc.CallOption) (*api.UpdateLogLinesResponse, error) {
 	u := c.u.JoinPath(fmt.Sprintf("/api/v1/job_executions/%d/logs", in.JobExecutionID))
 	resp := &api.UpdateLogLinesResponse{}
@@ -156,6 +178,43 @@ func (c *Client) CreatePipeline(ctx context.Context, in *api.CreatePipelineReque
 	return resp, nil
 }
 
+func (c *Client) GetPipeline(ctx context.Context, in *api.GetPipelineRequest, opts ...grpc.CallOption) (*api.GetPipelineResponse, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/pipelines/%d", in.PipelineID))
+	resp := &api.GetPipelineResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodGet, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) ListPipelines(ctx context.Context, in *api.ListPipelinesRequest, opts ...grpc.CallOption) (*api.ListPipelinesResponse, error) {
+	u := c.u.JoinPath("/api/v1/pipelines")
+	query := u.Query()
+	if in.Status != nil {
+		query.Add("status", fmt.Sprintf("%d", *in.Status))
+	}
+	if in.CreatedAfter != nil {
+		query.Add("created_after", fmt.Sprintf("%d", *in.CreatedAfter))
+	}
+	if in.CreatedBefore != nil {
+		query.Add("created_before", fmt.Sprintf("%d", *in.CreatedBefore))
+	}
+	if in.Cursor > 0 {
+		query.Add("cursor", fmt.Sprintf("%d", in.Cursor))
+	}
+	if in.Limit > 0 {
+		query.Add("limit", fmt.Sprintf("%d", in.Limit))
+	}
+	u.RawQuery = query.Encode()
+	resp := &api.ListPipelinesResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodGet, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *Client) RequestJob(ctx context.Context, in *api.RequestJobRequest, opts ...grpc.CallOption) (*api.RequestJobResponse, error) {
 	u := c.u.JoinPath("/api/v1/jobs/request")
 	resp := &api.RequestJobResponse{}
@@ -176,6 +235,24 @@ func (c *Client) Heartbeat(ctx context.Context, in *api.HeartbeatRequest, opts .
 	return resp, nil
 }
 
+func (c *Client) ListArtifacts(ctx context.Context, in *api.ListArtifactsRequest, opts ...grpc.CallOption) (*api.ListArtifactsResponse, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/pipelines/%d/artifacts", in.PipelineID))
+	query := u.Query()
+	if in.JobName != "" {
+		query.Add("job_name", in.JobName)
+	}
+	if in.Name != "" {
+		query.Add("name", in.Name)
+	}
+	u.RawQuery = query.Encode()
+	resp := &api.ListArtifactsResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodGet, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func NewClient(client *http.Client, baseURL string) (*Client, error) {
 	u, err := url.Parse(baseURL)
 	if err != nil {
@@ -206,7 +283,7 @@ func (c *Client) doRequest(ctx context.Context, path string, method string, in a
 		return nil
 	}
 	if response.StatusCode != http.StatusOK {
-		return errors.Errorf("failed to do request, got status code: %d", response.StatusCode)
+		return &StatusError{Code: response.StatusCode}
 	}
 	content, err := io.ReadAll(response.Body)
 	if err != nil {
@@ -218,3 +295,26 @@ func (c *Client) doRequest(ctx context.Context, path string, method string, in a
 	}
 	return nil
 }
+
+// StatusError is returned if the server responds with an unexpected status code. It's converted to a grpc status,
+// so that callers of api.ServerClient check errors in the same way as a grpc client.
+type StatusError struct {
+	Code int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("failed to do request, got status code: %d", e.Code)
+}
+
+func (e *StatusError) GRPCStatus() *status.Status {
+	code := codes.Unknown
+	switch e.Code {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusConflict:
+		code = codes.AlreadyExists
+	}
+	return status.New(code, e.Error())
+}
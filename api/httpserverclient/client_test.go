@@ -1,10 +1,14 @@
 package httpserverclient
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/cox96de/runner/app/server/eventhook"
 
@@ -14,11 +18,18 @@ import (
 
 	"github.com/cox96de/runner/app/server/logstorage"
 	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/app/server/artifact"
+	"github.com/cox96de/runner/app/server/cache"
 	"github.com/cox96de/runner/app/server/dispatch"
 	"github.com/cox96de/runner/app/server/handler"
+	"github.com/cox96de/runner/app/server/notify"
 	"github.com/cox96de/runner/app/server/pipeline"
+	"github.com/cox96de/runner/app/server/schedule"
+	"github.com/cox96de/runner/app/server/secret"
 	"github.com/cox96de/runner/mock"
 	"github.com/gin-gonic/gin"
 	"gotest.tools/v3/assert"
@@ -28,11 +39,17 @@ func TestNewClient(t *testing.T) {
 	dbClient := mock.NewMockDB(t)
 	eventHook := eventhook.NewService(eventhook.NewNopSender())
 	pipelineService := pipeline.NewService(dbClient)
-	dispatchService := dispatch.NewService(dbClient, eventHook)
+	dispatchService := dispatch.NewService(dbClient, eventHook, notify.NewLocalNotifier())
 	locker := mock.NewMockLocker()
 	redis := mock.NewMockRedis(t)
-	h := handler.NewHandler(dbClient, pipelineService, dispatchService, locker, logstorage.NewService(redis,
-		logstorage.NewFilesystemOSS(fs.NewDir(t, "baseDir").Path())), eventHook)
+	oss := logstorage.NewFilesystemOSS(fs.NewDir(t, "baseDir").Path())
+	secretCipher, err := secret.NewCipher(bytes.Repeat([]byte("k"), 32))
+	assert.NilError(t, err)
+	h := handler.NewHandler(dbClient, pipelineService, dispatchService, locker, logstorage.NewService(redis, oss),
+		eventHook, artifact.NewService(dbClient, oss),
+		cache.NewService(dbClient, cache.NewFilesystemStorage(fs.NewDir(t, "cache").Path()), 0),
+		secret.NewService(dbClient, secretCipher),
+		schedule.NewService(dbClient, pipelineService, dispatchService, locker))
 	engine := gin.New()
 	h.RegisterRouter(engine.Group(""))
 	server := httptest.NewServer(engine)
@@ -60,6 +77,96 @@ func TestNewClient(t *testing.T) {
 	})
 	assert.NilError(t, err)
 	assert.Assert(t, len(createPipelineResponse.Pipeline.Jobs) == 1)
+	t.Run("GetPipeline", func(t *testing.T) {
+		getPipelineResponse, err := client.GetPipeline(ctx, &api.GetPipelineRequest{
+			PipelineID: createPipelineResponse.Pipeline.ID,
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, getPipelineResponse.Pipeline.ID, createPipelineResponse.Pipeline.ID)
+		assert.Equal(t, getPipelineResponse.Pipeline.Execution.Status, api.StatusQueued)
+		assert.Equal(t, len(getPipelineResponse.Pipeline.Jobs), 1)
+	})
+	t.Run("ListPipelines", func(t *testing.T) {
+		listPipelinesResponse, err := client.ListPipelines(ctx, &api.ListPipelinesRequest{
+			Status: lo.ToPtr(api.StatusQueued),
+			Limit:  10,
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, len(listPipelinesResponse.Pipelines), 1)
+		assert.Equal(t, listPipelinesResponse.Pipelines[0].ID, createPipelineResponse.Pipeline.ID)
+		listPipelinesResponse, err = client.ListPipelines(ctx, &api.ListPipelinesRequest{
+			Status: lo.ToPtr(api.StatusFailed),
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, len(listPipelinesResponse.Pipelines), 0)
+	})
+	t.Run("Agent", func(t *testing.T) {
+		registerAgentResponse, err := client.RegisterAgent(ctx, &api.RegisterAgentRequest{
+			Name:        "agent1",
+			Hostname:    "host1",
+			Labels:      []string{"agent"},
+			Concurrency: 1,
+		})
+		assert.NilError(t, err)
+		agentID := registerAgentResponse.Agent.ID
+		_, err = client.AgentHeartbeat(ctx, &api.AgentHeartbeatRequest{
+			AgentID:                agentID,
+			RunningJobExecutionIDs: []int64{1},
+		})
+		assert.NilError(t, err)
+		listAgentsResponse, err := client.ListAgents(ctx, &api.ListAgentsRequest{
+			Label:  "agent",
+			Status: lo.ToPtr(api.AgentStatusBusy),
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, len(listAgentsResponse.Agents), 1)
+		listAgentsResponse, err = client.ListAgents(ctx, &api.ListAgentsRequest{
+			Status: lo.ToPtr(api.AgentStatusIdle),
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, len(listAgentsResponse.Agents), 0)
+		getAgentResponse, err := client.GetAgent(ctx, &api.GetAgentRequest{AgentID: agentID})
+		assert.NilError(t, err)
+		assert.Equal(t, getAgentResponse.Agent.Hostname, "host1")
+		assert.DeepEqual(t, getAgentResponse.Agent.RunningJobExecutionIDs, []int64{1})
+		_, err = client.AgentHeartbeat(ctx, &api.AgentHeartbeatRequest{AgentID: agentID + 1})
+		assert.Equal(t, status.Code(err), codes.NotFound)
+	})
+	t.Run("Schedule", func(t *testing.T) {
+		createScheduleResponse, err := client.CreateSchedule(ctx, &api.CreateScheduleRequest{
+			Name:     "nightly",
+			Cron:     "0 2 * * *",
+			Timezone: "Asia/Shanghai",
+			Pipeline: &api.PipelineDSL{Jobs: []*api.JobDSL{{
+				Name:   "job1",
+				RunsOn: &api.RunsOn{Label: "nightly"},
+				Steps:  []*api.StepDSL{{Name: "step1", Commands: []string{"echo hello"}}},
+			}}},
+			CatchUpPolicy: api.CatchUpPolicyLatest,
+		})
+		assert.NilError(t, err)
+		scheduleID := createScheduleResponse.Schedule.ID
+		assert.Assert(t, createScheduleResponse.Schedule.Enabled)
+		assert.Equal(t, createScheduleResponse.Schedule.CatchUpPolicy, api.CatchUpPolicyLatest)
+		_, err = client.CreateSchedule(ctx, &api.CreateScheduleRequest{Name: "invalid", Cron: "* *"})
+		assert.ErrorContains(t, err, "400")
+		updateScheduleResponse, err := client.UpdateSchedule(ctx, &api.UpdateScheduleRequest{
+			ScheduleID: scheduleID,
+			Enabled:    lo.ToPtr(false),
+		})
+		assert.NilError(t, err)
+		assert.Assert(t, !updateScheduleResponse.Schedule.Enabled)
+		getScheduleResponse, err := client.GetSchedule(ctx, &api.GetScheduleRequest{ScheduleID: scheduleID})
+		assert.NilError(t, err)
+		assert.Equal(t, getScheduleResponse.Schedule.Pipeline.Jobs[0].Name, "job1")
+		listSchedulesResponse, err := client.ListSchedules(ctx, &api.ListSchedulesRequest{})
+		assert.NilError(t, err)
+		assert.Equal(t, len(listSchedulesResponse.Schedules), 1)
+		_, err = client.DeleteSchedule(ctx, &api.DeleteScheduleRequest{ScheduleID: scheduleID})
+		assert.NilError(t, err)
+		_, err = client.GetSchedule(ctx, &api.GetScheduleRequest{ScheduleID: scheduleID})
+		assert.ErrorContains(t, err, "404")
+	})
 	t.Run("RequestJob", func(t *testing.T) {
 		requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{
 			Label: label,
@@ -72,6 +179,14 @@ func TestNewClient(t *testing.T) {
 		})
 		assert.NilError(t, err)
 		assert.Assert(t, requestJobResponse.Job == nil)
+		assert.Assert(t, !requestJobResponse.LongPolling)
+		requestJobResponse, err = client.RequestJob(ctx, &api.RequestJobRequest{
+			Label:       label,
+			WaitTimeout: 1,
+		})
+		assert.NilError(t, err)
+		assert.Assert(t, requestJobResponse.Job == nil)
+		assert.Assert(t, requestJobResponse.LongPolling)
 		t.Run("UpdateJobExecution", func(t *testing.T) {
 			updateJobExecutionResponse, err := client.UpdateJobExecution(ctx, &api.UpdateJobExecutionRequest{
 				JobExecutionID: requestedJob.Execution.ID,
@@ -86,6 +201,85 @@ func TestNewClient(t *testing.T) {
 			})
 			assert.NilError(t, err)
 		})
+		t.Run("Debug", func(t *testing.T) {
+			// The agent serves the session announced by heartbeat, it echoes the input.
+			agentErr := make(chan error, 1)
+			go func() {
+				agentErr <- func() error {
+					var sessions []string
+					for len(sessions) == 0 {
+						heartbeatResponse, err := client.Heartbeat(ctx, &api.HeartbeatRequest{
+							JobExecutionID: requestedJob.Execution.ID,
+						})
+						if err != nil {
+							return err
+						}
+						sessions = heartbeatResponse.DebugSessions
+						time.Sleep(time.Millisecond * 10)
+					}
+					stream, err := client.ServeDebug(ctx)
+					if err != nil {
+						return err
+					}
+					if err = stream.Send(&api.ServeDebugRequest{SessionID: sessions[0]}); err != nil {
+						return err
+					}
+					first, err := stream.Recv()
+					if err != nil {
+						return err
+					}
+					if err = stream.Send(&api.ServeDebugRequest{Response: &api.DebugResponse{
+						Output: []byte(strings.Join(first.Start.Commands, " ") + "\n"),
+					}}); err != nil {
+						return err
+					}
+					input, err := stream.Recv()
+					if err != nil {
+						return err
+					}
+					if err = stream.Send(&api.ServeDebugRequest{Response: &api.DebugResponse{Output: input.Stdin}}); err != nil {
+						return err
+					}
+					if err = stream.Send(&api.ServeDebugRequest{Response: &api.DebugResponse{
+						Exited:   true,
+						ExitCode: 1,
+					}}); err != nil {
+						return err
+					}
+					return stream.CloseSend()
+				}()
+			}()
+			stream, err := client.Debug(ctx)
+			assert.NilError(t, err)
+			assert.NilError(t, stream.Send(&api.DebugRequest{
+				JobExecutionID: requestedJob.Execution.ID,
+				Start:          &api.DebugStart{Commands: []string{"sh", "-l"}},
+			}))
+			response, err := stream.Recv()
+			assert.NilError(t, err)
+			assert.Equal(t, string(response.Output), "sh -l\n")
+			assert.NilError(t, stream.Send(&api.DebugRequest{Stdin: []byte("ls\n")}))
+			response, err = stream.Recv()
+			assert.NilError(t, err)
+			assert.Equal(t, string(response.Output), "ls\n")
+			response, err = stream.Recv()
+			assert.NilError(t, err)
+			assert.Assert(t, response.Exited)
+			assert.Equal(t, response.ExitCode, int32(1))
+			_, err = stream.Recv()
+			assert.Equal(t, err, io.EOF)
+			assert.NilError(t, <-agentErr)
+			t.Run("not_found", func(t *testing.T) {
+				stream, err := client.Debug(ctx)
+				assert.NilError(t, err)
+				assert.NilError(t, stream.Send(&api.DebugRequest{
+					JobExecutionID: 10000,
+					Start:          &api.DebugStart{},
+				}))
+				_, err = stream.Recv()
+				assert.ErrorContains(t, err, "failed to get job execution")
+			})
+		})
 		t.Run("UploadLogLines", func(t *testing.T) {
 			logLines := []*api.LogLine{{
 				Timestamp: 0,
@@ -110,6 +304,136 @@ func TestNewClient(t *testing.T) {
 				assert.Assert(t, getLogLinesResponse != nil)
 				assert.DeepEqual(t, getLogLinesResponse.Lines, logLines, cmpopts.IgnoreUnexported(api.LogLine{}))
 			})
+			t.Run("StreamLogLines", func(t *testing.T) {
+				ctx, cancel := context.WithCancel(ctx)
+				defer cancel()
+				stream, err := client.StreamLogLines(ctx, &api.StreamLogLinesRequest{
+					JobExecutionID: 1,
+					Name:           "step1",
+				})
+				assert.NilError(t, err)
+				streamLogLinesResponse, err := stream.Recv()
+				assert.NilError(t, err)
+				assert.DeepEqual(t, streamLogLinesResponse.Lines, logLines, cmpopts.IgnoreUnexported(api.LogLine{}))
+				assert.Equal(t, streamLogLinesResponse.Offset, int64(1))
+			})
+		})
+		t.Run("UploadArtifact", func(t *testing.T) {
+			stream, err := client.UploadArtifact(ctx)
+			assert.NilError(t, err)
+			assert.NilError(t, stream.Send(&api.UploadArtifactRequest{
+				JobExecutionID: requestedJob.Execution.ID,
+				Name:           "dist",
+				RetentionDays:  1,
+				Data:           []byte("hello "),
+			}))
+			assert.NilError(t, stream.Send(&api.UploadArtifactRequest{Data: []byte("world")}))
+			uploadArtifactResponse, err := stream.CloseAndRecv()
+			assert.NilError(t, err)
+			assert.Equal(t, uploadArtifactResponse.Artifact.Size, int64(len("hello world")))
+			assert.Assert(t, uploadArtifactResponse.Artifact.ExpireAt != nil)
+			t.Run("ListArtifacts", func(t *testing.T) {
+				listArtifactsResponse, err := client.ListArtifacts(ctx, &api.ListArtifactsRequest{
+					PipelineID: requestedJob.PipelineID,
+					JobName:    requestedJob.Name,
+					Name:       "dist",
+				})
+				assert.NilError(t, err)
+				assert.Equal(t, len(listArtifactsResponse.Artifacts), 1)
+				assert.Equal(t, listArtifactsResponse.Artifacts[0].ID, uploadArtifactResponse.Artifact.ID)
+			})
+			t.Run("DownloadArtifact", func(t *testing.T) {
+				stream, err := client.DownloadArtifact(ctx, &api.DownloadArtifactRequest{
+					ArtifactID: uploadArtifactResponse.Artifact.ID,
+				})
+				assert.NilError(t, err)
+				var content []byte
+				for {
+					downloadArtifactResponse, err := stream.Recv()
+					if err == io.EOF {
+						break
+					}
+					assert.NilError(t, err)
+					content = append(content, downloadArtifactResponse.Data...)
+				}
+				assert.Equal(t, string(content), "hello world")
+				_, err = client.DownloadArtifact(ctx, &api.DownloadArtifactRequest{ArtifactID: 1000})
+				assert.ErrorContains(t, err, "status code: 404")
+			})
+			t.Run("duplicated", func(t *testing.T) {
+				stream, err := client.UploadArtifact(ctx)
+				assert.NilError(t, err)
+				assert.NilError(t, stream.Send(&api.UploadArtifactRequest{
+					JobExecutionID: requestedJob.Execution.ID,
+					Name:           "dist",
+					Data:           []byte("hello"),
+				}))
+				_, err = stream.CloseAndRecv()
+				assert.ErrorContains(t, err, "already exists")
+			})
+		})
+		t.Run("UploadCache", func(t *testing.T) {
+			stream, err := client.UploadCache(ctx)
+			assert.NilError(t, err)
+			assert.NilError(t, stream.Send(&api.UploadCacheRequest{
+				JobExecutionID: requestedJob.Execution.ID,
+				Key:            "go-a",
+				Data:           []byte("hello "),
+			}))
+			assert.NilError(t, stream.Send(&api.UploadCacheRequest{Data: []byte("cache")}))
+			uploadCacheResponse, err := stream.CloseAndRecv()
+			assert.NilError(t, err)
+			assert.Equal(t, uploadCacheResponse.Cache.Label, label)
+			assert.Equal(t, uploadCacheResponse.Cache.Size, int64(len("hello cache")))
+			t.Run("GetCache", func(t *testing.T) {
+				getCacheResponse, err := client.GetCache(ctx, &api.GetCacheRequest{
+					JobExecutionID: requestedJob.Execution.ID,
+					Key:            "go-b",
+					RestoreKeys:    []string{"npm-", "go-"},
+				})
+				assert.NilError(t, err)
+				assert.Equal(t, getCacheResponse.Cache.ID, uploadCacheResponse.Cache.ID)
+				getCacheResponse, err = client.GetCache(ctx, &api.GetCacheRequest{
+					JobExecutionID: requestedJob.Execution.ID,
+					Key:            "go-b",
+				})
+				assert.NilError(t, err)
+				assert.Assert(t, getCacheResponse.Cache == nil)
+			})
+			t.Run("DownloadCache", func(t *testing.T) {
+				stream, err := client.DownloadCache(ctx, &api.DownloadCacheRequest{
+					CacheID: uploadCacheResponse.Cache.ID,
+				})
+				assert.NilError(t, err)
+				var content []byte
+				for {
+					downloadCacheResponse, err := stream.Recv()
+					if err == io.EOF {
+						break
+					}
+					assert.NilError(t, err)
+					content = append(content, downloadCacheResponse.Data...)
+				}
+				assert.Equal(t, string(content), "hello cache")
+			})
+			t.Run("SetCacheLimit", func(t *testing.T) {
+				_, err := client.SetCacheLimit(ctx, &api.SetCacheLimitRequest{Label: label, MaxSize: 100})
+				assert.NilError(t, err)
+				listCachesResponse, err := client.ListCaches(ctx, &api.ListCachesRequest{Label: label})
+				assert.NilError(t, err)
+				assert.Equal(t, len(listCachesResponse.Caches), 1)
+				assert.Equal(t, listCachesResponse.TotalSize, int64(len("hello cache")))
+				assert.Equal(t, listCachesResponse.MaxSize, int64(100))
+			})
+			t.Run("DeleteCache", func(t *testing.T) {
+				_, err := client.DeleteCache(ctx, &api.DeleteCacheRequest{CacheID: uploadCacheResponse.Cache.ID})
+				assert.NilError(t, err)
+				listCachesResponse, err := client.ListCaches(ctx, &api.ListCachesRequest{Label: label})
+				assert.NilError(t, err)
+				assert.Equal(t, len(listCachesResponse.Caches), 0)
+				_, err = client.DeleteCache(ctx, &api.DeleteCacheRequest{CacheID: uploadCacheResponse.Cache.ID})
+				assert.ErrorContains(t, err, "status code: 404")
+			})
 		})
 		t.Run("UpdateStepExecution", func(t *testing.T) {
 			execution, err := client.UpdateStepExecution(ctx, &api.UpdateStepExecutionRequest{
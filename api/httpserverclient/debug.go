@@ -0,0 +1,75 @@
+package httpserverclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cox96de/runner/api"
+	"google.golang.org/grpc"
+)
+
+// Debug runs a command in the runner of a job execution over a connection upgraded from http.
+// The connection is established by the first message.
+func (c *Client) Debug(ctx context.Context, opts ...grpc.CallOption) (api.Server_DebugClient, error) {
+	return &debugStream{upgradeStream: c.newUpgradeStream(clientStream{ctx: ctx})}, nil
+}
+
+// debugStream implements api.Server_DebugClient.
+type debugStream struct {
+	*upgradeStream
+}
+
+func (s *debugStream) Send(request *api.DebugRequest) error {
+	if !s.started() {
+		u := s.client.u.JoinPath(fmt.Sprintf("/api/v1/job_executions/%d/debug", request.JobExecutionID))
+		if err := s.connect(u.String()); err != nil {
+			return err
+		}
+	}
+	return s.send(request)
+}
+
+func (s *debugStream) Recv() (*api.DebugResponse, error) {
+	response := &api.DebugResponse{}
+	if err := s.recv(response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *debugStream) CloseSend() error {
+	return s.end()
+}
+
+// ServeDebug serves a debug session over a connection upgraded from http.
+// The connection is established by the first message.
+func (c *Client) ServeDebug(ctx context.Context, opts ...grpc.CallOption) (api.Server_ServeDebugClient, error) {
+	return &serveDebugStream{upgradeStream: c.newUpgradeStream(clientStream{ctx: ctx})}, nil
+}
+
+// serveDebugStream implements api.Server_ServeDebugClient.
+type serveDebugStream struct {
+	*upgradeStream
+}
+
+func (s *serveDebugStream) Send(request *api.ServeDebugRequest) error {
+	if !s.started() {
+		u := s.client.u.JoinPath(fmt.Sprintf("/api/v1/debug_sessions/%s/serve", request.SessionID))
+		if err := s.connect(u.String()); err != nil {
+			return err
+		}
+	}
+	return s.send(request)
+}
+
+func (s *serveDebugStream) Recv() (*api.DebugRequest, error) {
+	request := &api.DebugRequest{}
+	if err := s.recv(request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (s *serveDebugStream) CloseSend() error {
+	return s.end()
+}
@@ -0,0 +1,60 @@
+package httpserverclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cox96de/runner/api"
+	"google.golang.org/grpc"
+)
+
+func (c *Client) CreateSchedule(ctx context.Context, in *api.CreateScheduleRequest, opts ...grpc.CallOption) (*api.CreateScheduleResponse, error) {
+	u := c.u.JoinPath("/api/v1/schedules")
+	resp := &api.CreateScheduleResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodPost, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) UpdateSchedule(ctx context.Context, in *api.UpdateScheduleRequest, opts ...grpc.CallOption) (*api.UpdateScheduleResponse, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/schedules/%d", in.ScheduleID))
+	resp := &api.UpdateScheduleResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodPut, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) DeleteSchedule(ctx context.Context, in *api.DeleteScheduleRequest, opts ...grpc.CallOption) (*api.DeleteScheduleResponse, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/schedules/%d", in.ScheduleID))
+	resp := &api.DeleteScheduleResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodDelete, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) ListSchedules(ctx context.Context, in *api.ListSchedulesRequest, opts ...grpc.CallOption) (*api.ListSchedulesResponse, error) {
+	u := c.u.JoinPath("/api/v1/schedules")
+	resp := &api.ListSchedulesResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodGet, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) GetSchedule(ctx context.Context, in *api.GetScheduleRequest, opts ...grpc.CallOption) (*api.GetScheduleResponse, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/schedules/%d", in.ScheduleID))
+	resp := &api.GetScheduleResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodGet, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
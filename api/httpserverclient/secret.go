@@ -0,0 +1,68 @@
+package httpserverclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cox96de/runner/api"
+	"google.golang.org/grpc"
+)
+
+func (c *Client) CreateSecret(ctx context.Context, in *api.CreateSecretRequest, opts ...grpc.CallOption) (*api.CreateSecretResponse, error) {
+	u := c.u.JoinPath("/api/v1/secrets")
+	resp := &api.CreateSecretResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodPost, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) UpdateSecret(ctx context.Context, in *api.UpdateSecretRequest, opts ...grpc.CallOption) (*api.UpdateSecretResponse, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/secrets/%d", in.SecretID))
+	resp := &api.UpdateSecretResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodPut, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) DeleteSecret(ctx context.Context, in *api.DeleteSecretRequest, opts ...grpc.CallOption) (*api.DeleteSecretResponse, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/secrets/%d", in.SecretID))
+	resp := &api.DeleteSecretResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodDelete, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) ListSecrets(ctx context.Context, in *api.ListSecretsRequest, opts ...grpc.CallOption) (*api.ListSecretsResponse, error) {
+	u := c.u.JoinPath("/api/v1/secrets")
+	query := u.Query()
+	if in.Project != "" {
+		query.Add("project", in.Project)
+	}
+	if in.Label != "" {
+		query.Add("label", in.Label)
+	}
+	u.RawQuery = query.Encode()
+	resp := &api.ListSecretsResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodGet, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) GetSecret(ctx context.Context, in *api.GetSecretRequest, opts ...grpc.CallOption) (*api.GetSecretResponse, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/secrets/%d", in.SecretID))
+	resp := &api.GetSecretResponse{}
+	err := c.doRequest(ctx, u.String(), http.MethodGet, in, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
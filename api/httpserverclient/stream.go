@@ -0,0 +1,128 @@
+package httpserverclient
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// StreamLogLines streams log lines by server-sent events.
+func (c *Client) StreamLogLines(ctx context.Context, in *api.StreamLogLinesRequest, opts ...grpc.CallOption) (api.Server_StreamLogLinesClient, error) {
+	u := c.u.JoinPath(fmt.Sprintf("/api/v1/job_executions/%d/logs/%s/stream", in.JobExecutionID, in.Name))
+	query := u.Query()
+	query.Add("offset", fmt.Sprintf("%d", in.Offset))
+	u.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	response, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, response.Body)
+		_ = response.Body.Close()
+		return nil, errors.Errorf("failed to do request, got status code: %d", response.StatusCode)
+	}
+	return &logLinesStream{clientStream: clientStream{ctx: ctx}, body: response.Body, reader: bufio.NewReader(response.Body)}, nil
+}
+
+// logLinesStream implements api.Server_StreamLogLinesClient by reading server-sent events.
+type logLinesStream struct {
+	clientStream
+	body   io.ReadCloser
+	reader *bufio.Reader
+}
+
+// Recv returns the next log lines. It returns io.EOF when the stream is ended.
+func (s *logLinesStream) Recv() (*api.StreamLogLinesResponse, error) {
+	for {
+		event, data, err := s.readEvent()
+		if err != nil {
+			_ = s.body.Close()
+			return nil, err
+		}
+		switch event {
+		case "lines":
+			resp := &api.StreamLogLinesResponse{}
+			if err = json.Unmarshal(data, resp); err != nil {
+				return nil, errors.WithMessagef(err, "failed to unmarshal event data: %s", string(data))
+			}
+			return resp, nil
+		case "end":
+			_ = s.body.Close()
+			return nil, io.EOF
+		case "error":
+			_ = s.body.Close()
+			return nil, errors.Errorf("server error: %s", string(data))
+		}
+	}
+}
+
+// readEvent reads an event from the stream. Fields except event and data are ignored.
+func (s *logLinesStream) readEvent() (event string, data []byte, err error) {
+	var dataLines []string
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return "", nil, io.ErrUnexpectedEOF
+			}
+			return "", nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			if event == "" && len(dataLines) == 0 {
+				continue
+			}
+			return event, []byte(strings.Join(dataLines, "\n")), nil
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			event = value
+		case "data":
+			dataLines = append(dataLines, value)
+		}
+	}
+}
+
+// clientStream implements grpc.ClientStream for streams over http. Only the context is supported.
+type clientStream struct {
+	ctx context.Context
+}
+
+func (s *clientStream) Header() (metadata.MD, error) {
+	return nil, nil
+}
+
+func (s *clientStream) Trailer() metadata.MD {
+	return nil
+}
+
+func (s *clientStream) CloseSend() error {
+	return nil
+}
+
+func (s *clientStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *clientStream) SendMsg(m any) error {
+	return errors.New("not supported")
+}
+
+func (s *clientStream) RecvMsg(m any) error {
+	return errors.New("not supported")
+}
@@ -0,0 +1,112 @@
+package httpserverclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+)
+
+// uploadStream streams data as the body of a POST request, it's the base of client streams of uploading.
+type uploadStream struct {
+	clientStream
+	client *Client
+	writer *io.PipeWriter
+	result chan error
+}
+
+// start sends the request in background, the response body is unmarshalled into out.
+func (s *uploadStream) start(url string, out any) {
+	reader, writer := io.Pipe()
+	s.writer = writer
+	s.result = make(chan error, 1)
+	go func() {
+		err := s.do(url, reader, out)
+		// Unblock the writer if the request is failed before the body is consumed.
+		_ = reader.CloseWithError(errors.New("request is completed"))
+		s.result <- err
+	}()
+}
+
+func (s *uploadStream) started() bool {
+	return s.writer != nil
+}
+
+func (s *uploadStream) write(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	_, err := s.writer.Write(data)
+	return err
+}
+
+func (s *uploadStream) do(url string, body io.Reader, out any) error {
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodPost, url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	response, err := s.client.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return errors.WithMessage(err, "failed to read response body")
+	}
+	if response.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to upload, got status code: %d, body: %s", response.StatusCode,
+			string(content))
+	}
+	if err = json.Unmarshal(content, out); err != nil {
+		return errors.WithMessagef(err, "failed to unmarshal response body: %s", string(content))
+	}
+	return nil
+}
+
+// closeAndWait finishes the request body and waits for the response.
+func (s *uploadStream) closeAndWait() error {
+	if !s.started() {
+		return errors.New("no message is sent")
+	}
+	_ = s.writer.Close()
+	return <-s.result
+}
+
+// download sends a GET request and returns a stream reading the response body.
+func (c *Client) download(ctx context.Context, url string) (*downloadStream, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		content, _ := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		return nil, errors.Errorf("failed to download, got status code: %d, body: %s",
+			response.StatusCode, string(content))
+	}
+	return &downloadStream{clientStream: clientStream{ctx: ctx}, body: response.Body}, nil
+}
+
+// downloadStream reads the response body in chunks, it's the base of server streams of downloading.
+type downloadStream struct {
+	clientStream
+	body io.ReadCloser
+}
+
+// recv returns the next chunk of the body. It returns io.EOF when the body is ended.
+func (s *downloadStream) recv() ([]byte, error) {
+	buf := make([]byte, 32*1024)
+	n, err := io.ReadAtLeast(s.body, buf, 1)
+	if err != nil {
+		_ = s.body.Close()
+		return nil, err
+	}
+	return buf[:n], nil
+}
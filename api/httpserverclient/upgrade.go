@@ -0,0 +1,168 @@
+package httpserverclient
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// upgradeProtocol is the protocol of connections upgraded from http for bidirectional streams.
+const upgradeProtocol = "runner-stream"
+
+const (
+	frameEventMessage = "message"
+	// frameEventEnd means the sender won't send messages anymore.
+	frameEventEnd   = "end"
+	frameEventError = "error"
+)
+
+// streamFrame is a frame of an upgraded connection. Frames are sent as json lines.
+type streamFrame struct {
+	Event string              `json:"event"`
+	Data  jsoniter.RawMessage `json:"data,omitempty"`
+}
+
+// upgradeStream is a bidirectional stream over a connection upgraded from http, it's the base of bidirectional
+// streams. The connection is established when the first message is sent.
+type upgradeStream struct {
+	clientStream
+	client *Client
+	// connected is closed after connecting, conn is set if it's succeeded, otherwise err is set.
+	connected chan struct{}
+	conn      io.ReadWriteCloser
+	reader    *bufio.Reader
+	err       error
+	// writeLock protects writing, CloseSend might be called with Send concurrently.
+	writeLock sync.Mutex
+}
+
+func (c *Client) newUpgradeStream(s clientStream) *upgradeStream {
+	return &upgradeStream{clientStream: s, client: c, connected: make(chan struct{})}
+}
+
+func (s *upgradeStream) started() bool {
+	select {
+	case <-s.connected:
+		return true
+	default:
+		return false
+	}
+}
+
+// connect upgrades the connection of a GET request to the url.
+func (s *upgradeStream) connect(url string) error {
+	defer close(s.connected)
+	s.err = s.doConnect(url)
+	return s.err
+}
+
+func (s *upgradeStream) doConnect(url string) error {
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", upgradeProtocol)
+	response, err := s.client.client.Do(req)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusSwitchingProtocols {
+		content, _ := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		return errors.Errorf("failed to upgrade, got status code: %d, body: %s", response.StatusCode,
+			string(content))
+	}
+	conn, ok := response.Body.(io.ReadWriteCloser)
+	if !ok {
+		_ = response.Body.Close()
+		return errors.New("upgraded connection is not writable")
+	}
+	s.conn = conn
+	s.reader = bufio.NewReader(conn)
+	go func() {
+		<-s.ctx.Done()
+		_ = conn.Close()
+	}()
+	return nil
+}
+
+func (s *upgradeStream) send(m any) error {
+	if !s.started() {
+		return errors.New("stream is not connected")
+	}
+	if s.err != nil {
+		return s.err
+	}
+	return s.writeFrame(frameEventMessage, m)
+}
+
+// end tells the server no more messages will be sent.
+func (s *upgradeStream) end() error {
+	if !s.started() || s.err != nil {
+		return nil
+	}
+	return s.writeFrame(frameEventEnd, nil)
+}
+
+func (s *upgradeStream) writeFrame(event string, data any) error {
+	frame := &streamFrame{Event: event}
+	if data != nil {
+		bs, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		frame.Data = bs
+	}
+	bs, err := json.Marshal(frame)
+	if err != nil {
+		return err
+	}
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	_, err = s.conn.Write(append(bs, '\n'))
+	return err
+}
+
+// recv receives a message into m, it waits for the connection. It returns io.EOF when the server ends the stream.
+func (s *upgradeStream) recv(m any) error {
+	select {
+	case <-s.connected:
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+	if s.err != nil {
+		return s.err
+	}
+	line, err := s.reader.ReadBytes('\n')
+	if err != nil {
+		_ = s.conn.Close()
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	frame := &streamFrame{}
+	if err = json.Unmarshal(line, frame); err != nil {
+		return errors.WithMessagef(err, "failed to unmarshal frame: %s", string(line))
+	}
+	switch frame.Event {
+	case frameEventMessage:
+		if err = json.Unmarshal(frame.Data, m); err != nil {
+			return errors.WithMessagef(err, "failed to unmarshal message: %s", string(frame.Data))
+		}
+		return nil
+	case frameEventEnd:
+		_ = s.conn.Close()
+		return io.EOF
+	case frameEventError:
+		_ = s.conn.Close()
+		return errors.Errorf("server error: %s", string(frame.Data))
+	default:
+		return errors.Errorf("unexpected event '%s'", frame.Event)
+	}
+}
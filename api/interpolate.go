@@ -0,0 +1,361 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/lib/expr"
+	"github.com/samber/lo"
+)
+
+// Variables available in `${{ }}` expressions of DSL fields, such as `${{ env.VERSION }}`.
+//
+// Parameters and matrix values are static, they are rendered by the server when the pipeline is created, and they are
+// the only variables can be referred in `runs_on`. Other variables are rendered by the agent before the job or the
+// step runs.
+const (
+	// InterpolationVarParameters are parameters of the pipeline, e.g. `parameters.version`.
+	InterpolationVarParameters = "parameters"
+	// InterpolationVarPipeline is the pipeline, only `pipeline.id` is available.
+	InterpolationVarPipeline = "pipeline"
+	// InterpolationVarJob is the job, `job.id`, `job.name` and `job.execution_id` are available.
+	InterpolationVarJob = "job"
+	// InterpolationVarStep is the step, only `step.name` is available. It can be referred in fields of steps only.
+	InterpolationVarStep = "step"
+	// InterpolationVarEnv are environment variables and secrets of the job merged with environment variables of the
+	// step, built-in variables such as `RUNNER_JOB_ID` are included in fields of steps. It can't be referred in
+	// `env_var`.
+	InterpolationVarEnv = "env"
+	// InterpolationVarJobs are outputs of jobs which the job depends on, e.g. `jobs.build.outputs.image`.
+	InterpolationVarJobs = "jobs"
+	// InterpolationVarMatrix is the combination of the matrix a job is expanded from, e.g. `matrix.os`. It can be
+	// referred in jobs with matrix only.
+	InterpolationVarMatrix = "matrix"
+)
+
+// BuiltinEnvVars are environment variables injected into every step.
+var BuiltinEnvVars = []string{
+	"RUNNER_JOB_ID", "RUNNER_JOB_EXECUTION_ID", "RUNNER_STEP_NAME", "RUNNER_WORKSPACE", "RUNNER_OUTPUT",
+}
+
+// Template is a parsed string with `${{ }}` expressions.
+type Template struct {
+	// literals and expressions are interleaved, literals has one more element than expressions.
+	literals    []string
+	expressions []*expr.Expression
+	// sources are the original text of expressions, including `${{` and `}}`.
+	sources []string
+}
+
+// ParseTemplate parses s with `${{ }}` expressions. Only builtin functions can be called in expressions.
+func ParseTemplate(s string) (*Template, error) {
+	t := &Template{}
+	rest := s
+	for {
+		start := strings.Index(rest, "${{")
+		if start < 0 {
+			t.literals = append(t.literals, rest)
+			return t, nil
+		}
+		end := closingDoubleBrace(rest, start+3)
+		if end < 0 {
+			return nil, errors.Errorf("unclosed expression in '%s'", s)
+		}
+		e, err := expr.Parse(rest[start+3 : end])
+		if err != nil {
+			return nil, errors.WithMessagef(err, "invalid expression in '%s'", s)
+		}
+		builtin := expr.BuiltinFunctions()
+		for _, fn := range e.Functions() {
+			if _, ok := builtin[fn]; !ok {
+				return nil, errors.Errorf("unknown function '%s' in '%s'", fn, s)
+			}
+		}
+		t.literals = append(t.literals, rest[:start])
+		t.expressions = append(t.expressions, e)
+		t.sources = append(t.sources, rest[start:end+2])
+		rest = rest[end+2:]
+	}
+}
+
+// closingDoubleBrace returns the index of `}}` closes the expression starts at start, braces in strings are skipped.
+func closingDoubleBrace(s string, start int) int {
+	for {
+		end := closingBrace(s, start)
+		if end < 0 || end+1 >= len(s) {
+			return -1
+		}
+		if s[end+1] == '}' {
+			return end
+		}
+		start = end + 1
+	}
+}
+
+// Variables returns all variable references in expressions of the template.
+func (t *Template) Variables() [][]string {
+	var variables [][]string
+	for _, e := range t.expressions {
+		variables = append(variables, e.Variables()...)
+	}
+	return variables
+}
+
+// Render evaluates expressions with variables. An expression referring variables which are not provided is kept
+// as it is, so that it can be rendered later with more variables.
+func (t *Template) Render(variables map[string]interface{}) (string, error) {
+	ctx := &expr.Context{Variables: variables, Functions: expr.BuiltinFunctions()}
+	b := &strings.Builder{}
+	for i, e := range t.expressions {
+		b.WriteString(t.literals[i])
+		if !lo.EveryBy(e.Variables(), func(path []string) bool {
+			_, ok := variables[path[0]]
+			return ok
+		}) {
+			b.WriteString(t.sources[i])
+			continue
+		}
+		v, err := e.Evaluate(ctx)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(expr.ToString(v))
+	}
+	b.WriteString(t.literals[len(t.literals)-1])
+	return b.String(), nil
+}
+
+// Interpolate renders `${{ }}` expressions in s with variables, see Template.Render.
+func Interpolate(s string, variables map[string]interface{}) (string, error) {
+	if !strings.Contains(s, "${{") {
+		return s, nil
+	}
+	t, err := ParseTemplate(s)
+	if err != nil {
+		return "", err
+	}
+	return t.Render(variables)
+}
+
+// InterpolateEnv renders `${{ }}` expressions in values of env with variables.
+func InterpolateEnv(env map[string]string, variables map[string]interface{}) (map[string]string, error) {
+	if len(env) == 0 {
+		return env, nil
+	}
+	rendered := make(map[string]string, len(env))
+	for key, value := range env {
+		var err error
+		if rendered[key], err = Interpolate(value, variables); err != nil {
+			return nil, errors.WithMessagef(err, "failed to render env '%s'", key)
+		}
+	}
+	return rendered, nil
+}
+
+// InterpolateParameters renders parameters in all fields of the pipeline supporting `${{ }}` expressions.
+// Other variables are kept and rendered by agents.
+func InterpolateParameters(dsl *PipelineDSL) error {
+	variables := map[string]interface{}{InterpolationVarParameters: lo.Assign(dsl.Parameters)}
+	return walkInterpolationFields(dsl, func(f *interpolationField) error {
+		rendered, err := Interpolate(f.value, variables)
+		if err != nil {
+			return errors.WithMessagef(err, "failed to render %s", f.name)
+		}
+		f.set(rendered)
+		return nil
+	})
+}
+
+// interpolationScope is the variables can be referred in a field.
+type interpolationScope int
+
+const (
+	// interpolationScopeRunsOn is for fields of runs_on, they are used by the server to dispatch the job.
+	interpolationScopeRunsOn interpolationScope = iota
+	// interpolationScopeJobEnv is for env_var of the job.
+	interpolationScopeJobEnv
+	// interpolationScopeJob is for fields of the job.
+	interpolationScopeJob
+	// interpolationScopeStepEnv is for env_var of the step.
+	interpolationScopeStepEnv
+	// interpolationScopeStep is for fields of the step.
+	interpolationScopeStep
+)
+
+type interpolationField struct {
+	// name describes the field in error messages.
+	name  string
+	value string
+	set   func(string)
+	scope interpolationScope
+	job   *JobDSL
+	step  *StepDSL
+}
+
+// walkInterpolationFields calls fn with all fields supporting `${{ }}` expressions in the pipeline.
+func walkInterpolationFields(dsl *PipelineDSL, fn func(f *interpolationField) error) error {
+	visitEnv := func(env map[string]string, name string, scope interpolationScope, job *JobDSL, step *StepDSL) error {
+		keys := lo.Keys(env)
+		sort.Strings(keys)
+		for _, key := range keys {
+			err := fn(&interpolationField{
+				name: fmt.Sprintf("env '%s' of %s", key, name), value: env[key], scope: scope, job: job, step: step,
+				set: func(s string) { env[key] = s },
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, job := range dsl.Jobs {
+		fields := []*interpolationField{
+			{
+				name: "working directory", value: job.WorkingDirectory, scope: interpolationScopeJob,
+				set: func(s string) { job.WorkingDirectory = s },
+			},
+		}
+		if runsOn := job.RunsOn; runsOn != nil {
+			fields = append(fields, &interpolationField{
+				name: "runs_on label", value: runsOn.Label, scope: interpolationScopeRunsOn,
+				set: func(s string) { runsOn.Label = s },
+			})
+			if runsOn.Docker != nil {
+				for _, container := range runsOn.Docker.Containers {
+					fields = append(fields, &interpolationField{
+						name: fmt.Sprintf("image of container '%s'", container.Name), value: container.Image,
+						scope: interpolationScopeRunsOn, set: func(s string) { container.Image = s },
+					})
+				}
+			}
+			if runsOn.VM != nil {
+				fields = append(fields, &interpolationField{
+					name: "image of vm", value: runsOn.VM.Image, scope: interpolationScopeRunsOn,
+					set: func(s string) { runsOn.VM.Image = s },
+				})
+			}
+		}
+		for _, f := range fields {
+			f.job = job
+			if err := fn(f); err != nil {
+				return errors.WithMessagef(err, "invalid job '%s'", job.Name)
+			}
+		}
+		if err := visitEnv(job.EnvVar, "job", interpolationScopeJobEnv, job, nil); err != nil {
+			return errors.WithMessagef(err, "invalid job '%s'", job.Name)
+		}
+		for _, step := range job.Steps {
+			fields := []*interpolationField{{
+				name: fmt.Sprintf("working directory of step '%s'", step.Name), value: step.WorkingDirectory,
+				set: func(s string) { step.WorkingDirectory = s },
+			}}
+			for i := range step.Commands {
+				fields = append(fields, &interpolationField{
+					name: fmt.Sprintf("command of step '%s'", step.Name), value: step.Commands[i],
+					set: func(s string) { step.Commands[i] = s },
+				})
+			}
+			for _, f := range fields {
+				f.scope, f.job, f.step = interpolationScopeStep, job, step
+				if err := fn(f); err != nil {
+					return errors.WithMessagef(err, "invalid job '%s'", job.Name)
+				}
+			}
+			err := visitEnv(step.EnvVar, fmt.Sprintf("step '%s'", step.Name), interpolationScopeStepEnv, job, step)
+			if err != nil {
+				return errors.WithMessagef(err, "invalid job '%s'", job.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateInterpolations validates all variables referred by `${{ }}` expressions in the pipeline are known.
+func validateInterpolations(dsl *PipelineDSL) error {
+	return walkInterpolationFields(dsl, func(f *interpolationField) error {
+		if !strings.Contains(f.value, "${{") {
+			return nil
+		}
+		t, err := ParseTemplate(f.value)
+		if err != nil {
+			return errors.WithMessagef(err, "invalid %s", f.name)
+		}
+		for _, path := range t.Variables() {
+			if err = validateInterpolationVariable(dsl, f, path); err != nil {
+				return errors.WithMessagef(err, "invalid %s", f.name)
+			}
+		}
+		return nil
+	})
+}
+
+func validateInterpolationVariable(dsl *PipelineDSL, f *interpolationField, path []string) error {
+	variable := strings.Join(path, ".")
+	allowed := []string{InterpolationVarParameters}
+	switch f.scope {
+	case interpolationScopeJobEnv:
+		allowed = append(allowed, InterpolationVarPipeline, InterpolationVarJob, InterpolationVarJobs)
+	case interpolationScopeJob:
+		allowed = append(allowed, InterpolationVarPipeline, InterpolationVarJob, InterpolationVarJobs,
+			InterpolationVarEnv)
+	case interpolationScopeStepEnv:
+		allowed = append(allowed, InterpolationVarPipeline, InterpolationVarJob, InterpolationVarJobs,
+			InterpolationVarStep)
+	case interpolationScopeStep:
+		allowed = append(allowed, InterpolationVarPipeline, InterpolationVarJob, InterpolationVarJobs,
+			InterpolationVarStep, InterpolationVarEnv)
+	}
+	if f.job.Matrix != nil {
+		allowed = append(allowed, InterpolationVarMatrix)
+	}
+	if !lo.Contains(allowed, path[0]) {
+		return errors.Errorf("unknown variable '%s', only %s can be referred", variable, strings.Join(allowed, ", "))
+	}
+	if len(path) < 2 {
+		return errors.Errorf("incomplete variable '%s'", variable)
+	}
+	var known []string
+	switch path[0] {
+	case InterpolationVarParameters:
+		known = lo.Keys(dsl.Parameters)
+	case InterpolationVarPipeline:
+		known = []string{"id"}
+	case InterpolationVarJob:
+		known = []string{"id", "name", "execution_id"}
+	case InterpolationVarStep:
+		known = []string{"name"}
+	case InterpolationVarEnv:
+		known = append(lo.Keys(f.job.EnvVar), lo.Keys(f.job.Secrets)...)
+		if f.step != nil {
+			known = append(append(known, lo.Keys(f.step.EnvVar)...), BuiltinEnvVars...)
+		}
+	case InterpolationVarMatrix:
+		combinations, err := MatrixCombinations(f.job.Matrix)
+		if err != nil {
+			return err
+		}
+		known = lo.Uniq(lo.FlatMap(combinations, func(combination map[string]string, _ int) []string {
+			return lo.Keys(combination)
+		}))
+	case InterpolationVarJobs:
+		if !lo.Contains(f.job.DependsOn, path[1]) {
+			return errors.Errorf("job '%s' referred by '%s' is not a dependency", path[1], variable)
+		}
+		if lo.ContainsBy(dsl.Jobs, func(job *JobDSL) bool {
+			return job.Name == path[1] && job.Matrix != nil
+		}) {
+			return errors.Errorf("outputs of matrix job '%s' can't be referred", path[1])
+		}
+		if len(path) < 3 || path[2] != "outputs" {
+			return errors.Errorf("unknown variable '%s', only outputs of jobs can be referred", variable)
+		}
+		return nil
+	}
+	if !lo.Contains(known, path[1]) {
+		sort.Strings(known)
+		return errors.Errorf("unknown variable '%s', known %s: [%s]", variable, path[0], strings.Join(known, ", "))
+	}
+	return nil
+}
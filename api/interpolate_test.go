@@ -0,0 +1,59 @@
+package api
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestInterpolate(t *testing.T) {
+	variables := map[string]interface{}{
+		InterpolationVarEnv:      map[string]string{"VERSION": "1.0"},
+		InterpolationVarPipeline: map[string]interface{}{"id": int64(3)},
+	}
+	for _, tc := range []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{name: "no_expression", source: "echo hello", want: "echo hello"},
+		{name: "variable", source: "echo ${{ env.VERSION }}", want: "echo 1.0"},
+		{name: "multiple", source: "${{env.VERSION}}-${{ pipeline.id }}", want: "1.0-3"},
+		{name: "function", source: "${{ format('v{0}', env.VERSION) }}", want: "v1.0"},
+		{name: "brace_in_string", source: "${{ '}}' }}", want: "}}"},
+		{name: "missing_property", source: "[${{ env.NOT_FOUND }}]", want: "[]"},
+		{name: "unknown_variable_is_kept", source: "${{ env.VERSION }} ${{ jobs.build.outputs.image }}",
+			want: "1.0 ${{ jobs.build.outputs.image }}"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Interpolate(tc.source, variables)
+			assert.NilError(t, err)
+			assert.Equal(t, got, tc.want)
+		})
+	}
+	t.Run("unclosed", func(t *testing.T) {
+		_, err := Interpolate("${{ env.VERSION }", variables)
+		assert.ErrorContains(t, err, "unclosed expression")
+	})
+	t.Run("unknown_function", func(t *testing.T) {
+		_, err := Interpolate("${{ hashFiles('go.sum') }}", variables)
+		assert.ErrorContains(t, err, "unknown function 'hashFiles'")
+	})
+}
+
+func TestInterpolateParameters(t *testing.T) {
+	dsl := getDSL()
+	dsl.Parameters = map[string]string{"os": "linux", "tag": "v1"}
+	dsl.Jobs[0].RunsOn = &RunsOn{
+		Label:  "${{ parameters.os }}",
+		Docker: &Docker{Containers: []*Container{{Name: "golang", Image: "golang:${{ parameters.tag }}"}}},
+	}
+	dsl.Jobs[0].EnvVar = map[string]string{"TAG": "${{ parameters.tag }}"}
+	dsl.Jobs[0].Steps[0].Commands = []string{"echo ${{ parameters.tag }} ${{ env.TAG }}"}
+	assert.NilError(t, InterpolateParameters(dsl))
+	job := dsl.Jobs[0]
+	assert.Equal(t, job.RunsOn.Label, "linux")
+	assert.Equal(t, job.RunsOn.Docker.Containers[0].Image, "golang:v1")
+	assert.DeepEqual(t, job.EnvVar, map[string]string{"TAG": "v1"})
+	assert.DeepEqual(t, job.Steps[0].Commands, []string{"echo v1 ${{ env.TAG }}"})
+}
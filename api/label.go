@@ -0,0 +1,70 @@
+package api
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+)
+
+// labelRegex matches a label. A label might be a key=value pair, such as `gpu=false`, it's matched as a whole.
+var labelRegex = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_./:=-]*$`)
+
+// LabelSelector selects agents by their labels. It's parsed from a label expression, terms of the expression are
+// joined by `&&`, and a term prefixed by `!` excludes agents having the label, such as `linux && amd64 && !gpu=true`.
+type LabelSelector struct {
+	// Required are labels that agents must have all of them.
+	Required []string
+	// Excluded are labels that agents must have none of them.
+	Excluded []string
+}
+
+// ParseLabelSelector parses a label expression. At least one required label is expected.
+func ParseLabelSelector(expression string) (*LabelSelector, error) {
+	selector := &LabelSelector{}
+	for _, term := range strings.Split(expression, "&&") {
+		term = strings.TrimSpace(term)
+		excluded := strings.HasPrefix(term, "!")
+		if excluded {
+			term = strings.TrimSpace(term[1:])
+		}
+		if !labelRegex.MatchString(term) {
+			return nil, errors.Errorf("invalid label '%s' in label expression '%s'", term, expression)
+		}
+		if excluded {
+			selector.Excluded = append(selector.Excluded, term)
+		} else {
+			selector.Required = append(selector.Required, term)
+		}
+	}
+	if len(selector.Required) == 0 {
+		return nil, errors.Errorf("no required label in label expression '%s'", expression)
+	}
+	if conflicted := lo.Intersect(selector.Required, selector.Excluded); len(conflicted) > 0 {
+		return nil, errors.Errorf("label '%s' is both required and excluded in label expression '%s'",
+			conflicted[0], expression)
+	}
+	selector.Required = lo.Uniq(selector.Required)
+	selector.Excluded = lo.Uniq(selector.Excluded)
+	return selector, nil
+}
+
+// Match returns true if labels have all required labels and none of excluded labels.
+func (s *LabelSelector) Match(labels []string) bool {
+	return lo.Every(labels, s.Required) && lo.None(labels, s.Excluded)
+}
+
+// String returns the canonical label expression of the selector. Required labels are followed by excluded labels, and
+// labels are sorted in each part, so expressions selecting the same agents are printed the same.
+func (s *LabelSelector) String() string {
+	terms := make([]string, 0, len(s.Required)+len(s.Excluded))
+	terms = append(terms, s.Required...)
+	sort.Strings(terms)
+	excluded := lo.Map(s.Excluded, func(label string, _ int) string {
+		return "!" + label
+	})
+	sort.Strings(excluded)
+	return strings.Join(append(terms, excluded...), " && ")
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	selector, err := ParseLabelSelector("linux && amd64 && !gpu=true && linux")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, selector.Required, []string{"linux", "amd64"})
+	assert.DeepEqual(t, selector.Excluded, []string{"gpu=true"})
+	assert.Assert(t, selector.Match([]string{"linux", "amd64", "gpu=false"}))
+	assert.Assert(t, !selector.Match([]string{"linux", "amd64", "gpu=true"}))
+	assert.Assert(t, !selector.Match([]string{"linux"}))
+	assert.Equal(t, selector.String(), "amd64 && linux && !gpu=true")
+	for expression, message := range map[string]string{
+		"":                   "invalid label",
+		"linux &&":           "invalid label",
+		"linux || windows":   "invalid label",
+		"!gpu":               "no required label",
+		"linux && !linux":    "both required and excluded",
+		"linux && ! gpu=yes": "",
+	} {
+		_, err := ParseLabelSelector(expression)
+		if message == "" {
+			assert.NilError(t, err, expression)
+			continue
+		}
+		assert.ErrorContains(t, err, message, expression)
+	}
+}
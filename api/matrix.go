@@ -0,0 +1,202 @@
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxMatrixJobs is the max number of jobs a matrix can be expanded to.
+const maxMatrixJobs = 64
+
+// matrixKeyPattern is the pattern of keys of matrix values, they're referred by `${{ matrix.<key> }}`.
+var matrixKeyPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// MatrixCombinations returns the combinations of the matrix in order. The cartesian product of axes comes first,
+// the first axis varies slowest, and combinations added by `include` follow.
+func MatrixCombinations(m *MatrixDSL) ([]map[string]string, error) {
+	axes := make([]string, 0, len(m.Axes))
+	var combinations []map[string]string
+	for i, axis := range m.Axes {
+		if !matrixKeyPattern.MatchString(axis.Name) {
+			return nil, errors.Errorf("invalid axis name '%s'", axis.Name)
+		}
+		if lo.Contains(axes, axis.Name) {
+			return nil, errors.Errorf("axis '%s' is duplicated", axis.Name)
+		}
+		axes = append(axes, axis.Name)
+		if i == 0 {
+			combinations = []map[string]string{{}}
+		}
+		next := make([]map[string]string, 0, len(combinations)*len(axis.Values))
+		for _, combination := range combinations {
+			for _, value := range axis.Values {
+				next = append(next, lo.Assign(combination, map[string]string{axis.Name: value}))
+			}
+		}
+		combinations = next
+	}
+	for _, exclude := range m.Exclude {
+		for key := range exclude.Values {
+			if !lo.Contains(axes, key) {
+				return nil, errors.Errorf("axis '%s' of exclude is not defined", key)
+			}
+		}
+		combinations = lo.Reject(combinations, func(combination map[string]string, _ int) bool {
+			return matchCombination(combination, exclude.Values)
+		})
+	}
+	// Includes only extend combinations of axes, not the ones added by other includes.
+	product := len(combinations)
+	for _, include := range m.Include {
+		for key := range include.Values {
+			if !matrixKeyPattern.MatchString(key) {
+				return nil, errors.Errorf("invalid key '%s' of include", key)
+			}
+		}
+		axisValues := lo.PickByKeys(include.Values, axes)
+		extra := lo.OmitByKeys(include.Values, axes)
+		matched := false
+		for _, combination := range combinations[:product] {
+			if matchCombination(combination, axisValues) {
+				matched = true
+				for key, value := range extra {
+					combination[key] = value
+				}
+			}
+		}
+		if !matched {
+			combinations = append(combinations, lo.Assign(include.Values))
+		}
+	}
+	if len(combinations) == 0 {
+		return nil, errors.New("matrix has no combination")
+	}
+	if len(combinations) > maxMatrixJobs {
+		return nil, errors.Errorf("matrix has %d combinations, at most %d are allowed", len(combinations),
+			maxMatrixJobs)
+	}
+	return combinations, nil
+}
+
+// matchCombination returns true if the combination has all of values.
+func matchCombination(combination map[string]string, values map[string]string) bool {
+	for key, value := range values {
+		if v, ok := combination[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// MatrixJobName returns the name of the job expanded from the matrix job with values, such as
+// `test (go=1.22, os=linux)`. Axes are in the order of definition, keys only in includes are sorted and follow.
+func MatrixJobName(name string, m *MatrixDSL, values map[string]string) string {
+	axes := lo.Map(m.Axes, func(axis *MatrixAxis, _ int) string {
+		return axis.Name
+	})
+	keys := lo.Without(lo.Keys(values), axes...)
+	sort.Strings(keys)
+	keys = append(lo.Filter(axes, func(axis string, _ int) bool {
+		_, ok := values[axis]
+		return ok
+	}), keys...)
+	pairs := lo.Map(keys, func(key string, _ int) string {
+		return key + "=" + values[key]
+	})
+	return fmt.Sprintf("%s (%s)", name, strings.Join(pairs, ", "))
+}
+
+// ExpandMatrices replaces jobs with matrix by a job for each combination, `${{ matrix.<key> }}` expressions in
+// fields of expansions are rendered. Dependencies on a matrix job are replaced by all of its expansions.
+// matrices[i] is the combination jobs[i] is expanded from, it's nil if jobs[i] isn't expanded from a matrix.
+func ExpandMatrices(dslJobs []*JobDSL) (jobs []*JobDSL, matrices []*JobMatrix, err error) {
+	expansions := make(map[string][]string)
+	for _, job := range dslJobs {
+		if job.Matrix == nil {
+			jobs = append(jobs, job)
+			matrices = append(matrices, nil)
+			continue
+		}
+		combinations, err := MatrixCombinations(job.Matrix)
+		if err != nil {
+			return nil, nil, errors.WithMessagef(err, "invalid matrix of job '%s'", job.Name)
+		}
+		for _, values := range combinations {
+			expansion := proto.Clone(job).(*JobDSL)
+			expansion.Name = MatrixJobName(job.Name, job.Matrix, values)
+			expansion.Matrix = nil
+			variables := map[string]interface{}{InterpolationVarMatrix: lo.Assign(values)}
+			err = walkInterpolationFields(&PipelineDSL{Jobs: []*JobDSL{expansion}}, func(f *interpolationField) error {
+				rendered, err := Interpolate(f.value, variables)
+				if err != nil {
+					return errors.WithMessagef(err, "failed to render %s", f.name)
+				}
+				f.set(rendered)
+				return nil
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			jobs = append(jobs, expansion)
+			matrices = append(matrices, &JobMatrix{
+				Name:        job.Name,
+				Values:      values,
+				MaxParallel: job.Matrix.MaxParallel,
+			})
+			expansions[job.Name] = append(expansions[job.Name], expansion.Name)
+		}
+	}
+	for _, job := range jobs {
+		if !lo.SomeBy(job.DependsOn, func(dep string) bool {
+			_, ok := expansions[dep]
+			return ok
+		}) {
+			continue
+		}
+		job.DependsOn = lo.FlatMap(job.DependsOn, func(dep string, _ int) []string {
+			if names, ok := expansions[dep]; ok {
+				return names
+			}
+			return []string{dep}
+		})
+	}
+	return jobs, matrices, nil
+}
+
+// validateMatrices validates matrices of jobs can be expanded, and their expansions don't conflict with other jobs.
+// Outputs and artifacts of a matrix job can't be referred by other jobs, because they're ambiguous.
+func validateMatrices(dsl *PipelineDSL) error {
+	names := lo.SliceToMap(dsl.Jobs, func(job *JobDSL) (string, bool) {
+		return job.Name, true
+	})
+	for _, job := range dsl.Jobs {
+		if job.Matrix == nil {
+			continue
+		}
+		combinations, err := MatrixCombinations(job.Matrix)
+		if err != nil {
+			return errors.WithMessagef(err, "invalid matrix of job '%s'", job.Name)
+		}
+		for _, values := range combinations {
+			name := MatrixJobName(job.Name, job.Matrix, values)
+			if names[name] {
+				return errors.Errorf("job '%s' expanded from matrix of job '%s' is duplicated", name, job.Name)
+			}
+			names[name] = true
+		}
+		for _, other := range dsl.Jobs {
+			for _, download := range other.DownloadArtifacts {
+				if download.Job == job.Name {
+					return errors.Errorf("job '%s' can't download artifacts of matrix job '%s'", other.Name, job.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestMatrixCombinations(t *testing.T) {
+	m := &MatrixDSL{
+		Axes: []*MatrixAxis{
+			{Name: "go", Values: []string{"1.22", "1.23"}},
+			{Name: "os", Values: []string{"linux", "windows"}},
+		},
+		Exclude: []*MatrixCombination{{Values: map[string]string{"go": "1.22", "os": "windows"}}},
+		Include: []*MatrixCombination{
+			{Values: map[string]string{"os": "linux", "race": "true"}},
+			{Values: map[string]string{"go": "1.24", "os": "linux"}},
+		},
+	}
+	combinations, err := MatrixCombinations(m)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, combinations, []map[string]string{
+		{"go": "1.22", "os": "linux", "race": "true"},
+		{"go": "1.23", "os": "linux", "race": "true"},
+		{"go": "1.23", "os": "windows"},
+		{"go": "1.24", "os": "linux"},
+	})
+	assert.Equal(t, MatrixJobName("test", m, combinations[0]), "test (go=1.22, os=linux, race=true)")
+	assert.Equal(t, MatrixJobName("test", m, combinations[3]), "test (go=1.24, os=linux)")
+	t.Run("invalid", func(t *testing.T) {
+		for _, c := range []struct {
+			matrix *MatrixDSL
+			err    string
+		}{
+			{matrix: &MatrixDSL{}, err: "no combination"},
+			{matrix: &MatrixDSL{Axes: []*MatrixAxis{{Name: "a-b", Values: []string{"1"}}}}, err: "invalid axis name"},
+			{
+				matrix: &MatrixDSL{Axes: []*MatrixAxis{{Name: "a", Values: []string{"1"}}, {Name: "a", Values: []string{"2"}}}},
+				err:    "duplicated",
+			},
+			{
+				matrix: &MatrixDSL{
+					Axes:    []*MatrixAxis{{Name: "a", Values: []string{"1"}}},
+					Exclude: []*MatrixCombination{{Values: map[string]string{"b": "1"}}},
+				},
+				err: "axis 'b' of exclude is not defined",
+			},
+			{
+				matrix: &MatrixDSL{Axes: []*MatrixAxis{
+					{Name: "a", Values: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}},
+					{Name: "b", Values: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}},
+				}},
+				err: "at most 64",
+			},
+		} {
+			_, err := MatrixCombinations(c.matrix)
+			assert.ErrorContains(t, err, c.err)
+		}
+	})
+}
+
+func TestExpandMatrices(t *testing.T) {
+	dsl := &PipelineDSL{
+		Jobs: []*JobDSL{
+			{
+				Name:   "test",
+				RunsOn: &RunsOn{Label: "${{ matrix.os }}"},
+				EnvVar: map[string]string{"GO_VERSION": "${{ matrix.go }}", "HOME": "${{ env.HOME }}"},
+				Steps:  []*StepDSL{{Name: "test", Commands: []string{"go${{ matrix.go }} test ./..."}}},
+				Matrix: &MatrixDSL{
+					Axes: []*MatrixAxis{
+						{Name: "go", Values: []string{"1.22", "1.23"}},
+						{Name: "os", Values: []string{"linux"}},
+					},
+					MaxParallel: 1,
+				},
+			},
+			{
+				Name:      "deploy",
+				RunsOn:    &RunsOn{Label: "linux"},
+				DependsOn: []string{"build", "test"},
+				Steps:     []*StepDSL{{Name: "deploy", Commands: []string{"deploy"}}},
+			},
+		},
+	}
+	jobs, matrices, err := ExpandMatrices(dsl.Jobs)
+	assert.NilError(t, err)
+	assert.Equal(t, len(jobs), 3)
+	assert.Equal(t, jobs[0].Name, "test (go=1.22, os=linux)")
+	assert.Equal(t, jobs[0].RunsOn.Label, "linux")
+	assert.DeepEqual(t, jobs[0].EnvVar, map[string]string{"GO_VERSION": "1.22", "HOME": "${{ env.HOME }}"})
+	assert.DeepEqual(t, jobs[0].Steps[0].Commands, []string{"go1.22 test ./..."})
+	assert.Assert(t, jobs[0].Matrix == nil)
+	assert.Equal(t, jobs[1].Name, "test (go=1.23, os=linux)")
+	assert.DeepEqual(t, jobs[1].Steps[0].Commands, []string{"go1.23 test ./..."})
+	assert.DeepEqual(t, jobs[2].DependsOn, []string{"build", "test (go=1.22, os=linux)", "test (go=1.23, os=linux)"})
+	assert.DeepEqual(t, matrices[0].Values, map[string]string{"go": "1.22", "os": "linux"})
+	assert.Equal(t, matrices[0].Name, "test")
+	assert.Equal(t, matrices[0].MaxParallel, int32(1))
+	assert.Assert(t, matrices[2] == nil)
+	// The original DSL isn't changed.
+	assert.Equal(t, dsl.Jobs[0].RunsOn.Label, "${{ matrix.os }}")
+}
+
+func TestValidateDSL_Matrix(t *testing.T) {
+	newDSL := func() *PipelineDSL {
+		dsl := getDSL()
+		dsl.Jobs[0].RunsOn.Label = "${{ matrix.os }}"
+		dsl.Jobs[0].Steps[0].Commands = []string{"echo ${{ matrix.os }} ${{ matrix.race }}"}
+		dsl.Jobs[0].Matrix = &MatrixDSL{
+			Axes:    []*MatrixAxis{{Name: "os", Values: []string{"linux", "windows"}}},
+			Include: []*MatrixCombination{{Values: map[string]string{"os": "linux", "race": "true"}}},
+		}
+		return dsl
+	}
+	assert.NilError(t, ValidateDSL(newDSL()))
+
+	dsl := newDSL()
+	dsl.Jobs[0].Steps[0].Commands = []string{"echo ${{ matrix.arch }}"}
+	assert.ErrorContains(t, ValidateDSL(dsl), "unknown variable 'matrix.arch'")
+
+	dsl = newDSL()
+	dsl.Jobs[0].RunsOn.Label = "${{ matrix.os }} && !"
+	assert.ErrorContains(t, ValidateDSL(dsl), "invalid label")
+
+	dsl = newDSL()
+	dsl.Jobs[0].Matrix = nil
+	assert.ErrorContains(t, ValidateDSL(dsl), "unknown variable 'matrix.os'")
+
+	dsl = newDSL()
+	dsl.Jobs[0].Matrix.MaxParallel = -1
+	assert.Assert(t, ValidateDSL(dsl) != nil)
+
+	dsl = newDSL()
+	dsl.Jobs = append(dsl.Jobs, &JobDSL{
+		Name:   "uid (os=linux, race=true)",
+		RunsOn: &RunsOn{Label: "linux"},
+		Steps:  []*StepDSL{{Name: "step"}},
+	})
+	assert.ErrorContains(t, ValidateDSL(dsl), "is duplicated")
+
+	dsl = newDSL()
+	dsl.Jobs = append(dsl.Jobs, &JobDSL{
+		Name:              "deploy",
+		RunsOn:            &RunsOn{Label: "linux"},
+		DependsOn:         []string{"uid"},
+		DownloadArtifacts: []*ArtifactDownload{{Job: "uid", Name: "binary"}},
+		Steps:             []*StepDSL{{Name: "step"}},
+	})
+	assert.ErrorContains(t, ValidateDSL(dsl), "can't download artifacts of matrix job 'uid'")
+
+	dsl = newDSL()
+	dsl.Jobs = append(dsl.Jobs, &JobDSL{
+		Name:      "deploy",
+		RunsOn:    &RunsOn{Label: "linux"},
+		DependsOn: []string{"uid"},
+		Steps:     []*StepDSL{{Name: "step", Commands: []string{"echo ${{ jobs.uid.outputs.image }}"}}},
+	})
+	assert.ErrorContains(t, ValidateDSL(dsl), "outputs of matrix job 'uid' can't be referred")
+}
@@ -42,6 +42,50 @@ func (m *MockServerClient) EXPECT() *MockServerClientMockRecorder {
 	return m.recorder
 }
 
+// AgentHeartbeat mocks base method.
+func (m *MockServerClient) AgentHeartbeat(ctx context.Context, in *api.AgentHeartbeatRequest, opts ...grpc.CallOption) (*api.AgentHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AgentHeartbeat", varargs...)
+	ret0, _ := ret[0].(*api.AgentHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AgentHeartbeat indicates an expected call of AgentHeartbeat.
+func (mr *MockServerClientMockRecorder) AgentHeartbeat(ctx, in any, opts ...any) *MockServerClientAgentHeartbeatCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AgentHeartbeat", reflect.TypeOf((*MockServerClient)(nil).AgentHeartbeat), varargs...)
+	return &MockServerClientAgentHeartbeatCall{Call: call}
+}
+
+// MockServerClientAgentHeartbeatCall wrap *gomock.Call
+type MockServerClientAgentHeartbeatCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientAgentHeartbeatCall) Return(arg0 *api.AgentHeartbeatResponse, arg1 error) *MockServerClientAgentHeartbeatCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientAgentHeartbeatCall) Do(f func(context.Context, *api.AgentHeartbeatRequest, ...grpc.CallOption) (*api.AgentHeartbeatResponse, error)) *MockServerClientAgentHeartbeatCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientAgentHeartbeatCall) DoAndReturn(f func(context.Context, *api.AgentHeartbeatRequest, ...grpc.CallOption) (*api.AgentHeartbeatResponse, error)) *MockServerClientAgentHeartbeatCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CancelJobExecution mocks base method.
 func (m *MockServerClient) CancelJobExecution(ctx context.Context, in *api.CancelJobExecutionRequest, opts ...grpc.CallOption) (*api.CancelJobExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -130,442 +174,1718 @@ func (c *MockServerClientCreatePipelineCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
-// GetJobExecution mocks base method.
-func (m *MockServerClient) GetJobExecution(ctx context.Context, in *api.GetJobExecutionRequest, opts ...grpc.CallOption) (*api.GetJobExecutionResponse, error) {
+// CreateSchedule mocks base method.
+func (m *MockServerClient) CreateSchedule(ctx context.Context, in *api.CreateScheduleRequest, opts ...grpc.CallOption) (*api.CreateScheduleResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetJobExecution", varargs...)
-	ret0, _ := ret[0].(*api.GetJobExecutionResponse)
+	ret := m.ctrl.Call(m, "CreateSchedule", varargs...)
+	ret0, _ := ret[0].(*api.CreateScheduleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetJobExecution indicates an expected call of GetJobExecution.
-func (mr *MockServerClientMockRecorder) GetJobExecution(ctx, in any, opts ...any) *MockServerClientGetJobExecutionCall {
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *MockServerClientMockRecorder) CreateSchedule(ctx, in any, opts ...any) *MockServerClientCreateScheduleCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobExecution", reflect.TypeOf((*MockServerClient)(nil).GetJobExecution), varargs...)
-	return &MockServerClientGetJobExecutionCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockServerClient)(nil).CreateSchedule), varargs...)
+	return &MockServerClientCreateScheduleCall{Call: call}
 }
 
-// MockServerClientGetJobExecutionCall wrap *gomock.Call
-type MockServerClientGetJobExecutionCall struct {
+// MockServerClientCreateScheduleCall wrap *gomock.Call
+type MockServerClientCreateScheduleCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockServerClientGetJobExecutionCall) Return(arg0 *api.GetJobExecutionResponse, arg1 error) *MockServerClientGetJobExecutionCall {
+func (c *MockServerClientCreateScheduleCall) Return(arg0 *api.CreateScheduleResponse, arg1 error) *MockServerClientCreateScheduleCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockServerClientGetJobExecutionCall) Do(f func(context.Context, *api.GetJobExecutionRequest, ...grpc.CallOption) (*api.GetJobExecutionResponse, error)) *MockServerClientGetJobExecutionCall {
+func (c *MockServerClientCreateScheduleCall) Do(f func(context.Context, *api.CreateScheduleRequest, ...grpc.CallOption) (*api.CreateScheduleResponse, error)) *MockServerClientCreateScheduleCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockServerClientGetJobExecutionCall) DoAndReturn(f func(context.Context, *api.GetJobExecutionRequest, ...grpc.CallOption) (*api.GetJobExecutionResponse, error)) *MockServerClientGetJobExecutionCall {
+func (c *MockServerClientCreateScheduleCall) DoAndReturn(f func(context.Context, *api.CreateScheduleRequest, ...grpc.CallOption) (*api.CreateScheduleResponse, error)) *MockServerClientCreateScheduleCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetLogLines mocks base method.
-func (m *MockServerClient) GetLogLines(ctx context.Context, in *api.GetLogLinesRequest, opts ...grpc.CallOption) (*api.GetLogLinesResponse, error) {
+// CreateSecret mocks base method.
+func (m *MockServerClient) CreateSecret(ctx context.Context, in *api.CreateSecretRequest, opts ...grpc.CallOption) (*api.CreateSecretResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetLogLines", varargs...)
-	ret0, _ := ret[0].(*api.GetLogLinesResponse)
+	ret := m.ctrl.Call(m, "CreateSecret", varargs...)
+	ret0, _ := ret[0].(*api.CreateSecretResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLogLines indicates an expected call of GetLogLines.
-func (mr *MockServerClientMockRecorder) GetLogLines(ctx, in any, opts ...any) *MockServerClientGetLogLinesCall {
+// CreateSecret indicates an expected call of CreateSecret.
+func (mr *MockServerClientMockRecorder) CreateSecret(ctx, in any, opts ...any) *MockServerClientCreateSecretCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogLines", reflect.TypeOf((*MockServerClient)(nil).GetLogLines), varargs...)
-	return &MockServerClientGetLogLinesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockServerClient)(nil).CreateSecret), varargs...)
+	return &MockServerClientCreateSecretCall{Call: call}
 }
 
-// MockServerClientGetLogLinesCall wrap *gomock.Call
-type MockServerClientGetLogLinesCall struct {
+// MockServerClientCreateSecretCall wrap *gomock.Call
+type MockServerClientCreateSecretCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockServerClientGetLogLinesCall) Return(arg0 *api.GetLogLinesResponse, arg1 error) *MockServerClientGetLogLinesCall {
+func (c *MockServerClientCreateSecretCall) Return(arg0 *api.CreateSecretResponse, arg1 error) *MockServerClientCreateSecretCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockServerClientGetLogLinesCall) Do(f func(context.Context, *api.GetLogLinesRequest, ...grpc.CallOption) (*api.GetLogLinesResponse, error)) *MockServerClientGetLogLinesCall {
+func (c *MockServerClientCreateSecretCall) Do(f func(context.Context, *api.CreateSecretRequest, ...grpc.CallOption) (*api.CreateSecretResponse, error)) *MockServerClientCreateSecretCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockServerClientGetLogLinesCall) DoAndReturn(f func(context.Context, *api.GetLogLinesRequest, ...grpc.CallOption) (*api.GetLogLinesResponse, error)) *MockServerClientGetLogLinesCall {
+func (c *MockServerClientCreateSecretCall) DoAndReturn(f func(context.Context, *api.CreateSecretRequest, ...grpc.CallOption) (*api.CreateSecretResponse, error)) *MockServerClientCreateSecretCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetStepExecution mocks base method.
-func (m *MockServerClient) GetStepExecution(ctx context.Context, in *api.GetStepExecutionRequest, opts ...grpc.CallOption) (*api.GetStepExecutionResponse, error) {
+// Debug mocks base method.
+func (m *MockServerClient) Debug(ctx context.Context, opts ...grpc.CallOption) (api.Server_DebugClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Debug", varargs...)
+	ret0, _ := ret[0].(api.Server_DebugClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Debug indicates an expected call of Debug.
+func (mr *MockServerClientMockRecorder) Debug(ctx any, opts ...any) *MockServerClientDebugCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Debug", reflect.TypeOf((*MockServerClient)(nil).Debug), varargs...)
+	return &MockServerClientDebugCall{Call: call}
+}
+
+// MockServerClientDebugCall wrap *gomock.Call
+type MockServerClientDebugCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientDebugCall) Return(arg0 api.Server_DebugClient, arg1 error) *MockServerClientDebugCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientDebugCall) Do(f func(context.Context, ...grpc.CallOption) (api.Server_DebugClient, error)) *MockServerClientDebugCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientDebugCall) DoAndReturn(f func(context.Context, ...grpc.CallOption) (api.Server_DebugClient, error)) *MockServerClientDebugCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteCache mocks base method.
+func (m *MockServerClient) DeleteCache(ctx context.Context, in *api.DeleteCacheRequest, opts ...grpc.CallOption) (*api.DeleteCacheResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetStepExecution", varargs...)
-	ret0, _ := ret[0].(*api.GetStepExecutionResponse)
+	ret := m.ctrl.Call(m, "DeleteCache", varargs...)
+	ret0, _ := ret[0].(*api.DeleteCacheResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetStepExecution indicates an expected call of GetStepExecution.
-func (mr *MockServerClientMockRecorder) GetStepExecution(ctx, in any, opts ...any) *MockServerClientGetStepExecutionCall {
+// DeleteCache indicates an expected call of DeleteCache.
+func (mr *MockServerClientMockRecorder) DeleteCache(ctx, in any, opts ...any) *MockServerClientDeleteCacheCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStepExecution", reflect.TypeOf((*MockServerClient)(nil).GetStepExecution), varargs...)
-	return &MockServerClientGetStepExecutionCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCache", reflect.TypeOf((*MockServerClient)(nil).DeleteCache), varargs...)
+	return &MockServerClientDeleteCacheCall{Call: call}
 }
 
-// MockServerClientGetStepExecutionCall wrap *gomock.Call
-type MockServerClientGetStepExecutionCall struct {
+// MockServerClientDeleteCacheCall wrap *gomock.Call
+type MockServerClientDeleteCacheCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockServerClientGetStepExecutionCall) Return(arg0 *api.GetStepExecutionResponse, arg1 error) *MockServerClientGetStepExecutionCall {
+func (c *MockServerClientDeleteCacheCall) Return(arg0 *api.DeleteCacheResponse, arg1 error) *MockServerClientDeleteCacheCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockServerClientGetStepExecutionCall) Do(f func(context.Context, *api.GetStepExecutionRequest, ...grpc.CallOption) (*api.GetStepExecutionResponse, error)) *MockServerClientGetStepExecutionCall {
+func (c *MockServerClientDeleteCacheCall) Do(f func(context.Context, *api.DeleteCacheRequest, ...grpc.CallOption) (*api.DeleteCacheResponse, error)) *MockServerClientDeleteCacheCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockServerClientGetStepExecutionCall) DoAndReturn(f func(context.Context, *api.GetStepExecutionRequest, ...grpc.CallOption) (*api.GetStepExecutionResponse, error)) *MockServerClientGetStepExecutionCall {
+func (c *MockServerClientDeleteCacheCall) DoAndReturn(f func(context.Context, *api.DeleteCacheRequest, ...grpc.CallOption) (*api.DeleteCacheResponse, error)) *MockServerClientDeleteCacheCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// Heartbeat mocks base method.
-func (m *MockServerClient) Heartbeat(ctx context.Context, in *api.HeartbeatRequest, opts ...grpc.CallOption) (*api.HeartbeatResponse, error) {
+// DeleteSchedule mocks base method.
+func (m *MockServerClient) DeleteSchedule(ctx context.Context, in *api.DeleteScheduleRequest, opts ...grpc.CallOption) (*api.DeleteScheduleResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "Heartbeat", varargs...)
-	ret0, _ := ret[0].(*api.HeartbeatResponse)
+	ret := m.ctrl.Call(m, "DeleteSchedule", varargs...)
+	ret0, _ := ret[0].(*api.DeleteScheduleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Heartbeat indicates an expected call of Heartbeat.
-func (mr *MockServerClientMockRecorder) Heartbeat(ctx, in any, opts ...any) *MockServerClientHeartbeatCall {
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *MockServerClientMockRecorder) DeleteSchedule(ctx, in any, opts ...any) *MockServerClientDeleteScheduleCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Heartbeat", reflect.TypeOf((*MockServerClient)(nil).Heartbeat), varargs...)
-	return &MockServerClientHeartbeatCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockServerClient)(nil).DeleteSchedule), varargs...)
+	return &MockServerClientDeleteScheduleCall{Call: call}
 }
 
-// MockServerClientHeartbeatCall wrap *gomock.Call
-type MockServerClientHeartbeatCall struct {
+// MockServerClientDeleteScheduleCall wrap *gomock.Call
+type MockServerClientDeleteScheduleCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockServerClientHeartbeatCall) Return(arg0 *api.HeartbeatResponse, arg1 error) *MockServerClientHeartbeatCall {
+func (c *MockServerClientDeleteScheduleCall) Return(arg0 *api.DeleteScheduleResponse, arg1 error) *MockServerClientDeleteScheduleCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockServerClientHeartbeatCall) Do(f func(context.Context, *api.HeartbeatRequest, ...grpc.CallOption) (*api.HeartbeatResponse, error)) *MockServerClientHeartbeatCall {
+func (c *MockServerClientDeleteScheduleCall) Do(f func(context.Context, *api.DeleteScheduleRequest, ...grpc.CallOption) (*api.DeleteScheduleResponse, error)) *MockServerClientDeleteScheduleCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockServerClientHeartbeatCall) DoAndReturn(f func(context.Context, *api.HeartbeatRequest, ...grpc.CallOption) (*api.HeartbeatResponse, error)) *MockServerClientHeartbeatCall {
+func (c *MockServerClientDeleteScheduleCall) DoAndReturn(f func(context.Context, *api.DeleteScheduleRequest, ...grpc.CallOption) (*api.DeleteScheduleResponse, error)) *MockServerClientDeleteScheduleCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ListJobExecutions mocks base method.
-func (m *MockServerClient) ListJobExecutions(ctx context.Context, in *api.ListJobExecutionsRequest, opts ...grpc.CallOption) (*api.ListJobExecutionsResponse, error) {
+// DeleteSecret mocks base method.
+func (m *MockServerClient) DeleteSecret(ctx context.Context, in *api.DeleteSecretRequest, opts ...grpc.CallOption) (*api.DeleteSecretResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListJobExecutions", varargs...)
-	ret0, _ := ret[0].(*api.ListJobExecutionsResponse)
+	ret := m.ctrl.Call(m, "DeleteSecret", varargs...)
+	ret0, _ := ret[0].(*api.DeleteSecretResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListJobExecutions indicates an expected call of ListJobExecutions.
-func (mr *MockServerClientMockRecorder) ListJobExecutions(ctx, in any, opts ...any) *MockServerClientListJobExecutionsCall {
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MockServerClientMockRecorder) DeleteSecret(ctx, in any, opts ...any) *MockServerClientDeleteSecretCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobExecutions", reflect.TypeOf((*MockServerClient)(nil).ListJobExecutions), varargs...)
-	return &MockServerClientListJobExecutionsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockServerClient)(nil).DeleteSecret), varargs...)
+	return &MockServerClientDeleteSecretCall{Call: call}
 }
 
-// MockServerClientListJobExecutionsCall wrap *gomock.Call
-type MockServerClientListJobExecutionsCall struct {
+// MockServerClientDeleteSecretCall wrap *gomock.Call
+type MockServerClientDeleteSecretCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockServerClientListJobExecutionsCall) Return(arg0 *api.ListJobExecutionsResponse, arg1 error) *MockServerClientListJobExecutionsCall {
+func (c *MockServerClientDeleteSecretCall) Return(arg0 *api.DeleteSecretResponse, arg1 error) *MockServerClientDeleteSecretCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockServerClientListJobExecutionsCall) Do(f func(context.Context, *api.ListJobExecutionsRequest, ...grpc.CallOption) (*api.ListJobExecutionsResponse, error)) *MockServerClientListJobExecutionsCall {
+func (c *MockServerClientDeleteSecretCall) Do(f func(context.Context, *api.DeleteSecretRequest, ...grpc.CallOption) (*api.DeleteSecretResponse, error)) *MockServerClientDeleteSecretCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockServerClientListJobExecutionsCall) DoAndReturn(f func(context.Context, *api.ListJobExecutionsRequest, ...grpc.CallOption) (*api.ListJobExecutionsResponse, error)) *MockServerClientListJobExecutionsCall {
+func (c *MockServerClientDeleteSecretCall) DoAndReturn(f func(context.Context, *api.DeleteSecretRequest, ...grpc.CallOption) (*api.DeleteSecretResponse, error)) *MockServerClientDeleteSecretCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// Ping mocks base method.
-func (m *MockServerClient) Ping(ctx context.Context, in *api.ServerPingRequest, opts ...grpc.CallOption) (*api.ServerPingResponse, error) {
+// DownloadArtifact mocks base method.
+func (m *MockServerClient) DownloadArtifact(ctx context.Context, in *api.DownloadArtifactRequest, opts ...grpc.CallOption) (api.Server_DownloadArtifactClient, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "Ping", varargs...)
-	ret0, _ := ret[0].(*api.ServerPingResponse)
+	ret := m.ctrl.Call(m, "DownloadArtifact", varargs...)
+	ret0, _ := ret[0].(api.Server_DownloadArtifactClient)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Ping indicates an expected call of Ping.
-func (mr *MockServerClientMockRecorder) Ping(ctx, in any, opts ...any) *MockServerClientPingCall {
+// DownloadArtifact indicates an expected call of DownloadArtifact.
+func (mr *MockServerClientMockRecorder) DownloadArtifact(ctx, in any, opts ...any) *MockServerClientDownloadArtifactCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockServerClient)(nil).Ping), varargs...)
-	return &MockServerClientPingCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadArtifact", reflect.TypeOf((*MockServerClient)(nil).DownloadArtifact), varargs...)
+	return &MockServerClientDownloadArtifactCall{Call: call}
 }
 
-// MockServerClientPingCall wrap *gomock.Call
-type MockServerClientPingCall struct {
+// MockServerClientDownloadArtifactCall wrap *gomock.Call
+type MockServerClientDownloadArtifactCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockServerClientPingCall) Return(arg0 *api.ServerPingResponse, arg1 error) *MockServerClientPingCall {
+func (c *MockServerClientDownloadArtifactCall) Return(arg0 api.Server_DownloadArtifactClient, arg1 error) *MockServerClientDownloadArtifactCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockServerClientPingCall) Do(f func(context.Context, *api.ServerPingRequest, ...grpc.CallOption) (*api.ServerPingResponse, error)) *MockServerClientPingCall {
+func (c *MockServerClientDownloadArtifactCall) Do(f func(context.Context, *api.DownloadArtifactRequest, ...grpc.CallOption) (api.Server_DownloadArtifactClient, error)) *MockServerClientDownloadArtifactCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockServerClientPingCall) DoAndReturn(f func(context.Context, *api.ServerPingRequest, ...grpc.CallOption) (*api.ServerPingResponse, error)) *MockServerClientPingCall {
+func (c *MockServerClientDownloadArtifactCall) DoAndReturn(f func(context.Context, *api.DownloadArtifactRequest, ...grpc.CallOption) (api.Server_DownloadArtifactClient, error)) *MockServerClientDownloadArtifactCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RequestJob mocks base method.
-func (m *MockServerClient) RequestJob(ctx context.Context, in *api.RequestJobRequest, opts ...grpc.CallOption) (*api.RequestJobResponse, error) {
+// DownloadCache mocks base method.
+func (m *MockServerClient) DownloadCache(ctx context.Context, in *api.DownloadCacheRequest, opts ...grpc.CallOption) (api.Server_DownloadCacheClient, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RequestJob", varargs...)
-	ret0, _ := ret[0].(*api.RequestJobResponse)
+	ret := m.ctrl.Call(m, "DownloadCache", varargs...)
+	ret0, _ := ret[0].(api.Server_DownloadCacheClient)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RequestJob indicates an expected call of RequestJob.
-func (mr *MockServerClientMockRecorder) RequestJob(ctx, in any, opts ...any) *MockServerClientRequestJobCall {
+// DownloadCache indicates an expected call of DownloadCache.
+func (mr *MockServerClientMockRecorder) DownloadCache(ctx, in any, opts ...any) *MockServerClientDownloadCacheCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestJob", reflect.TypeOf((*MockServerClient)(nil).RequestJob), varargs...)
-	return &MockServerClientRequestJobCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadCache", reflect.TypeOf((*MockServerClient)(nil).DownloadCache), varargs...)
+	return &MockServerClientDownloadCacheCall{Call: call}
 }
 
-// MockServerClientRequestJobCall wrap *gomock.Call
-type MockServerClientRequestJobCall struct {
+// MockServerClientDownloadCacheCall wrap *gomock.Call
+type MockServerClientDownloadCacheCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockServerClientRequestJobCall) Return(arg0 *api.RequestJobResponse, arg1 error) *MockServerClientRequestJobCall {
+func (c *MockServerClientDownloadCacheCall) Return(arg0 api.Server_DownloadCacheClient, arg1 error) *MockServerClientDownloadCacheCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockServerClientRequestJobCall) Do(f func(context.Context, *api.RequestJobRequest, ...grpc.CallOption) (*api.RequestJobResponse, error)) *MockServerClientRequestJobCall {
+func (c *MockServerClientDownloadCacheCall) Do(f func(context.Context, *api.DownloadCacheRequest, ...grpc.CallOption) (api.Server_DownloadCacheClient, error)) *MockServerClientDownloadCacheCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockServerClientRequestJobCall) DoAndReturn(f func(context.Context, *api.RequestJobRequest, ...grpc.CallOption) (*api.RequestJobResponse, error)) *MockServerClientRequestJobCall {
+func (c *MockServerClientDownloadCacheCall) DoAndReturn(f func(context.Context, *api.DownloadCacheRequest, ...grpc.CallOption) (api.Server_DownloadCacheClient, error)) *MockServerClientDownloadCacheCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RerunJob mocks base method.
-func (m *MockServerClient) RerunJob(ctx context.Context, in *api.RerunJobRequest, opts ...grpc.CallOption) (*api.RerunJobResponse, error) {
+// GetAgent mocks base method.
+func (m *MockServerClient) GetAgent(ctx context.Context, in *api.GetAgentRequest, opts ...grpc.CallOption) (*api.GetAgentResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RerunJob", varargs...)
-	ret0, _ := ret[0].(*api.RerunJobResponse)
+	ret := m.ctrl.Call(m, "GetAgent", varargs...)
+	ret0, _ := ret[0].(*api.GetAgentResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RerunJob indicates an expected call of RerunJob.
-func (mr *MockServerClientMockRecorder) RerunJob(ctx, in any, opts ...any) *MockServerClientRerunJobCall {
+// GetAgent indicates an expected call of GetAgent.
+func (mr *MockServerClientMockRecorder) GetAgent(ctx, in any, opts ...any) *MockServerClientGetAgentCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RerunJob", reflect.TypeOf((*MockServerClient)(nil).RerunJob), varargs...)
-	return &MockServerClientRerunJobCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgent", reflect.TypeOf((*MockServerClient)(nil).GetAgent), varargs...)
+	return &MockServerClientGetAgentCall{Call: call}
 }
 
-// MockServerClientRerunJobCall wrap *gomock.Call
-type MockServerClientRerunJobCall struct {
+// MockServerClientGetAgentCall wrap *gomock.Call
+type MockServerClientGetAgentCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockServerClientRerunJobCall) Return(arg0 *api.RerunJobResponse, arg1 error) *MockServerClientRerunJobCall {
+func (c *MockServerClientGetAgentCall) Return(arg0 *api.GetAgentResponse, arg1 error) *MockServerClientGetAgentCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockServerClientRerunJobCall) Do(f func(context.Context, *api.RerunJobRequest, ...grpc.CallOption) (*api.RerunJobResponse, error)) *MockServerClientRerunJobCall {
+func (c *MockServerClientGetAgentCall) Do(f func(context.Context, *api.GetAgentRequest, ...grpc.CallOption) (*api.GetAgentResponse, error)) *MockServerClientGetAgentCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockServerClientRerunJobCall) DoAndReturn(f func(context.Context, *api.RerunJobRequest, ...grpc.CallOption) (*api.RerunJobResponse, error)) *MockServerClientRerunJobCall {
+func (c *MockServerClientGetAgentCall) DoAndReturn(f func(context.Context, *api.GetAgentRequest, ...grpc.CallOption) (*api.GetAgentResponse, error)) *MockServerClientGetAgentCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// UpdateJobExecution mocks base method.
-func (m *MockServerClient) UpdateJobExecution(ctx context.Context, in *api.UpdateJobExecutionRequest, opts ...grpc.CallOption) (*api.UpdateJobExecutionResponse, error) {
+// GetCache mocks base method.
+func (m *MockServerClient) GetCache(ctx context.Context, in *api.GetCacheRequest, opts ...grpc.CallOption) (*api.GetCacheResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateJobExecution", varargs...)
-	ret0, _ := ret[0].(*api.UpdateJobExecutionResponse)
+	ret := m.ctrl.Call(m, "GetCache", varargs...)
+	ret0, _ := ret[0].(*api.GetCacheResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateJobExecution indicates an expected call of UpdateJobExecution.
-func (mr *MockServerClientMockRecorder) UpdateJobExecution(ctx, in any, opts ...any) *MockServerClientUpdateJobExecutionCall {
+// GetCache indicates an expected call of GetCache.
+func (mr *MockServerClientMockRecorder) GetCache(ctx, in any, opts ...any) *MockServerClientGetCacheCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobExecution", reflect.TypeOf((*MockServerClient)(nil).UpdateJobExecution), varargs...)
-	return &MockServerClientUpdateJobExecutionCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCache", reflect.TypeOf((*MockServerClient)(nil).GetCache), varargs...)
+	return &MockServerClientGetCacheCall{Call: call}
 }
 
-// MockServerClientUpdateJobExecutionCall wrap *gomock.Call
-type MockServerClientUpdateJobExecutionCall struct {
+// MockServerClientGetCacheCall wrap *gomock.Call
+type MockServerClientGetCacheCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockServerClientUpdateJobExecutionCall) Return(arg0 *api.UpdateJobExecutionResponse, arg1 error) *MockServerClientUpdateJobExecutionCall {
+func (c *MockServerClientGetCacheCall) Return(arg0 *api.GetCacheResponse, arg1 error) *MockServerClientGetCacheCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockServerClientUpdateJobExecutionCall) Do(f func(context.Context, *api.UpdateJobExecutionRequest, ...grpc.CallOption) (*api.UpdateJobExecutionResponse, error)) *MockServerClientUpdateJobExecutionCall {
+func (c *MockServerClientGetCacheCall) Do(f func(context.Context, *api.GetCacheRequest, ...grpc.CallOption) (*api.GetCacheResponse, error)) *MockServerClientGetCacheCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockServerClientUpdateJobExecutionCall) DoAndReturn(f func(context.Context, *api.UpdateJobExecutionRequest, ...grpc.CallOption) (*api.UpdateJobExecutionResponse, error)) *MockServerClientUpdateJobExecutionCall {
+func (c *MockServerClientGetCacheCall) DoAndReturn(f func(context.Context, *api.GetCacheRequest, ...grpc.CallOption) (*api.GetCacheResponse, error)) *MockServerClientGetCacheCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// UpdateStepExecution mocks base method.
-func (m *MockServerClient) UpdateStepExecution(ctx context.Context, in *api.UpdateStepExecutionRequest, opts ...grpc.CallOption) (*api.UpdateStepExecutionResponse, error) {
+// GetJobExecution mocks base method.
+func (m *MockServerClient) GetJobExecution(ctx context.Context, in *api.GetJobExecutionRequest, opts ...grpc.CallOption) (*api.GetJobExecutionResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateStepExecution", varargs...)
-	ret0, _ := ret[0].(*api.UpdateStepExecutionResponse)
+	ret := m.ctrl.Call(m, "GetJobExecution", varargs...)
+	ret0, _ := ret[0].(*api.GetJobExecutionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateStepExecution indicates an expected call of UpdateStepExecution.
-func (mr *MockServerClientMockRecorder) UpdateStepExecution(ctx, in any, opts ...any) *MockServerClientUpdateStepExecutionCall {
+// GetJobExecution indicates an expected call of GetJobExecution.
+func (mr *MockServerClientMockRecorder) GetJobExecution(ctx, in any, opts ...any) *MockServerClientGetJobExecutionCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStepExecution", reflect.TypeOf((*MockServerClient)(nil).UpdateStepExecution), varargs...)
-	return &MockServerClientUpdateStepExecutionCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobExecution", reflect.TypeOf((*MockServerClient)(nil).GetJobExecution), varargs...)
+	return &MockServerClientGetJobExecutionCall{Call: call}
 }
 
-// MockServerClientUpdateStepExecutionCall wrap *gomock.Call
-type MockServerClientUpdateStepExecutionCall struct {
+// MockServerClientGetJobExecutionCall wrap *gomock.Call
+type MockServerClientGetJobExecutionCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockServerClientUpdateStepExecutionCall) Return(arg0 *api.UpdateStepExecutionResponse, arg1 error) *MockServerClientUpdateStepExecutionCall {
+func (c *MockServerClientGetJobExecutionCall) Return(arg0 *api.GetJobExecutionResponse, arg1 error) *MockServerClientGetJobExecutionCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockServerClientUpdateStepExecutionCall) Do(f func(context.Context, *api.UpdateStepExecutionRequest, ...grpc.CallOption) (*api.UpdateStepExecutionResponse, error)) *MockServerClientUpdateStepExecutionCall {
+func (c *MockServerClientGetJobExecutionCall) Do(f func(context.Context, *api.GetJobExecutionRequest, ...grpc.CallOption) (*api.GetJobExecutionResponse, error)) *MockServerClientGetJobExecutionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockServerClientUpdateStepExecutionCall) DoAndReturn(f func(context.Context, *api.UpdateStepExecutionRequest, ...grpc.CallOption) (*api.UpdateStepExecutionResponse, error)) *MockServerClientUpdateStepExecutionCall {
+func (c *MockServerClientGetJobExecutionCall) DoAndReturn(f func(context.Context, *api.GetJobExecutionRequest, ...grpc.CallOption) (*api.GetJobExecutionResponse, error)) *MockServerClientGetJobExecutionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetLogLines mocks base method.
+func (m *MockServerClient) GetLogLines(ctx context.Context, in *api.GetLogLinesRequest, opts ...grpc.CallOption) (*api.GetLogLinesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLogLines", varargs...)
+	ret0, _ := ret[0].(*api.GetLogLinesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLogLines indicates an expected call of GetLogLines.
+func (mr *MockServerClientMockRecorder) GetLogLines(ctx, in any, opts ...any) *MockServerClientGetLogLinesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogLines", reflect.TypeOf((*MockServerClient)(nil).GetLogLines), varargs...)
+	return &MockServerClientGetLogLinesCall{Call: call}
+}
+
+// MockServerClientGetLogLinesCall wrap *gomock.Call
+type MockServerClientGetLogLinesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientGetLogLinesCall) Return(arg0 *api.GetLogLinesResponse, arg1 error) *MockServerClientGetLogLinesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientGetLogLinesCall) Do(f func(context.Context, *api.GetLogLinesRequest, ...grpc.CallOption) (*api.GetLogLinesResponse, error)) *MockServerClientGetLogLinesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientGetLogLinesCall) DoAndReturn(f func(context.Context, *api.GetLogLinesRequest, ...grpc.CallOption) (*api.GetLogLinesResponse, error)) *MockServerClientGetLogLinesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPipeline mocks base method.
+func (m *MockServerClient) GetPipeline(ctx context.Context, in *api.GetPipelineRequest, opts ...grpc.CallOption) (*api.GetPipelineResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPipeline", varargs...)
+	ret0, _ := ret[0].(*api.GetPipelineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipeline indicates an expected call of GetPipeline.
+func (mr *MockServerClientMockRecorder) GetPipeline(ctx, in any, opts ...any) *MockServerClientGetPipelineCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockServerClient)(nil).GetPipeline), varargs...)
+	return &MockServerClientGetPipelineCall{Call: call}
+}
+
+// MockServerClientGetPipelineCall wrap *gomock.Call
+type MockServerClientGetPipelineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientGetPipelineCall) Return(arg0 *api.GetPipelineResponse, arg1 error) *MockServerClientGetPipelineCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientGetPipelineCall) Do(f func(context.Context, *api.GetPipelineRequest, ...grpc.CallOption) (*api.GetPipelineResponse, error)) *MockServerClientGetPipelineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientGetPipelineCall) DoAndReturn(f func(context.Context, *api.GetPipelineRequest, ...grpc.CallOption) (*api.GetPipelineResponse, error)) *MockServerClientGetPipelineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSchedule mocks base method.
+func (m *MockServerClient) GetSchedule(ctx context.Context, in *api.GetScheduleRequest, opts ...grpc.CallOption) (*api.GetScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSchedule", varargs...)
+	ret0, _ := ret[0].(*api.GetScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedule indicates an expected call of GetSchedule.
+func (mr *MockServerClientMockRecorder) GetSchedule(ctx, in any, opts ...any) *MockServerClientGetScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedule", reflect.TypeOf((*MockServerClient)(nil).GetSchedule), varargs...)
+	return &MockServerClientGetScheduleCall{Call: call}
+}
+
+// MockServerClientGetScheduleCall wrap *gomock.Call
+type MockServerClientGetScheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientGetScheduleCall) Return(arg0 *api.GetScheduleResponse, arg1 error) *MockServerClientGetScheduleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientGetScheduleCall) Do(f func(context.Context, *api.GetScheduleRequest, ...grpc.CallOption) (*api.GetScheduleResponse, error)) *MockServerClientGetScheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientGetScheduleCall) DoAndReturn(f func(context.Context, *api.GetScheduleRequest, ...grpc.CallOption) (*api.GetScheduleResponse, error)) *MockServerClientGetScheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSecret mocks base method.
+func (m *MockServerClient) GetSecret(ctx context.Context, in *api.GetSecretRequest, opts ...grpc.CallOption) (*api.GetSecretResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSecret", varargs...)
+	ret0, _ := ret[0].(*api.GetSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecret indicates an expected call of GetSecret.
+func (mr *MockServerClientMockRecorder) GetSecret(ctx, in any, opts ...any) *MockServerClientGetSecretCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockServerClient)(nil).GetSecret), varargs...)
+	return &MockServerClientGetSecretCall{Call: call}
+}
+
+// MockServerClientGetSecretCall wrap *gomock.Call
+type MockServerClientGetSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientGetSecretCall) Return(arg0 *api.GetSecretResponse, arg1 error) *MockServerClientGetSecretCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientGetSecretCall) Do(f func(context.Context, *api.GetSecretRequest, ...grpc.CallOption) (*api.GetSecretResponse, error)) *MockServerClientGetSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientGetSecretCall) DoAndReturn(f func(context.Context, *api.GetSecretRequest, ...grpc.CallOption) (*api.GetSecretResponse, error)) *MockServerClientGetSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetStepExecution mocks base method.
+func (m *MockServerClient) GetStepExecution(ctx context.Context, in *api.GetStepExecutionRequest, opts ...grpc.CallOption) (*api.GetStepExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStepExecution", varargs...)
+	ret0, _ := ret[0].(*api.GetStepExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStepExecution indicates an expected call of GetStepExecution.
+func (mr *MockServerClientMockRecorder) GetStepExecution(ctx, in any, opts ...any) *MockServerClientGetStepExecutionCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStepExecution", reflect.TypeOf((*MockServerClient)(nil).GetStepExecution), varargs...)
+	return &MockServerClientGetStepExecutionCall{Call: call}
+}
+
+// MockServerClientGetStepExecutionCall wrap *gomock.Call
+type MockServerClientGetStepExecutionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientGetStepExecutionCall) Return(arg0 *api.GetStepExecutionResponse, arg1 error) *MockServerClientGetStepExecutionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientGetStepExecutionCall) Do(f func(context.Context, *api.GetStepExecutionRequest, ...grpc.CallOption) (*api.GetStepExecutionResponse, error)) *MockServerClientGetStepExecutionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientGetStepExecutionCall) DoAndReturn(f func(context.Context, *api.GetStepExecutionRequest, ...grpc.CallOption) (*api.GetStepExecutionResponse, error)) *MockServerClientGetStepExecutionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Heartbeat mocks base method.
+func (m *MockServerClient) Heartbeat(ctx context.Context, in *api.HeartbeatRequest, opts ...grpc.CallOption) (*api.HeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Heartbeat", varargs...)
+	ret0, _ := ret[0].(*api.HeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Heartbeat indicates an expected call of Heartbeat.
+func (mr *MockServerClientMockRecorder) Heartbeat(ctx, in any, opts ...any) *MockServerClientHeartbeatCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Heartbeat", reflect.TypeOf((*MockServerClient)(nil).Heartbeat), varargs...)
+	return &MockServerClientHeartbeatCall{Call: call}
+}
+
+// MockServerClientHeartbeatCall wrap *gomock.Call
+type MockServerClientHeartbeatCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientHeartbeatCall) Return(arg0 *api.HeartbeatResponse, arg1 error) *MockServerClientHeartbeatCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientHeartbeatCall) Do(f func(context.Context, *api.HeartbeatRequest, ...grpc.CallOption) (*api.HeartbeatResponse, error)) *MockServerClientHeartbeatCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientHeartbeatCall) DoAndReturn(f func(context.Context, *api.HeartbeatRequest, ...grpc.CallOption) (*api.HeartbeatResponse, error)) *MockServerClientHeartbeatCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListAgents mocks base method.
+func (m *MockServerClient) ListAgents(ctx context.Context, in *api.ListAgentsRequest, opts ...grpc.CallOption) (*api.ListAgentsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAgents", varargs...)
+	ret0, _ := ret[0].(*api.ListAgentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAgents indicates an expected call of ListAgents.
+func (mr *MockServerClientMockRecorder) ListAgents(ctx, in any, opts ...any) *MockServerClientListAgentsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAgents", reflect.TypeOf((*MockServerClient)(nil).ListAgents), varargs...)
+	return &MockServerClientListAgentsCall{Call: call}
+}
+
+// MockServerClientListAgentsCall wrap *gomock.Call
+type MockServerClientListAgentsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientListAgentsCall) Return(arg0 *api.ListAgentsResponse, arg1 error) *MockServerClientListAgentsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientListAgentsCall) Do(f func(context.Context, *api.ListAgentsRequest, ...grpc.CallOption) (*api.ListAgentsResponse, error)) *MockServerClientListAgentsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientListAgentsCall) DoAndReturn(f func(context.Context, *api.ListAgentsRequest, ...grpc.CallOption) (*api.ListAgentsResponse, error)) *MockServerClientListAgentsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListArtifacts mocks base method.
+func (m *MockServerClient) ListArtifacts(ctx context.Context, in *api.ListArtifactsRequest, opts ...grpc.CallOption) (*api.ListArtifactsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListArtifacts", varargs...)
+	ret0, _ := ret[0].(*api.ListArtifactsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListArtifacts indicates an expected call of ListArtifacts.
+func (mr *MockServerClientMockRecorder) ListArtifacts(ctx, in any, opts ...any) *MockServerClientListArtifactsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArtifacts", reflect.TypeOf((*MockServerClient)(nil).ListArtifacts), varargs...)
+	return &MockServerClientListArtifactsCall{Call: call}
+}
+
+// MockServerClientListArtifactsCall wrap *gomock.Call
+type MockServerClientListArtifactsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientListArtifactsCall) Return(arg0 *api.ListArtifactsResponse, arg1 error) *MockServerClientListArtifactsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientListArtifactsCall) Do(f func(context.Context, *api.ListArtifactsRequest, ...grpc.CallOption) (*api.ListArtifactsResponse, error)) *MockServerClientListArtifactsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientListArtifactsCall) DoAndReturn(f func(context.Context, *api.ListArtifactsRequest, ...grpc.CallOption) (*api.ListArtifactsResponse, error)) *MockServerClientListArtifactsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListCaches mocks base method.
+func (m *MockServerClient) ListCaches(ctx context.Context, in *api.ListCachesRequest, opts ...grpc.CallOption) (*api.ListCachesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCaches", varargs...)
+	ret0, _ := ret[0].(*api.ListCachesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCaches indicates an expected call of ListCaches.
+func (mr *MockServerClientMockRecorder) ListCaches(ctx, in any, opts ...any) *MockServerClientListCachesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCaches", reflect.TypeOf((*MockServerClient)(nil).ListCaches), varargs...)
+	return &MockServerClientListCachesCall{Call: call}
+}
+
+// MockServerClientListCachesCall wrap *gomock.Call
+type MockServerClientListCachesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientListCachesCall) Return(arg0 *api.ListCachesResponse, arg1 error) *MockServerClientListCachesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientListCachesCall) Do(f func(context.Context, *api.ListCachesRequest, ...grpc.CallOption) (*api.ListCachesResponse, error)) *MockServerClientListCachesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientListCachesCall) DoAndReturn(f func(context.Context, *api.ListCachesRequest, ...grpc.CallOption) (*api.ListCachesResponse, error)) *MockServerClientListCachesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListJobExecutions mocks base method.
+func (m *MockServerClient) ListJobExecutions(ctx context.Context, in *api.ListJobExecutionsRequest, opts ...grpc.CallOption) (*api.ListJobExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListJobExecutions", varargs...)
+	ret0, _ := ret[0].(*api.ListJobExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobExecutions indicates an expected call of ListJobExecutions.
+func (mr *MockServerClientMockRecorder) ListJobExecutions(ctx, in any, opts ...any) *MockServerClientListJobExecutionsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobExecutions", reflect.TypeOf((*MockServerClient)(nil).ListJobExecutions), varargs...)
+	return &MockServerClientListJobExecutionsCall{Call: call}
+}
+
+// MockServerClientListJobExecutionsCall wrap *gomock.Call
+type MockServerClientListJobExecutionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientListJobExecutionsCall) Return(arg0 *api.ListJobExecutionsResponse, arg1 error) *MockServerClientListJobExecutionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientListJobExecutionsCall) Do(f func(context.Context, *api.ListJobExecutionsRequest, ...grpc.CallOption) (*api.ListJobExecutionsResponse, error)) *MockServerClientListJobExecutionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientListJobExecutionsCall) DoAndReturn(f func(context.Context, *api.ListJobExecutionsRequest, ...grpc.CallOption) (*api.ListJobExecutionsResponse, error)) *MockServerClientListJobExecutionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListPipelines mocks base method.
+func (m *MockServerClient) ListPipelines(ctx context.Context, in *api.ListPipelinesRequest, opts ...grpc.CallOption) (*api.ListPipelinesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPipelines", varargs...)
+	ret0, _ := ret[0].(*api.ListPipelinesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelines indicates an expected call of ListPipelines.
+func (mr *MockServerClientMockRecorder) ListPipelines(ctx, in any, opts ...any) *MockServerClientListPipelinesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockServerClient)(nil).ListPipelines), varargs...)
+	return &MockServerClientListPipelinesCall{Call: call}
+}
+
+// MockServerClientListPipelinesCall wrap *gomock.Call
+type MockServerClientListPipelinesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientListPipelinesCall) Return(arg0 *api.ListPipelinesResponse, arg1 error) *MockServerClientListPipelinesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientListPipelinesCall) Do(f func(context.Context, *api.ListPipelinesRequest, ...grpc.CallOption) (*api.ListPipelinesResponse, error)) *MockServerClientListPipelinesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientListPipelinesCall) DoAndReturn(f func(context.Context, *api.ListPipelinesRequest, ...grpc.CallOption) (*api.ListPipelinesResponse, error)) *MockServerClientListPipelinesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSchedules mocks base method.
+func (m *MockServerClient) ListSchedules(ctx context.Context, in *api.ListSchedulesRequest, opts ...grpc.CallOption) (*api.ListSchedulesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSchedules", varargs...)
+	ret0, _ := ret[0].(*api.ListSchedulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchedules indicates an expected call of ListSchedules.
+func (mr *MockServerClientMockRecorder) ListSchedules(ctx, in any, opts ...any) *MockServerClientListSchedulesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*MockServerClient)(nil).ListSchedules), varargs...)
+	return &MockServerClientListSchedulesCall{Call: call}
+}
+
+// MockServerClientListSchedulesCall wrap *gomock.Call
+type MockServerClientListSchedulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientListSchedulesCall) Return(arg0 *api.ListSchedulesResponse, arg1 error) *MockServerClientListSchedulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientListSchedulesCall) Do(f func(context.Context, *api.ListSchedulesRequest, ...grpc.CallOption) (*api.ListSchedulesResponse, error)) *MockServerClientListSchedulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientListSchedulesCall) DoAndReturn(f func(context.Context, *api.ListSchedulesRequest, ...grpc.CallOption) (*api.ListSchedulesResponse, error)) *MockServerClientListSchedulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSecrets mocks base method.
+func (m *MockServerClient) ListSecrets(ctx context.Context, in *api.ListSecretsRequest, opts ...grpc.CallOption) (*api.ListSecretsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecrets", varargs...)
+	ret0, _ := ret[0].(*api.ListSecretsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MockServerClientMockRecorder) ListSecrets(ctx, in any, opts ...any) *MockServerClientListSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockServerClient)(nil).ListSecrets), varargs...)
+	return &MockServerClientListSecretsCall{Call: call}
+}
+
+// MockServerClientListSecretsCall wrap *gomock.Call
+type MockServerClientListSecretsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientListSecretsCall) Return(arg0 *api.ListSecretsResponse, arg1 error) *MockServerClientListSecretsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientListSecretsCall) Do(f func(context.Context, *api.ListSecretsRequest, ...grpc.CallOption) (*api.ListSecretsResponse, error)) *MockServerClientListSecretsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientListSecretsCall) DoAndReturn(f func(context.Context, *api.ListSecretsRequest, ...grpc.CallOption) (*api.ListSecretsResponse, error)) *MockServerClientListSecretsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Ping mocks base method.
+func (m *MockServerClient) Ping(ctx context.Context, in *api.ServerPingRequest, opts ...grpc.CallOption) (*api.ServerPingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Ping", varargs...)
+	ret0, _ := ret[0].(*api.ServerPingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockServerClientMockRecorder) Ping(ctx, in any, opts ...any) *MockServerClientPingCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockServerClient)(nil).Ping), varargs...)
+	return &MockServerClientPingCall{Call: call}
+}
+
+// MockServerClientPingCall wrap *gomock.Call
+type MockServerClientPingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientPingCall) Return(arg0 *api.ServerPingResponse, arg1 error) *MockServerClientPingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientPingCall) Do(f func(context.Context, *api.ServerPingRequest, ...grpc.CallOption) (*api.ServerPingResponse, error)) *MockServerClientPingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientPingCall) DoAndReturn(f func(context.Context, *api.ServerPingRequest, ...grpc.CallOption) (*api.ServerPingResponse, error)) *MockServerClientPingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RegisterAgent mocks base method.
+func (m *MockServerClient) RegisterAgent(ctx context.Context, in *api.RegisterAgentRequest, opts ...grpc.CallOption) (*api.RegisterAgentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterAgent", varargs...)
+	ret0, _ := ret[0].(*api.RegisterAgentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterAgent indicates an expected call of RegisterAgent.
+func (mr *MockServerClientMockRecorder) RegisterAgent(ctx, in any, opts ...any) *MockServerClientRegisterAgentCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterAgent", reflect.TypeOf((*MockServerClient)(nil).RegisterAgent), varargs...)
+	return &MockServerClientRegisterAgentCall{Call: call}
+}
+
+// MockServerClientRegisterAgentCall wrap *gomock.Call
+type MockServerClientRegisterAgentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientRegisterAgentCall) Return(arg0 *api.RegisterAgentResponse, arg1 error) *MockServerClientRegisterAgentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientRegisterAgentCall) Do(f func(context.Context, *api.RegisterAgentRequest, ...grpc.CallOption) (*api.RegisterAgentResponse, error)) *MockServerClientRegisterAgentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientRegisterAgentCall) DoAndReturn(f func(context.Context, *api.RegisterAgentRequest, ...grpc.CallOption) (*api.RegisterAgentResponse, error)) *MockServerClientRegisterAgentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RequestJob mocks base method.
+func (m *MockServerClient) RequestJob(ctx context.Context, in *api.RequestJobRequest, opts ...grpc.CallOption) (*api.RequestJobResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequestJob", varargs...)
+	ret0, _ := ret[0].(*api.RequestJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestJob indicates an expected call of RequestJob.
+func (mr *MockServerClientMockRecorder) RequestJob(ctx, in any, opts ...any) *MockServerClientRequestJobCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestJob", reflect.TypeOf((*MockServerClient)(nil).RequestJob), varargs...)
+	return &MockServerClientRequestJobCall{Call: call}
+}
+
+// MockServerClientRequestJobCall wrap *gomock.Call
+type MockServerClientRequestJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientRequestJobCall) Return(arg0 *api.RequestJobResponse, arg1 error) *MockServerClientRequestJobCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientRequestJobCall) Do(f func(context.Context, *api.RequestJobRequest, ...grpc.CallOption) (*api.RequestJobResponse, error)) *MockServerClientRequestJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientRequestJobCall) DoAndReturn(f func(context.Context, *api.RequestJobRequest, ...grpc.CallOption) (*api.RequestJobResponse, error)) *MockServerClientRequestJobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RerunJob mocks base method.
+func (m *MockServerClient) RerunJob(ctx context.Context, in *api.RerunJobRequest, opts ...grpc.CallOption) (*api.RerunJobResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RerunJob", varargs...)
+	ret0, _ := ret[0].(*api.RerunJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RerunJob indicates an expected call of RerunJob.
+func (mr *MockServerClientMockRecorder) RerunJob(ctx, in any, opts ...any) *MockServerClientRerunJobCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RerunJob", reflect.TypeOf((*MockServerClient)(nil).RerunJob), varargs...)
+	return &MockServerClientRerunJobCall{Call: call}
+}
+
+// MockServerClientRerunJobCall wrap *gomock.Call
+type MockServerClientRerunJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientRerunJobCall) Return(arg0 *api.RerunJobResponse, arg1 error) *MockServerClientRerunJobCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientRerunJobCall) Do(f func(context.Context, *api.RerunJobRequest, ...grpc.CallOption) (*api.RerunJobResponse, error)) *MockServerClientRerunJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientRerunJobCall) DoAndReturn(f func(context.Context, *api.RerunJobRequest, ...grpc.CallOption) (*api.RerunJobResponse, error)) *MockServerClientRerunJobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RerunPipeline mocks base method.
+func (m *MockServerClient) RerunPipeline(ctx context.Context, in *api.RerunPipelineRequest, opts ...grpc.CallOption) (*api.RerunPipelineResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RerunPipeline", varargs...)
+	ret0, _ := ret[0].(*api.RerunPipelineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RerunPipeline indicates an expected call of RerunPipeline.
+func (mr *MockServerClientMockRecorder) RerunPipeline(ctx, in any, opts ...any) *MockServerClientRerunPipelineCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RerunPipeline", reflect.TypeOf((*MockServerClient)(nil).RerunPipeline), varargs...)
+	return &MockServerClientRerunPipelineCall{Call: call}
+}
+
+// MockServerClientRerunPipelineCall wrap *gomock.Call
+type MockServerClientRerunPipelineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientRerunPipelineCall) Return(arg0 *api.RerunPipelineResponse, arg1 error) *MockServerClientRerunPipelineCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientRerunPipelineCall) Do(f func(context.Context, *api.RerunPipelineRequest, ...grpc.CallOption) (*api.RerunPipelineResponse, error)) *MockServerClientRerunPipelineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientRerunPipelineCall) DoAndReturn(f func(context.Context, *api.RerunPipelineRequest, ...grpc.CallOption) (*api.RerunPipelineResponse, error)) *MockServerClientRerunPipelineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RetryStepExecution mocks base method.
+func (m *MockServerClient) RetryStepExecution(ctx context.Context, in *api.RetryStepExecutionRequest, opts ...grpc.CallOption) (*api.RetryStepExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RetryStepExecution", varargs...)
+	ret0, _ := ret[0].(*api.RetryStepExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryStepExecution indicates an expected call of RetryStepExecution.
+func (mr *MockServerClientMockRecorder) RetryStepExecution(ctx, in any, opts ...any) *MockServerClientRetryStepExecutionCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryStepExecution", reflect.TypeOf((*MockServerClient)(nil).RetryStepExecution), varargs...)
+	return &MockServerClientRetryStepExecutionCall{Call: call}
+}
+
+// MockServerClientRetryStepExecutionCall wrap *gomock.Call
+type MockServerClientRetryStepExecutionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientRetryStepExecutionCall) Return(arg0 *api.RetryStepExecutionResponse, arg1 error) *MockServerClientRetryStepExecutionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientRetryStepExecutionCall) Do(f func(context.Context, *api.RetryStepExecutionRequest, ...grpc.CallOption) (*api.RetryStepExecutionResponse, error)) *MockServerClientRetryStepExecutionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientRetryStepExecutionCall) DoAndReturn(f func(context.Context, *api.RetryStepExecutionRequest, ...grpc.CallOption) (*api.RetryStepExecutionResponse, error)) *MockServerClientRetryStepExecutionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ServeDebug mocks base method.
+func (m *MockServerClient) ServeDebug(ctx context.Context, opts ...grpc.CallOption) (api.Server_ServeDebugClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ServeDebug", varargs...)
+	ret0, _ := ret[0].(api.Server_ServeDebugClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServeDebug indicates an expected call of ServeDebug.
+func (mr *MockServerClientMockRecorder) ServeDebug(ctx any, opts ...any) *MockServerClientServeDebugCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServeDebug", reflect.TypeOf((*MockServerClient)(nil).ServeDebug), varargs...)
+	return &MockServerClientServeDebugCall{Call: call}
+}
+
+// MockServerClientServeDebugCall wrap *gomock.Call
+type MockServerClientServeDebugCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientServeDebugCall) Return(arg0 api.Server_ServeDebugClient, arg1 error) *MockServerClientServeDebugCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientServeDebugCall) Do(f func(context.Context, ...grpc.CallOption) (api.Server_ServeDebugClient, error)) *MockServerClientServeDebugCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientServeDebugCall) DoAndReturn(f func(context.Context, ...grpc.CallOption) (api.Server_ServeDebugClient, error)) *MockServerClientServeDebugCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetCacheLimit mocks base method.
+func (m *MockServerClient) SetCacheLimit(ctx context.Context, in *api.SetCacheLimitRequest, opts ...grpc.CallOption) (*api.SetCacheLimitResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetCacheLimit", varargs...)
+	ret0, _ := ret[0].(*api.SetCacheLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCacheLimit indicates an expected call of SetCacheLimit.
+func (mr *MockServerClientMockRecorder) SetCacheLimit(ctx, in any, opts ...any) *MockServerClientSetCacheLimitCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCacheLimit", reflect.TypeOf((*MockServerClient)(nil).SetCacheLimit), varargs...)
+	return &MockServerClientSetCacheLimitCall{Call: call}
+}
+
+// MockServerClientSetCacheLimitCall wrap *gomock.Call
+type MockServerClientSetCacheLimitCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientSetCacheLimitCall) Return(arg0 *api.SetCacheLimitResponse, arg1 error) *MockServerClientSetCacheLimitCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientSetCacheLimitCall) Do(f func(context.Context, *api.SetCacheLimitRequest, ...grpc.CallOption) (*api.SetCacheLimitResponse, error)) *MockServerClientSetCacheLimitCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientSetCacheLimitCall) DoAndReturn(f func(context.Context, *api.SetCacheLimitRequest, ...grpc.CallOption) (*api.SetCacheLimitResponse, error)) *MockServerClientSetCacheLimitCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StreamLogLines mocks base method.
+func (m *MockServerClient) StreamLogLines(ctx context.Context, in *api.StreamLogLinesRequest, opts ...grpc.CallOption) (api.Server_StreamLogLinesClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamLogLines", varargs...)
+	ret0, _ := ret[0].(api.Server_StreamLogLinesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamLogLines indicates an expected call of StreamLogLines.
+func (mr *MockServerClientMockRecorder) StreamLogLines(ctx, in any, opts ...any) *MockServerClientStreamLogLinesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamLogLines", reflect.TypeOf((*MockServerClient)(nil).StreamLogLines), varargs...)
+	return &MockServerClientStreamLogLinesCall{Call: call}
+}
+
+// MockServerClientStreamLogLinesCall wrap *gomock.Call
+type MockServerClientStreamLogLinesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientStreamLogLinesCall) Return(arg0 api.Server_StreamLogLinesClient, arg1 error) *MockServerClientStreamLogLinesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientStreamLogLinesCall) Do(f func(context.Context, *api.StreamLogLinesRequest, ...grpc.CallOption) (api.Server_StreamLogLinesClient, error)) *MockServerClientStreamLogLinesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientStreamLogLinesCall) DoAndReturn(f func(context.Context, *api.StreamLogLinesRequest, ...grpc.CallOption) (api.Server_StreamLogLinesClient, error)) *MockServerClientStreamLogLinesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateJobExecution mocks base method.
+func (m *MockServerClient) UpdateJobExecution(ctx context.Context, in *api.UpdateJobExecutionRequest, opts ...grpc.CallOption) (*api.UpdateJobExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateJobExecution", varargs...)
+	ret0, _ := ret[0].(*api.UpdateJobExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateJobExecution indicates an expected call of UpdateJobExecution.
+func (mr *MockServerClientMockRecorder) UpdateJobExecution(ctx, in any, opts ...any) *MockServerClientUpdateJobExecutionCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobExecution", reflect.TypeOf((*MockServerClient)(nil).UpdateJobExecution), varargs...)
+	return &MockServerClientUpdateJobExecutionCall{Call: call}
+}
+
+// MockServerClientUpdateJobExecutionCall wrap *gomock.Call
+type MockServerClientUpdateJobExecutionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientUpdateJobExecutionCall) Return(arg0 *api.UpdateJobExecutionResponse, arg1 error) *MockServerClientUpdateJobExecutionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientUpdateJobExecutionCall) Do(f func(context.Context, *api.UpdateJobExecutionRequest, ...grpc.CallOption) (*api.UpdateJobExecutionResponse, error)) *MockServerClientUpdateJobExecutionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientUpdateJobExecutionCall) DoAndReturn(f func(context.Context, *api.UpdateJobExecutionRequest, ...grpc.CallOption) (*api.UpdateJobExecutionResponse, error)) *MockServerClientUpdateJobExecutionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateSchedule mocks base method.
+func (m *MockServerClient) UpdateSchedule(ctx context.Context, in *api.UpdateScheduleRequest, opts ...grpc.CallOption) (*api.UpdateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSchedule", varargs...)
+	ret0, _ := ret[0].(*api.UpdateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule.
+func (mr *MockServerClientMockRecorder) UpdateSchedule(ctx, in any, opts ...any) *MockServerClientUpdateScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockServerClient)(nil).UpdateSchedule), varargs...)
+	return &MockServerClientUpdateScheduleCall{Call: call}
+}
+
+// MockServerClientUpdateScheduleCall wrap *gomock.Call
+type MockServerClientUpdateScheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientUpdateScheduleCall) Return(arg0 *api.UpdateScheduleResponse, arg1 error) *MockServerClientUpdateScheduleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientUpdateScheduleCall) Do(f func(context.Context, *api.UpdateScheduleRequest, ...grpc.CallOption) (*api.UpdateScheduleResponse, error)) *MockServerClientUpdateScheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientUpdateScheduleCall) DoAndReturn(f func(context.Context, *api.UpdateScheduleRequest, ...grpc.CallOption) (*api.UpdateScheduleResponse, error)) *MockServerClientUpdateScheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateSecret mocks base method.
+func (m *MockServerClient) UpdateSecret(ctx context.Context, in *api.UpdateSecretRequest, opts ...grpc.CallOption) (*api.UpdateSecretResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSecret", varargs...)
+	ret0, _ := ret[0].(*api.UpdateSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSecret indicates an expected call of UpdateSecret.
+func (mr *MockServerClientMockRecorder) UpdateSecret(ctx, in any, opts ...any) *MockServerClientUpdateSecretCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecret", reflect.TypeOf((*MockServerClient)(nil).UpdateSecret), varargs...)
+	return &MockServerClientUpdateSecretCall{Call: call}
+}
+
+// MockServerClientUpdateSecretCall wrap *gomock.Call
+type MockServerClientUpdateSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientUpdateSecretCall) Return(arg0 *api.UpdateSecretResponse, arg1 error) *MockServerClientUpdateSecretCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientUpdateSecretCall) Do(f func(context.Context, *api.UpdateSecretRequest, ...grpc.CallOption) (*api.UpdateSecretResponse, error)) *MockServerClientUpdateSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientUpdateSecretCall) DoAndReturn(f func(context.Context, *api.UpdateSecretRequest, ...grpc.CallOption) (*api.UpdateSecretResponse, error)) *MockServerClientUpdateSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateStepExecution mocks base method.
+func (m *MockServerClient) UpdateStepExecution(ctx context.Context, in *api.UpdateStepExecutionRequest, opts ...grpc.CallOption) (*api.UpdateStepExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateStepExecution", varargs...)
+	ret0, _ := ret[0].(*api.UpdateStepExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStepExecution indicates an expected call of UpdateStepExecution.
+func (mr *MockServerClientMockRecorder) UpdateStepExecution(ctx, in any, opts ...any) *MockServerClientUpdateStepExecutionCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStepExecution", reflect.TypeOf((*MockServerClient)(nil).UpdateStepExecution), varargs...)
+	return &MockServerClientUpdateStepExecutionCall{Call: call}
+}
+
+// MockServerClientUpdateStepExecutionCall wrap *gomock.Call
+type MockServerClientUpdateStepExecutionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientUpdateStepExecutionCall) Return(arg0 *api.UpdateStepExecutionResponse, arg1 error) *MockServerClientUpdateStepExecutionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientUpdateStepExecutionCall) Do(f func(context.Context, *api.UpdateStepExecutionRequest, ...grpc.CallOption) (*api.UpdateStepExecutionResponse, error)) *MockServerClientUpdateStepExecutionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientUpdateStepExecutionCall) DoAndReturn(f func(context.Context, *api.UpdateStepExecutionRequest, ...grpc.CallOption) (*api.UpdateStepExecutionResponse, error)) *MockServerClientUpdateStepExecutionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UploadArtifact mocks base method.
+func (m *MockServerClient) UploadArtifact(ctx context.Context, opts ...grpc.CallOption) (api.Server_UploadArtifactClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UploadArtifact", varargs...)
+	ret0, _ := ret[0].(api.Server_UploadArtifactClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadArtifact indicates an expected call of UploadArtifact.
+func (mr *MockServerClientMockRecorder) UploadArtifact(ctx any, opts ...any) *MockServerClientUploadArtifactCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifact", reflect.TypeOf((*MockServerClient)(nil).UploadArtifact), varargs...)
+	return &MockServerClientUploadArtifactCall{Call: call}
+}
+
+// MockServerClientUploadArtifactCall wrap *gomock.Call
+type MockServerClientUploadArtifactCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientUploadArtifactCall) Return(arg0 api.Server_UploadArtifactClient, arg1 error) *MockServerClientUploadArtifactCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientUploadArtifactCall) Do(f func(context.Context, ...grpc.CallOption) (api.Server_UploadArtifactClient, error)) *MockServerClientUploadArtifactCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientUploadArtifactCall) DoAndReturn(f func(context.Context, ...grpc.CallOption) (api.Server_UploadArtifactClient, error)) *MockServerClientUploadArtifactCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UploadCache mocks base method.
+func (m *MockServerClient) UploadCache(ctx context.Context, opts ...grpc.CallOption) (api.Server_UploadCacheClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UploadCache", varargs...)
+	ret0, _ := ret[0].(api.Server_UploadCacheClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadCache indicates an expected call of UploadCache.
+func (mr *MockServerClientMockRecorder) UploadCache(ctx any, opts ...any) *MockServerClientUploadCacheCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadCache", reflect.TypeOf((*MockServerClient)(nil).UploadCache), varargs...)
+	return &MockServerClientUploadCacheCall{Call: call}
+}
+
+// MockServerClientUploadCacheCall wrap *gomock.Call
+type MockServerClientUploadCacheCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClientUploadCacheCall) Return(arg0 api.Server_UploadCacheClient, arg1 error) *MockServerClientUploadCacheCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClientUploadCacheCall) Do(f func(context.Context, ...grpc.CallOption) (api.Server_UploadCacheClient, error)) *MockServerClientUploadCacheCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClientUploadCacheCall) DoAndReturn(f func(context.Context, ...grpc.CallOption) (api.Server_UploadCacheClient, error)) *MockServerClientUploadCacheCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -24,17 +24,74 @@ const _ = grpc.SupportPackageIsVersion7
 type ServerClient interface {
 	Ping(ctx context.Context, in *ServerPingRequest, opts ...grpc.CallOption) (*ServerPingResponse, error)
 	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*CreatePipelineResponse, error)
+	GetPipeline(ctx context.Context, in *GetPipelineRequest, opts ...grpc.CallOption) (*GetPipelineResponse, error)
+	ListPipelines(ctx context.Context, in *ListPipelinesRequest, opts ...grpc.CallOption) (*ListPipelinesResponse, error)
 	RequestJob(ctx context.Context, in *RequestJobRequest, opts ...grpc.CallOption) (*RequestJobResponse, error)
 	RerunJob(ctx context.Context, in *RerunJobRequest, opts ...grpc.CallOption) (*RerunJobResponse, error)
+	RerunPipeline(ctx context.Context, in *RerunPipelineRequest, opts ...grpc.CallOption) (*RerunPipelineResponse, error)
 	GetJobExecution(ctx context.Context, in *GetJobExecutionRequest, opts ...grpc.CallOption) (*GetJobExecutionResponse, error)
 	CancelJobExecution(ctx context.Context, in *CancelJobExecutionRequest, opts ...grpc.CallOption) (*CancelJobExecutionResponse, error)
 	ListJobExecutions(ctx context.Context, in *ListJobExecutionsRequest, opts ...grpc.CallOption) (*ListJobExecutionsResponse, error)
 	UpdateJobExecution(ctx context.Context, in *UpdateJobExecutionRequest, opts ...grpc.CallOption) (*UpdateJobExecutionResponse, error)
 	GetStepExecution(ctx context.Context, in *GetStepExecutionRequest, opts ...grpc.CallOption) (*GetStepExecutionResponse, error)
 	UpdateStepExecution(ctx context.Context, in *UpdateStepExecutionRequest, opts ...grpc.CallOption) (*UpdateStepExecutionResponse, error)
+	// RetryStepExecution creates a new step execution as the next attempt of a failed step execution.
+	RetryStepExecution(ctx context.Context, in *RetryStepExecutionRequest, opts ...grpc.CallOption) (*RetryStepExecutionResponse, error)
 	UploadLogLines(ctx context.Context, in *UpdateLogLinesRequest, opts ...grpc.CallOption) (*UpdateLogLinesResponse, error)
 	GetLogLines(ctx context.Context, in *GetLogLinesRequest, opts ...grpc.CallOption) (*GetLogLinesResponse, error)
+	StreamLogLines(ctx context.Context, in *StreamLogLinesRequest, opts ...grpc.CallOption) (Server_StreamLogLinesClient, error)
 	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
+	// UploadArtifact uploads an artifact of a job execution. The metadata is carried by the first message, and the
+	// data of all messages are concatenated as the content of the artifact.
+	UploadArtifact(ctx context.Context, opts ...grpc.CallOption) (Server_UploadArtifactClient, error)
+	// ListArtifacts lists artifacts of a pipeline, expired ones are not included.
+	ListArtifacts(ctx context.Context, in *ListArtifactsRequest, opts ...grpc.CallOption) (*ListArtifactsResponse, error)
+	// DownloadArtifact streams the content of an artifact. It's a tar archive.
+	DownloadArtifact(ctx context.Context, in *DownloadArtifactRequest, opts ...grpc.CallOption) (Server_DownloadArtifactClient, error)
+	// GetCache finds the cache for a job execution in caches of the label it runs on. Caches are scoped by the canonical
+	// form of the label expression in runs_on, so equivalent expressions share caches. It matches the key exactly
+	// first, then the restore keys as prefixes in order. The cache is not set if nothing matches.
+	GetCache(ctx context.Context, in *GetCacheRequest, opts ...grpc.CallOption) (*GetCacheResponse, error)
+	// UploadCache saves a cache for the label a job execution runs on. The metadata is carried by the first message,
+	// and the data of all messages are concatenated as the content of the cache.
+	UploadCache(ctx context.Context, opts ...grpc.CallOption) (Server_UploadCacheClient, error)
+	// DownloadCache streams the content of a cache. It's a tar archive.
+	DownloadCache(ctx context.Context, in *DownloadCacheRequest, opts ...grpc.CallOption) (Server_DownloadCacheClient, error)
+	// ListCaches lists caches of a label with the usage of the label.
+	ListCaches(ctx context.Context, in *ListCachesRequest, opts ...grpc.CallOption) (*ListCachesResponse, error)
+	// DeleteCache evicts a cache.
+	DeleteCache(ctx context.Context, in *DeleteCacheRequest, opts ...grpc.CallOption) (*DeleteCacheResponse, error)
+	// SetCacheLimit sets the max total size of caches of a label. Least recently used caches are evicted
+	// when the limit is exceeded.
+	SetCacheLimit(ctx context.Context, in *SetCacheLimitRequest, opts ...grpc.CallOption) (*SetCacheLimitResponse, error)
+	// Debug runs a command interactively in the runner of a job execution. The stream is relayed through the agent
+	// running the job execution, so the runner must be running or kept alive by keep_alive_on_failure.
+	// The job execution and the command are carried by the first message.
+	Debug(ctx context.Context, opts ...grpc.CallOption) (Server_DebugClient, error)
+	// ServeDebug is called by agents to serve a debug session announced by HeartbeatResponse. The session id is
+	// carried by the first message, and the requests of the session are streamed back.
+	ServeDebug(ctx context.Context, opts ...grpc.CallOption) (Server_ServeDebugClient, error)
+	// RegisterAgent is called by agents on starting. Agents with the same name share the same record,
+	// so the record is updated if the agent is registered before.
+	RegisterAgent(ctx context.Context, in *RegisterAgentRequest, opts ...grpc.CallOption) (*RegisterAgentResponse, error)
+	// AgentHeartbeat reports the running jobs of an agent. Agents without heartbeats are considered offline.
+	AgentHeartbeat(ctx context.Context, in *AgentHeartbeatRequest, opts ...grpc.CallOption) (*AgentHeartbeatResponse, error)
+	ListAgents(ctx context.Context, in *ListAgentsRequest, opts ...grpc.CallOption) (*ListAgentsResponse, error)
+	GetAgent(ctx context.Context, in *GetAgentRequest, opts ...grpc.CallOption) (*GetAgentResponse, error)
+	// CreateSecret creates a secret, it fails if a secret with the same name, project and label exists.
+	CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*CreateSecretResponse, error)
+	// UpdateSecret replaces the value of a secret.
+	UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...grpc.CallOption) (*UpdateSecretResponse, error)
+	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*DeleteSecretResponse, error)
+	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
+	GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error)
+	// CreateSchedule creates a schedule, pipelines are created by the server when it's due.
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error)
+	// UpdateSchedule updates fields of a schedule which are set. The next run is recalculated.
+	UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*UpdateScheduleResponse, error)
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error)
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	GetSchedule(ctx context.Context, in *GetScheduleRequest, opts ...grpc.CallOption) (*GetScheduleResponse, error)
 }
 
 type serverClient struct {
@@ -63,6 +120,24 @@ func (c *serverClient) CreatePipeline(ctx context.Context, in *CreatePipelineReq
 	return out, nil
 }
 
+func (c *serverClient) GetPipeline(ctx context.Context, in *GetPipelineRequest, opts ...grpc.CallOption) (*GetPipelineResponse, error) {
+	out := new(GetPipelineResponse)
+	err := c.cc.Invoke(ctx, "/Server/GetPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) ListPipelines(ctx context.Context, in *ListPipelinesRequest, opts ...grpc.CallOption) (*ListPipelinesResponse, error) {
+	out := new(ListPipelinesResponse)
+	err := c.cc.Invoke(ctx, "/Server/ListPipelines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serverClient) RequestJob(ctx context.Context, in *RequestJobRequest, opts ...grpc.CallOption) (*RequestJobResponse, error) {
 	out := new(RequestJobResponse)
 	err := c.cc.Invoke(ctx, "/Server/RequestJob", in, out, opts...)
@@ -81,6 +156,15 @@ func (c *serverClient) RerunJob(ctx context.Context, in *RerunJobRequest, opts .
 	return out, nil
 }
 
+func (c *serverClient) RerunPipeline(ctx context.Context, in *RerunPipelineRequest, opts ...grpc.CallOption) (*RerunPipelineResponse, error) {
+	out := new(RerunPipelineResponse)
+	err := c.cc.Invoke(ctx, "/Server/RerunPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serverClient) GetJobExecution(ctx context.Context, in *GetJobExecutionRequest, opts ...grpc.CallOption) (*GetJobExecutionResponse, error) {
 	out := new(GetJobExecutionResponse)
 	err := c.cc.Invoke(ctx, "/Server/GetJobExecution", in, out, opts...)
@@ -126,348 +210,1482 @@ func (c *serverClient) GetStepExecution(ctx context.Context, in *GetStepExecutio
 	return out, nil
 }
 
-func (c *serverClient) UpdateStepExecution(ctx context.Context, in *UpdateStepExecutionRequest, opts ...grpc.CallOption) (*UpdateStepExecutionResponse, error) {
-	out := new(UpdateStepExecutionResponse)
-	err := c.cc.Invoke(ctx, "/Server/UpdateStepExecution", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (c *serverClient) UpdateStepExecution(ctx context.Context, in *UpdateStepExecutionRequest, opts ...grpc.CallOption) (*UpdateStepExecutionResponse, error) {
+	out := new(UpdateStepExecutionResponse)
+	err := c.cc.Invoke(ctx, "/Server/UpdateStepExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) RetryStepExecution(ctx context.Context, in *RetryStepExecutionRequest, opts ...grpc.CallOption) (*RetryStepExecutionResponse, error) {
+	out := new(RetryStepExecutionResponse)
+	err := c.cc.Invoke(ctx, "/Server/RetryStepExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) UploadLogLines(ctx context.Context, in *UpdateLogLinesRequest, opts ...grpc.CallOption) (*UpdateLogLinesResponse, error) {
+	out := new(UpdateLogLinesResponse)
+	err := c.cc.Invoke(ctx, "/Server/UploadLogLines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) GetLogLines(ctx context.Context, in *GetLogLinesRequest, opts ...grpc.CallOption) (*GetLogLinesResponse, error) {
+	out := new(GetLogLinesResponse)
+	err := c.cc.Invoke(ctx, "/Server/GetLogLines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) StreamLogLines(ctx context.Context, in *StreamLogLinesRequest, opts ...grpc.CallOption) (Server_StreamLogLinesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Server_ServiceDesc.Streams[0], "/Server/StreamLogLines", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serverStreamLogLinesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Server_StreamLogLinesClient interface {
+	Recv() (*StreamLogLinesResponse, error)
+	grpc.ClientStream
+}
+
+type serverStreamLogLinesClient struct {
+	grpc.ClientStream
+}
+
+func (x *serverStreamLogLinesClient) Recv() (*StreamLogLinesResponse, error) {
+	m := new(StreamLogLinesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serverClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/Server/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) UploadArtifact(ctx context.Context, opts ...grpc.CallOption) (Server_UploadArtifactClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Server_ServiceDesc.Streams[1], "/Server/UploadArtifact", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serverUploadArtifactClient{stream}
+	return x, nil
+}
+
+type Server_UploadArtifactClient interface {
+	Send(*UploadArtifactRequest) error
+	CloseAndRecv() (*UploadArtifactResponse, error)
+	grpc.ClientStream
+}
+
+type serverUploadArtifactClient struct {
+	grpc.ClientStream
+}
+
+func (x *serverUploadArtifactClient) Send(m *UploadArtifactRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *serverUploadArtifactClient) CloseAndRecv() (*UploadArtifactResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadArtifactResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serverClient) ListArtifacts(ctx context.Context, in *ListArtifactsRequest, opts ...grpc.CallOption) (*ListArtifactsResponse, error) {
+	out := new(ListArtifactsResponse)
+	err := c.cc.Invoke(ctx, "/Server/ListArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) DownloadArtifact(ctx context.Context, in *DownloadArtifactRequest, opts ...grpc.CallOption) (Server_DownloadArtifactClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Server_ServiceDesc.Streams[2], "/Server/DownloadArtifact", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serverDownloadArtifactClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Server_DownloadArtifactClient interface {
+	Recv() (*DownloadArtifactResponse, error)
+	grpc.ClientStream
+}
+
+type serverDownloadArtifactClient struct {
+	grpc.ClientStream
+}
+
+func (x *serverDownloadArtifactClient) Recv() (*DownloadArtifactResponse, error) {
+	m := new(DownloadArtifactResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serverClient) GetCache(ctx context.Context, in *GetCacheRequest, opts ...grpc.CallOption) (*GetCacheResponse, error) {
+	out := new(GetCacheResponse)
+	err := c.cc.Invoke(ctx, "/Server/GetCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) UploadCache(ctx context.Context, opts ...grpc.CallOption) (Server_UploadCacheClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Server_ServiceDesc.Streams[3], "/Server/UploadCache", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serverUploadCacheClient{stream}
+	return x, nil
+}
+
+type Server_UploadCacheClient interface {
+	Send(*UploadCacheRequest) error
+	CloseAndRecv() (*UploadCacheResponse, error)
+	grpc.ClientStream
+}
+
+type serverUploadCacheClient struct {
+	grpc.ClientStream
+}
+
+func (x *serverUploadCacheClient) Send(m *UploadCacheRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *serverUploadCacheClient) CloseAndRecv() (*UploadCacheResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadCacheResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serverClient) DownloadCache(ctx context.Context, in *DownloadCacheRequest, opts ...grpc.CallOption) (Server_DownloadCacheClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Server_ServiceDesc.Streams[4], "/Server/DownloadCache", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serverDownloadCacheClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Server_DownloadCacheClient interface {
+	Recv() (*DownloadCacheResponse, error)
+	grpc.ClientStream
+}
+
+type serverDownloadCacheClient struct {
+	grpc.ClientStream
+}
+
+func (x *serverDownloadCacheClient) Recv() (*DownloadCacheResponse, error) {
+	m := new(DownloadCacheResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serverClient) ListCaches(ctx context.Context, in *ListCachesRequest, opts ...grpc.CallOption) (*ListCachesResponse, error) {
+	out := new(ListCachesResponse)
+	err := c.cc.Invoke(ctx, "/Server/ListCaches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) DeleteCache(ctx context.Context, in *DeleteCacheRequest, opts ...grpc.CallOption) (*DeleteCacheResponse, error) {
+	out := new(DeleteCacheResponse)
+	err := c.cc.Invoke(ctx, "/Server/DeleteCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) SetCacheLimit(ctx context.Context, in *SetCacheLimitRequest, opts ...grpc.CallOption) (*SetCacheLimitResponse, error) {
+	out := new(SetCacheLimitResponse)
+	err := c.cc.Invoke(ctx, "/Server/SetCacheLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) Debug(ctx context.Context, opts ...grpc.CallOption) (Server_DebugClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Server_ServiceDesc.Streams[5], "/Server/Debug", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serverDebugClient{stream}
+	return x, nil
+}
+
+type Server_DebugClient interface {
+	Send(*DebugRequest) error
+	Recv() (*DebugResponse, error)
+	grpc.ClientStream
+}
+
+type serverDebugClient struct {
+	grpc.ClientStream
+}
+
+func (x *serverDebugClient) Send(m *DebugRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *serverDebugClient) Recv() (*DebugResponse, error) {
+	m := new(DebugResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serverClient) ServeDebug(ctx context.Context, opts ...grpc.CallOption) (Server_ServeDebugClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Server_ServiceDesc.Streams[6], "/Server/ServeDebug", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serverServeDebugClient{stream}
+	return x, nil
+}
+
+type Server_ServeDebugClient interface {
+	Send(*ServeDebugRequest) error
+	Recv() (*DebugRequest, error)
+	grpc.ClientStream
+}
+
+type serverServeDebugClient struct {
+	grpc.ClientStream
+}
+
+func (x *serverServeDebugClient) Send(m *ServeDebugRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *serverServeDebugClient) Recv() (*DebugRequest, error) {
+	m := new(DebugRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serverClient) RegisterAgent(ctx context.Context, in *RegisterAgentRequest, opts ...grpc.CallOption) (*RegisterAgentResponse, error) {
+	out := new(RegisterAgentResponse)
+	err := c.cc.Invoke(ctx, "/Server/RegisterAgent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) AgentHeartbeat(ctx context.Context, in *AgentHeartbeatRequest, opts ...grpc.CallOption) (*AgentHeartbeatResponse, error) {
+	out := new(AgentHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/Server/AgentHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) ListAgents(ctx context.Context, in *ListAgentsRequest, opts ...grpc.CallOption) (*ListAgentsResponse, error) {
+	out := new(ListAgentsResponse)
+	err := c.cc.Invoke(ctx, "/Server/ListAgents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) GetAgent(ctx context.Context, in *GetAgentRequest, opts ...grpc.CallOption) (*GetAgentResponse, error) {
+	out := new(GetAgentResponse)
+	err := c.cc.Invoke(ctx, "/Server/GetAgent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*CreateSecretResponse, error) {
+	out := new(CreateSecretResponse)
+	err := c.cc.Invoke(ctx, "/Server/CreateSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...grpc.CallOption) (*UpdateSecretResponse, error) {
+	out := new(UpdateSecretResponse)
+	err := c.cc.Invoke(ctx, "/Server/UpdateSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*DeleteSecretResponse, error) {
+	out := new(DeleteSecretResponse)
+	err := c.cc.Invoke(ctx, "/Server/DeleteSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error) {
+	out := new(ListSecretsResponse)
+	err := c.cc.Invoke(ctx, "/Server/ListSecrets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error) {
+	out := new(GetSecretResponse)
+	err := c.cc.Invoke(ctx, "/Server/GetSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error) {
+	out := new(CreateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/Server/CreateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*UpdateScheduleResponse, error) {
+	out := new(UpdateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/Server/UpdateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error) {
+	out := new(DeleteScheduleResponse)
+	err := c.cc.Invoke(ctx, "/Server/DeleteSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/Server/ListSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverClient) GetSchedule(ctx context.Context, in *GetScheduleRequest, opts ...grpc.CallOption) (*GetScheduleResponse, error) {
+	out := new(GetScheduleResponse)
+	err := c.cc.Invoke(ctx, "/Server/GetSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServerServer is the server API for Server service.
+// All implementations must embed UnimplementedServerServer
+// for forward compatibility
+type ServerServer interface {
+	Ping(context.Context, *ServerPingRequest) (*ServerPingResponse, error)
+	CreatePipeline(context.Context, *CreatePipelineRequest) (*CreatePipelineResponse, error)
+	GetPipeline(context.Context, *GetPipelineRequest) (*GetPipelineResponse, error)
+	ListPipelines(context.Context, *ListPipelinesRequest) (*ListPipelinesResponse, error)
+	RequestJob(context.Context, *RequestJobRequest) (*RequestJobResponse, error)
+	RerunJob(context.Context, *RerunJobRequest) (*RerunJobResponse, error)
+	RerunPipeline(context.Context, *RerunPipelineRequest) (*RerunPipelineResponse, error)
+	GetJobExecution(context.Context, *GetJobExecutionRequest) (*GetJobExecutionResponse, error)
+	CancelJobExecution(context.Context, *CancelJobExecutionRequest) (*CancelJobExecutionResponse, error)
+	ListJobExecutions(context.Context, *ListJobExecutionsRequest) (*ListJobExecutionsResponse, error)
+	UpdateJobExecution(context.Context, *UpdateJobExecutionRequest) (*UpdateJobExecutionResponse, error)
+	GetStepExecution(context.Context, *GetStepExecutionRequest) (*GetStepExecutionResponse, error)
+	UpdateStepExecution(context.Context, *UpdateStepExecutionRequest) (*UpdateStepExecutionResponse, error)
+	// RetryStepExecution creates a new step execution as the next attempt of a failed step execution.
+	RetryStepExecution(context.Context, *RetryStepExecutionRequest) (*RetryStepExecutionResponse, error)
+	UploadLogLines(context.Context, *UpdateLogLinesRequest) (*UpdateLogLinesResponse, error)
+	GetLogLines(context.Context, *GetLogLinesRequest) (*GetLogLinesResponse, error)
+	StreamLogLines(*StreamLogLinesRequest, Server_StreamLogLinesServer) error
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	// UploadArtifact uploads an artifact of a job execution. The metadata is carried by the first message, and the
+	// data of all messages are concatenated as the content of the artifact.
+	UploadArtifact(Server_UploadArtifactServer) error
+	// ListArtifacts lists artifacts of a pipeline, expired ones are not included.
+	ListArtifacts(context.Context, *ListArtifactsRequest) (*ListArtifactsResponse, error)
+	// DownloadArtifact streams the content of an artifact. It's a tar archive.
+	DownloadArtifact(*DownloadArtifactRequest, Server_DownloadArtifactServer) error
+	// GetCache finds the cache for a job execution in caches of the label it runs on. Caches are scoped by the canonical
+	// form of the label expression in runs_on, so equivalent expressions share caches. It matches the key exactly
+	// first, then the restore keys as prefixes in order. The cache is not set if nothing matches.
+	GetCache(context.Context, *GetCacheRequest) (*GetCacheResponse, error)
+	// UploadCache saves a cache for the label a job execution runs on. The metadata is carried by the first message,
+	// and the data of all messages are concatenated as the content of the cache.
+	UploadCache(Server_UploadCacheServer) error
+	// DownloadCache streams the content of a cache. It's a tar archive.
+	DownloadCache(*DownloadCacheRequest, Server_DownloadCacheServer) error
+	// ListCaches lists caches of a label with the usage of the label.
+	ListCaches(context.Context, *ListCachesRequest) (*ListCachesResponse, error)
+	// DeleteCache evicts a cache.
+	DeleteCache(context.Context, *DeleteCacheRequest) (*DeleteCacheResponse, error)
+	// SetCacheLimit sets the max total size of caches of a label. Least recently used caches are evicted
+	// when the limit is exceeded.
+	SetCacheLimit(context.Context, *SetCacheLimitRequest) (*SetCacheLimitResponse, error)
+	// Debug runs a command interactively in the runner of a job execution. The stream is relayed through the agent
+	// running the job execution, so the runner must be running or kept alive by keep_alive_on_failure.
+	// The job execution and the command are carried by the first message.
+	Debug(Server_DebugServer) error
+	// ServeDebug is called by agents to serve a debug session announced by HeartbeatResponse. The session id is
+	// carried by the first message, and the requests of the session are streamed back.
+	ServeDebug(Server_ServeDebugServer) error
+	// RegisterAgent is called by agents on starting. Agents with the same name share the same record,
+	// so the record is updated if the agent is registered before.
+	RegisterAgent(context.Context, *RegisterAgentRequest) (*RegisterAgentResponse, error)
+	// AgentHeartbeat reports the running jobs of an agent. Agents without heartbeats are considered offline.
+	AgentHeartbeat(context.Context, *AgentHeartbeatRequest) (*AgentHeartbeatResponse, error)
+	ListAgents(context.Context, *ListAgentsRequest) (*ListAgentsResponse, error)
+	GetAgent(context.Context, *GetAgentRequest) (*GetAgentResponse, error)
+	// CreateSecret creates a secret, it fails if a secret with the same name, project and label exists.
+	CreateSecret(context.Context, *CreateSecretRequest) (*CreateSecretResponse, error)
+	// UpdateSecret replaces the value of a secret.
+	UpdateSecret(context.Context, *UpdateSecretRequest) (*UpdateSecretResponse, error)
+	DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error)
+	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
+	GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error)
+	// CreateSchedule creates a schedule, pipelines are created by the server when it's due.
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error)
+	// UpdateSchedule updates fields of a schedule which are set. The next run is recalculated.
+	UpdateSchedule(context.Context, *UpdateScheduleRequest) (*UpdateScheduleResponse, error)
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error)
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	GetSchedule(context.Context, *GetScheduleRequest) (*GetScheduleResponse, error)
+	mustEmbedUnimplementedServerServer()
+}
+
+// UnimplementedServerServer must be embedded to have forward compatible implementations.
+type UnimplementedServerServer struct {
+}
+
+func (UnimplementedServerServer) Ping(context.Context, *ServerPingRequest) (*ServerPingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedServerServer) CreatePipeline(context.Context, *CreatePipelineRequest) (*CreatePipelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePipeline not implemented")
+}
+func (UnimplementedServerServer) GetPipeline(context.Context, *GetPipelineRequest) (*GetPipelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPipeline not implemented")
+}
+func (UnimplementedServerServer) ListPipelines(context.Context, *ListPipelinesRequest) (*ListPipelinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPipelines not implemented")
+}
+func (UnimplementedServerServer) RequestJob(context.Context, *RequestJobRequest) (*RequestJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestJob not implemented")
+}
+func (UnimplementedServerServer) RerunJob(context.Context, *RerunJobRequest) (*RerunJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RerunJob not implemented")
+}
+func (UnimplementedServerServer) RerunPipeline(context.Context, *RerunPipelineRequest) (*RerunPipelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RerunPipeline not implemented")
+}
+func (UnimplementedServerServer) GetJobExecution(context.Context, *GetJobExecutionRequest) (*GetJobExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobExecution not implemented")
+}
+func (UnimplementedServerServer) CancelJobExecution(context.Context, *CancelJobExecutionRequest) (*CancelJobExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJobExecution not implemented")
+}
+func (UnimplementedServerServer) ListJobExecutions(context.Context, *ListJobExecutionsRequest) (*ListJobExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobExecutions not implemented")
+}
+func (UnimplementedServerServer) UpdateJobExecution(context.Context, *UpdateJobExecutionRequest) (*UpdateJobExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobExecution not implemented")
+}
+func (UnimplementedServerServer) GetStepExecution(context.Context, *GetStepExecutionRequest) (*GetStepExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStepExecution not implemented")
+}
+func (UnimplementedServerServer) UpdateStepExecution(context.Context, *UpdateStepExecutionRequest) (*UpdateStepExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStepExecution not implemented")
+}
+func (UnimplementedServerServer) RetryStepExecution(context.Context, *RetryStepExecutionRequest) (*RetryStepExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryStepExecution not implemented")
+}
+func (UnimplementedServerServer) UploadLogLines(context.Context, *UpdateLogLinesRequest) (*UpdateLogLinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadLogLines not implemented")
+}
+func (UnimplementedServerServer) GetLogLines(context.Context, *GetLogLinesRequest) (*GetLogLinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogLines not implemented")
+}
+func (UnimplementedServerServer) StreamLogLines(*StreamLogLinesRequest, Server_StreamLogLinesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogLines not implemented")
+}
+func (UnimplementedServerServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedServerServer) UploadArtifact(Server_UploadArtifactServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadArtifact not implemented")
+}
+func (UnimplementedServerServer) ListArtifacts(context.Context, *ListArtifactsRequest) (*ListArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArtifacts not implemented")
+}
+func (UnimplementedServerServer) DownloadArtifact(*DownloadArtifactRequest, Server_DownloadArtifactServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadArtifact not implemented")
+}
+func (UnimplementedServerServer) GetCache(context.Context, *GetCacheRequest) (*GetCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCache not implemented")
+}
+func (UnimplementedServerServer) UploadCache(Server_UploadCacheServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadCache not implemented")
+}
+func (UnimplementedServerServer) DownloadCache(*DownloadCacheRequest, Server_DownloadCacheServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadCache not implemented")
+}
+func (UnimplementedServerServer) ListCaches(context.Context, *ListCachesRequest) (*ListCachesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCaches not implemented")
+}
+func (UnimplementedServerServer) DeleteCache(context.Context, *DeleteCacheRequest) (*DeleteCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCache not implemented")
+}
+func (UnimplementedServerServer) SetCacheLimit(context.Context, *SetCacheLimitRequest) (*SetCacheLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCacheLimit not implemented")
+}
+func (UnimplementedServerServer) Debug(Server_DebugServer) error {
+	return status.Errorf(codes.Unimplemented, "method Debug not implemented")
+}
+func (UnimplementedServerServer) ServeDebug(Server_ServeDebugServer) error {
+	return status.Errorf(codes.Unimplemented, "method ServeDebug not implemented")
+}
+func (UnimplementedServerServer) RegisterAgent(context.Context, *RegisterAgentRequest) (*RegisterAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAgent not implemented")
+}
+func (UnimplementedServerServer) AgentHeartbeat(context.Context, *AgentHeartbeatRequest) (*AgentHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgentHeartbeat not implemented")
+}
+func (UnimplementedServerServer) ListAgents(context.Context, *ListAgentsRequest) (*ListAgentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAgents not implemented")
+}
+func (UnimplementedServerServer) GetAgent(context.Context, *GetAgentRequest) (*GetAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgent not implemented")
+}
+func (UnimplementedServerServer) CreateSecret(context.Context, *CreateSecretRequest) (*CreateSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSecret not implemented")
+}
+func (UnimplementedServerServer) UpdateSecret(context.Context, *UpdateSecretRequest) (*UpdateSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSecret not implemented")
+}
+func (UnimplementedServerServer) DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
+}
+func (UnimplementedServerServer) ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecrets not implemented")
+}
+func (UnimplementedServerServer) GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecret not implemented")
+}
+func (UnimplementedServerServer) CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedServerServer) UpdateSchedule(context.Context, *UpdateScheduleRequest) (*UpdateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedule not implemented")
+}
+func (UnimplementedServerServer) DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (UnimplementedServerServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedServerServer) GetSchedule(context.Context, *GetScheduleRequest) (*GetScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedule not implemented")
+}
+func (UnimplementedServerServer) mustEmbedUnimplementedServerServer() {}
+
+// UnsafeServerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServerServer will
+// result in compilation errors.
+type UnsafeServerServer interface {
+	mustEmbedUnimplementedServerServer()
+}
+
+func RegisterServerServer(s grpc.ServiceRegistrar, srv ServerServer) {
+	s.RegisterService(&Server_ServiceDesc, srv)
+}
+
+func _Server_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerPingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).Ping(ctx, req.(*ServerPingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).CreatePipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/CreatePipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).CreatePipeline(ctx, req.(*CreatePipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_GetPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).GetPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/GetPipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).GetPipeline(ctx, req.(*GetPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_ListPipelines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPipelinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).ListPipelines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/ListPipelines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).ListPipelines(ctx, req.(*ListPipelinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_RequestJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).RequestJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/RequestJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).RequestJob(ctx, req.(*RequestJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_RerunJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RerunJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).RerunJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/RerunJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).RerunJob(ctx, req.(*RerunJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_RerunPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RerunPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).RerunPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/RerunPipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).RerunPipeline(ctx, req.(*RerunPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_GetJobExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).GetJobExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/GetJobExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).GetJobExecution(ctx, req.(*GetJobExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_CancelJobExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).CancelJobExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/CancelJobExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).CancelJobExecution(ctx, req.(*CancelJobExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_ListJobExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).ListJobExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/ListJobExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).ListJobExecutions(ctx, req.(*ListJobExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_UpdateJobExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).UpdateJobExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/UpdateJobExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).UpdateJobExecution(ctx, req.(*UpdateJobExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_GetStepExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStepExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).GetStepExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/GetStepExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).GetStepExecution(ctx, req.(*GetStepExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_UpdateStepExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStepExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).UpdateStepExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/UpdateStepExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).UpdateStepExecution(ctx, req.(*UpdateStepExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_RetryStepExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryStepExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).RetryStepExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/RetryStepExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).RetryStepExecution(ctx, req.(*RetryStepExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_UploadLogLines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLogLinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).UploadLogLines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/UploadLogLines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).UploadLogLines(ctx, req.(*UpdateLogLinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_GetLogLines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogLinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).GetLogLines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/GetLogLines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).GetLogLines(ctx, req.(*GetLogLinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_StreamLogLines_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamLogLinesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServerServer).StreamLogLines(m, &serverStreamLogLinesServer{stream})
+}
+
+type Server_StreamLogLinesServer interface {
+	Send(*StreamLogLinesResponse) error
+	grpc.ServerStream
+}
+
+type serverStreamLogLinesServer struct {
+	grpc.ServerStream
+}
+
+func (x *serverStreamLogLinesServer) Send(m *StreamLogLinesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Server_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_UploadArtifact_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ServerServer).UploadArtifact(&serverUploadArtifactServer{stream})
+}
+
+type Server_UploadArtifactServer interface {
+	SendAndClose(*UploadArtifactResponse) error
+	Recv() (*UploadArtifactRequest, error)
+	grpc.ServerStream
+}
+
+type serverUploadArtifactServer struct {
+	grpc.ServerStream
+}
+
+func (x *serverUploadArtifactServer) SendAndClose(m *UploadArtifactResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *serverUploadArtifactServer) Recv() (*UploadArtifactRequest, error) {
+	m := new(UploadArtifactRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Server_ListArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).ListArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/ListArtifacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).ListArtifacts(ctx, req.(*ListArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_DownloadArtifact_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadArtifactRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServerServer).DownloadArtifact(m, &serverDownloadArtifactServer{stream})
+}
+
+type Server_DownloadArtifactServer interface {
+	Send(*DownloadArtifactResponse) error
+	grpc.ServerStream
+}
+
+type serverDownloadArtifactServer struct {
+	grpc.ServerStream
+}
+
+func (x *serverDownloadArtifactServer) Send(m *DownloadArtifactResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Server_GetCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).GetCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/GetCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).GetCache(ctx, req.(*GetCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Server_UploadCache_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ServerServer).UploadCache(&serverUploadCacheServer{stream})
+}
+
+type Server_UploadCacheServer interface {
+	SendAndClose(*UploadCacheResponse) error
+	Recv() (*UploadCacheRequest, error)
+	grpc.ServerStream
+}
+
+type serverUploadCacheServer struct {
+	grpc.ServerStream
+}
+
+func (x *serverUploadCacheServer) SendAndClose(m *UploadCacheResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *serverUploadCacheServer) Recv() (*UploadCacheRequest, error) {
+	m := new(UploadCacheRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Server_DownloadCache_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadCacheRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServerServer).DownloadCache(m, &serverDownloadCacheServer{stream})
+}
+
+type Server_DownloadCacheServer interface {
+	Send(*DownloadCacheResponse) error
+	grpc.ServerStream
+}
+
+type serverDownloadCacheServer struct {
+	grpc.ServerStream
+}
+
+func (x *serverDownloadCacheServer) Send(m *DownloadCacheResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (c *serverClient) UploadLogLines(ctx context.Context, in *UpdateLogLinesRequest, opts ...grpc.CallOption) (*UpdateLogLinesResponse, error) {
-	out := new(UpdateLogLinesResponse)
-	err := c.cc.Invoke(ctx, "/Server/UploadLogLines", in, out, opts...)
-	if err != nil {
+func _Server_ListCaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCachesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ServerServer).ListCaches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/ListCaches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).ListCaches(ctx, req.(*ListCachesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *serverClient) GetLogLines(ctx context.Context, in *GetLogLinesRequest, opts ...grpc.CallOption) (*GetLogLinesResponse, error) {
-	out := new(GetLogLinesResponse)
-	err := c.cc.Invoke(ctx, "/Server/GetLogLines", in, out, opts...)
-	if err != nil {
+func _Server_DeleteCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCacheRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ServerServer).DeleteCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/DeleteCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).DeleteCache(ctx, req.(*DeleteCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *serverClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
-	out := new(HeartbeatResponse)
-	err := c.cc.Invoke(ctx, "/Server/Heartbeat", in, out, opts...)
-	if err != nil {
+func _Server_SetCacheLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCacheLimitRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ServerServer).SetCacheLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/SetCacheLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).SetCacheLimit(ctx, req.(*SetCacheLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// ServerServer is the server API for Server service.
-// All implementations must embed UnimplementedServerServer
-// for forward compatibility
-type ServerServer interface {
-	Ping(context.Context, *ServerPingRequest) (*ServerPingResponse, error)
-	CreatePipeline(context.Context, *CreatePipelineRequest) (*CreatePipelineResponse, error)
-	RequestJob(context.Context, *RequestJobRequest) (*RequestJobResponse, error)
-	RerunJob(context.Context, *RerunJobRequest) (*RerunJobResponse, error)
-	GetJobExecution(context.Context, *GetJobExecutionRequest) (*GetJobExecutionResponse, error)
-	CancelJobExecution(context.Context, *CancelJobExecutionRequest) (*CancelJobExecutionResponse, error)
-	ListJobExecutions(context.Context, *ListJobExecutionsRequest) (*ListJobExecutionsResponse, error)
-	UpdateJobExecution(context.Context, *UpdateJobExecutionRequest) (*UpdateJobExecutionResponse, error)
-	GetStepExecution(context.Context, *GetStepExecutionRequest) (*GetStepExecutionResponse, error)
-	UpdateStepExecution(context.Context, *UpdateStepExecutionRequest) (*UpdateStepExecutionResponse, error)
-	UploadLogLines(context.Context, *UpdateLogLinesRequest) (*UpdateLogLinesResponse, error)
-	GetLogLines(context.Context, *GetLogLinesRequest) (*GetLogLinesResponse, error)
-	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
-	mustEmbedUnimplementedServerServer()
+func _Server_Debug_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ServerServer).Debug(&serverDebugServer{stream})
 }
 
-// UnimplementedServerServer must be embedded to have forward compatible implementations.
-type UnimplementedServerServer struct {
+type Server_DebugServer interface {
+	Send(*DebugResponse) error
+	Recv() (*DebugRequest, error)
+	grpc.ServerStream
 }
 
-func (UnimplementedServerServer) Ping(context.Context, *ServerPingRequest) (*ServerPingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
-}
-func (UnimplementedServerServer) CreatePipeline(context.Context, *CreatePipelineRequest) (*CreatePipelineResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePipeline not implemented")
-}
-func (UnimplementedServerServer) RequestJob(context.Context, *RequestJobRequest) (*RequestJobResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestJob not implemented")
-}
-func (UnimplementedServerServer) RerunJob(context.Context, *RerunJobRequest) (*RerunJobResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RerunJob not implemented")
+type serverDebugServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedServerServer) GetJobExecution(context.Context, *GetJobExecutionRequest) (*GetJobExecutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJobExecution not implemented")
-}
-func (UnimplementedServerServer) CancelJobExecution(context.Context, *CancelJobExecutionRequest) (*CancelJobExecutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelJobExecution not implemented")
-}
-func (UnimplementedServerServer) ListJobExecutions(context.Context, *ListJobExecutionsRequest) (*ListJobExecutionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListJobExecutions not implemented")
-}
-func (UnimplementedServerServer) UpdateJobExecution(context.Context, *UpdateJobExecutionRequest) (*UpdateJobExecutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobExecution not implemented")
+
+func (x *serverDebugServer) Send(m *DebugResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedServerServer) GetStepExecution(context.Context, *GetStepExecutionRequest) (*GetStepExecutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStepExecution not implemented")
+
+func (x *serverDebugServer) Recv() (*DebugRequest, error) {
+	m := new(DebugRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedServerServer) UpdateStepExecution(context.Context, *UpdateStepExecutionRequest) (*UpdateStepExecutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateStepExecution not implemented")
+
+func _Server_ServeDebug_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ServerServer).ServeDebug(&serverServeDebugServer{stream})
 }
-func (UnimplementedServerServer) UploadLogLines(context.Context, *UpdateLogLinesRequest) (*UpdateLogLinesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UploadLogLines not implemented")
+
+type Server_ServeDebugServer interface {
+	Send(*DebugRequest) error
+	Recv() (*ServeDebugRequest, error)
+	grpc.ServerStream
 }
-func (UnimplementedServerServer) GetLogLines(context.Context, *GetLogLinesRequest) (*GetLogLinesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLogLines not implemented")
+
+type serverServeDebugServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedServerServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+
+func (x *serverServeDebugServer) Send(m *DebugRequest) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedServerServer) mustEmbedUnimplementedServerServer() {}
 
-// UnsafeServerServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ServerServer will
-// result in compilation errors.
-type UnsafeServerServer interface {
-	mustEmbedUnimplementedServerServer()
+func (x *serverServeDebugServer) Recv() (*ServeDebugRequest, error) {
+	m := new(ServeDebugRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func RegisterServerServer(s grpc.ServiceRegistrar, srv ServerServer) {
-	s.RegisterService(&Server_ServiceDesc, srv)
+func _Server_RegisterAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).RegisterAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/RegisterAgent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).RegisterAgent(ctx, req.(*RegisterAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Server_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServerPingRequest)
+func _Server_AgentHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentHeartbeatRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServer).Ping(ctx, in)
+		return srv.(ServerServer).AgentHeartbeat(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Server/Ping",
+		FullMethod: "/Server/AgentHeartbeat",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServer).Ping(ctx, req.(*ServerPingRequest))
+		return srv.(ServerServer).AgentHeartbeat(ctx, req.(*AgentHeartbeatRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Server_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePipelineRequest)
+func _Server_ListAgents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAgentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServer).CreatePipeline(ctx, in)
+		return srv.(ServerServer).ListAgents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Server/CreatePipeline",
+		FullMethod: "/Server/ListAgents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServer).CreatePipeline(ctx, req.(*CreatePipelineRequest))
+		return srv.(ServerServer).ListAgents(ctx, req.(*ListAgentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Server_RequestJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestJobRequest)
+func _Server_GetAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServer).RequestJob(ctx, in)
+		return srv.(ServerServer).GetAgent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Server/RequestJob",
+		FullMethod: "/Server/GetAgent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServer).RequestJob(ctx, req.(*RequestJobRequest))
+		return srv.(ServerServer).GetAgent(ctx, req.(*GetAgentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Server_RerunJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RerunJobRequest)
+func _Server_CreateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServer).RerunJob(ctx, in)
+		return srv.(ServerServer).CreateSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Server/RerunJob",
+		FullMethod: "/Server/CreateSecret",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServer).RerunJob(ctx, req.(*RerunJobRequest))
+		return srv.(ServerServer).CreateSecret(ctx, req.(*CreateSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Server_GetJobExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetJobExecutionRequest)
+func _Server_UpdateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServer).GetJobExecution(ctx, in)
+		return srv.(ServerServer).UpdateSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Server/GetJobExecution",
+		FullMethod: "/Server/UpdateSecret",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServer).GetJobExecution(ctx, req.(*GetJobExecutionRequest))
+		return srv.(ServerServer).UpdateSecret(ctx, req.(*UpdateSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Server_CancelJobExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelJobExecutionRequest)
+func _Server_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServer).CancelJobExecution(ctx, in)
+		return srv.(ServerServer).DeleteSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Server/CancelJobExecution",
+		FullMethod: "/Server/DeleteSecret",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServer).CancelJobExecution(ctx, req.(*CancelJobExecutionRequest))
+		return srv.(ServerServer).DeleteSecret(ctx, req.(*DeleteSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Server_ListJobExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListJobExecutionsRequest)
+func _Server_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServer).ListJobExecutions(ctx, in)
+		return srv.(ServerServer).ListSecrets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Server/ListJobExecutions",
+		FullMethod: "/Server/ListSecrets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServer).ListJobExecutions(ctx, req.(*ListJobExecutionsRequest))
+		return srv.(ServerServer).ListSecrets(ctx, req.(*ListSecretsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Server_UpdateJobExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateJobExecutionRequest)
+func _Server_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServer).UpdateJobExecution(ctx, in)
+		return srv.(ServerServer).GetSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Server/UpdateJobExecution",
+		FullMethod: "/Server/GetSecret",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServer).UpdateJobExecution(ctx, req.(*UpdateJobExecutionRequest))
+		return srv.(ServerServer).GetSecret(ctx, req.(*GetSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Server_GetStepExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStepExecutionRequest)
+func _Server_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServer).GetStepExecution(ctx, in)
+		return srv.(ServerServer).CreateSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Server/GetStepExecution",
+		FullMethod: "/Server/CreateSchedule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServer).GetStepExecution(ctx, req.(*GetStepExecutionRequest))
+		return srv.(ServerServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Server_UpdateStepExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateStepExecutionRequest)
+func _Server_UpdateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServer).UpdateStepExecution(ctx, in)
+		return srv.(ServerServer).UpdateSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Server/UpdateStepExecution",
+		FullMethod: "/Server/UpdateSchedule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServer).UpdateStepExecution(ctx, req.(*UpdateStepExecutionRequest))
+		return srv.(ServerServer).UpdateSchedule(ctx, req.(*UpdateScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Server_UploadLogLines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateLogLinesRequest)
+func _Server_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServer).UploadLogLines(ctx, in)
+		return srv.(ServerServer).DeleteSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Server/UploadLogLines",
+		FullMethod: "/Server/DeleteSchedule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServer).UploadLogLines(ctx, req.(*UpdateLogLinesRequest))
+		return srv.(ServerServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Server_GetLogLines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLogLinesRequest)
+func _Server_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServer).GetLogLines(ctx, in)
+		return srv.(ServerServer).ListSchedules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Server/GetLogLines",
+		FullMethod: "/Server/ListSchedules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServer).GetLogLines(ctx, req.(*GetLogLinesRequest))
+		return srv.(ServerServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Server_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HeartbeatRequest)
+func _Server_GetSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServerServer).Heartbeat(ctx, in)
+		return srv.(ServerServer).GetSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Server/Heartbeat",
+		FullMethod: "/Server/GetSchedule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServerServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+		return srv.(ServerServer).GetSchedule(ctx, req.(*GetScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -487,6 +1705,14 @@ var Server_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePipeline",
 			Handler:    _Server_CreatePipeline_Handler,
 		},
+		{
+			MethodName: "GetPipeline",
+			Handler:    _Server_GetPipeline_Handler,
+		},
+		{
+			MethodName: "ListPipelines",
+			Handler:    _Server_ListPipelines_Handler,
+		},
 		{
 			MethodName: "RequestJob",
 			Handler:    _Server_RequestJob_Handler,
@@ -495,6 +1721,10 @@ var Server_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RerunJob",
 			Handler:    _Server_RerunJob_Handler,
 		},
+		{
+			MethodName: "RerunPipeline",
+			Handler:    _Server_RerunPipeline_Handler,
+		},
 		{
 			MethodName: "GetJobExecution",
 			Handler:    _Server_GetJobExecution_Handler,
@@ -519,6 +1749,10 @@ var Server_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateStepExecution",
 			Handler:    _Server_UpdateStepExecution_Handler,
 		},
+		{
+			MethodName: "RetryStepExecution",
+			Handler:    _Server_RetryStepExecution_Handler,
+		},
 		{
 			MethodName: "UploadLogLines",
 			Handler:    _Server_UploadLogLines_Handler,
@@ -531,7 +1765,121 @@ var Server_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Heartbeat",
 			Handler:    _Server_Heartbeat_Handler,
 		},
+		{
+			MethodName: "ListArtifacts",
+			Handler:    _Server_ListArtifacts_Handler,
+		},
+		{
+			MethodName: "GetCache",
+			Handler:    _Server_GetCache_Handler,
+		},
+		{
+			MethodName: "ListCaches",
+			Handler:    _Server_ListCaches_Handler,
+		},
+		{
+			MethodName: "DeleteCache",
+			Handler:    _Server_DeleteCache_Handler,
+		},
+		{
+			MethodName: "SetCacheLimit",
+			Handler:    _Server_SetCacheLimit_Handler,
+		},
+		{
+			MethodName: "RegisterAgent",
+			Handler:    _Server_RegisterAgent_Handler,
+		},
+		{
+			MethodName: "AgentHeartbeat",
+			Handler:    _Server_AgentHeartbeat_Handler,
+		},
+		{
+			MethodName: "ListAgents",
+			Handler:    _Server_ListAgents_Handler,
+		},
+		{
+			MethodName: "GetAgent",
+			Handler:    _Server_GetAgent_Handler,
+		},
+		{
+			MethodName: "CreateSecret",
+			Handler:    _Server_CreateSecret_Handler,
+		},
+		{
+			MethodName: "UpdateSecret",
+			Handler:    _Server_UpdateSecret_Handler,
+		},
+		{
+			MethodName: "DeleteSecret",
+			Handler:    _Server_DeleteSecret_Handler,
+		},
+		{
+			MethodName: "ListSecrets",
+			Handler:    _Server_ListSecrets_Handler,
+		},
+		{
+			MethodName: "GetSecret",
+			Handler:    _Server_GetSecret_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _Server_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateSchedule",
+			Handler:    _Server_UpdateSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _Server_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _Server_ListSchedules_Handler,
+		},
+		{
+			MethodName: "GetSchedule",
+			Handler:    _Server_GetSchedule_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLogLines",
+			Handler:       _Server_StreamLogLines_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadArtifact",
+			Handler:       _Server_UploadArtifact_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadArtifact",
+			Handler:       _Server_DownloadArtifact_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadCache",
+			Handler:       _Server_UploadCache_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadCache",
+			Handler:       _Server_DownloadCache_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Debug",
+			Handler:       _Server_Debug_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ServeDebug",
+			Handler:       _Server_ServeDebug_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "server.proto",
 }
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"strconv"
 	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -23,3 +24,12 @@ func ConvertTime[T Time](t T) *timestamppb.Timestamp {
 	}
 	return nil
 }
+
+// StepLogName returns the log name of a step execution. The first attempt uses the step name, and the following
+// attempts use `<step name>@<attempt>`, so logs of each attempt are kept separately.
+func StepLogName(stepName string, attempt int32) string {
+	if attempt <= 1 {
+		return stepName
+	}
+	return stepName + "@" + strconv.Itoa(int(attempt))
+}
@@ -20,3 +20,9 @@ func TestConvertTime(t *testing.T) {
 	convertTime = ConvertTime(lo.ToPtr(now))
 	assert.Assert(t, convertTime.AsTime().Equal(now))
 }
+
+func TestStepLogName(t *testing.T) {
+	assert.Equal(t, StepLogName("build", 0), "build")
+	assert.Equal(t, StepLogName("build", 1), "build")
+	assert.Equal(t, StepLogName("build", 3), "build@3")
+}
@@ -1,10 +1,223 @@
 package api
 
 import (
+	"regexp"
+	"sort"
+
+	"github.com/cockroachdb/errors"
 	"github.com/go-playground/validator/v10"
+	"github.com/samber/lo"
 )
 
 func ValidateDSL(dsl *PipelineDSL) error {
 	vd := validator.New()
-	return vd.Struct(dsl)
+	if err := vd.Struct(dsl); err != nil {
+		return err
+	}
+	if err := validateMatrices(dsl); err != nil {
+		return err
+	}
+	if err := validateInterpolations(dsl); err != nil {
+		return err
+	}
+	for _, job := range dsl.Jobs {
+		if err := validateLabel(dsl, job); err != nil {
+			return errors.WithMessagef(err, "invalid job '%s'", job.Name)
+		}
+		if err := validateStepConditions(job); err != nil {
+			return errors.WithMessagef(err, "invalid job '%s'", job.Name)
+		}
+		if err := validateStepContainers(job); err != nil {
+			return errors.WithMessagef(err, "invalid job '%s'", job.Name)
+		}
+		if err := validateArtifacts(job); err != nil {
+			return errors.WithMessagef(err, "invalid job '%s'", job.Name)
+		}
+		if err := validateCache(job.Cache); err != nil {
+			return errors.WithMessagef(err, "invalid job '%s'", job.Name)
+		}
+		if err := validateSecrets(job); err != nil {
+			return errors.WithMessagef(err, "invalid job '%s'", job.Name)
+		}
+	}
+	return nil
+}
+
+// validateLabel validates the label expression of the job rendered with parameters, it's rendered with each
+// combination if the job has matrix.
+func validateLabel(dsl *PipelineDSL, job *JobDSL) error {
+	combinations := []map[string]string{nil}
+	if job.Matrix != nil {
+		var err error
+		if combinations, err = MatrixCombinations(job.Matrix); err != nil {
+			return err
+		}
+	}
+	for _, combination := range combinations {
+		label, err := Interpolate(job.RunsOn.Label, map[string]interface{}{
+			InterpolationVarParameters: dsl.Parameters,
+			InterpolationVarMatrix:     combination,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err = ParseLabelSelector(label); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SecretNamePattern is the pattern of names of secrets and the environment variables they're exposed as.
+var SecretNamePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// validateSecrets validates secrets of the job are exposed as valid environment variables, which are not defined
+// in `env_var` of the job.
+func validateSecrets(job *JobDSL) error {
+	envs := lo.Keys(job.Secrets)
+	sort.Strings(envs)
+	for _, env := range envs {
+		name := job.Secrets[env]
+		if !SecretNamePattern.MatchString(env) {
+			return errors.Errorf("invalid environment variable '%s' of secret", env)
+		}
+		if !SecretNamePattern.MatchString(name) {
+			return errors.Errorf("invalid secret name '%s' of '%s'", name, env)
+		}
+		if _, ok := job.EnvVar[env]; ok {
+			return errors.Errorf("secret '%s' conflicts with environment variable '%s'", name, env)
+		}
+	}
+	return nil
+}
+
+// validateStepConditions validates the `if` condition of steps in the job. A condition can only refer to steps
+// run before it, they're the ancestors in the DAG of steps, or all previous steps if no step has `depends_on`.
+func validateStepConditions(job *JobDSL) error {
+	stepNames := lo.Map(job.Steps, func(step *StepDSL, _ int) string {
+		return step.Name
+	})
+	for i, step := range job.Steps {
+		if step.If == "" {
+			continue
+		}
+		condition, err := ParseStepCondition(step.If)
+		if err != nil {
+			return errors.WithMessagef(err, "invalid condition of step '%s'", step.Name)
+		}
+		ancestors := stepAncestors(job.Steps, i)
+		for _, variable := range condition.Variables() {
+			if variable[0] != ConditionVarSteps || len(variable) < 2 {
+				continue
+			}
+			if !lo.Contains(stepNames, variable[1]) {
+				return errors.Errorf("step '%s' referred by condition of step '%s' is not found", variable[1], step.Name)
+			}
+			if !ancestors[variable[1]] {
+				return errors.Errorf("step '%s' referred by condition of step '%s' doesn't run before it",
+					variable[1], step.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// stepAncestors returns names of steps run before the step at index. The steps are run in sequence if none of them
+// has `depends_on`.
+func stepAncestors(steps []*StepDSL, index int) map[string]bool {
+	ancestors := make(map[string]bool)
+	if !lo.SomeBy(steps, func(step *StepDSL) bool { return len(step.DependsOn) > 0 }) {
+		for _, step := range steps[:index] {
+			ancestors[step.Name] = true
+		}
+		return ancestors
+	}
+	stepsByName := lo.KeyBy(steps, func(step *StepDSL) string {
+		return step.Name
+	})
+	queue := append([]string(nil), steps[index].DependsOn...)
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if ancestors[name] {
+			continue
+		}
+		ancestors[name] = true
+		if step, ok := stepsByName[name]; ok {
+			queue = append(queue, step.DependsOn...)
+		}
+	}
+	return ancestors
+}
+
+// validateStepContainers validates the containers of steps are defined in `runs_on.docker.containers`.
+func validateStepContainers(job *JobDSL) error {
+	var containers []string
+	if job.RunsOn.Docker != nil {
+		containers = lo.Map(job.RunsOn.Docker.Containers, func(container *Container, _ int) string {
+			return container.Name
+		})
+		defaultContainer := job.RunsOn.Docker.DefaultContainer
+		if defaultContainer != "" && !lo.Contains(containers, defaultContainer) {
+			return errors.Errorf("default container '%s' is not defined", defaultContainer)
+		}
+	}
+	for _, step := range job.Steps {
+		if step.Container == "" {
+			continue
+		}
+		if !lo.Contains(containers, step.Container) {
+			return errors.Errorf("container '%s' of step '%s' is not defined", step.Container, step.Name)
+		}
+	}
+	return nil
+}
+
+var artifactNamePattern = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// ValidateArtifactName validates the name of an artifact. It's used as a part of the storage key, so only letters,
+// digits, `.`, `-` and `_` are allowed.
+func ValidateArtifactName(name string) error {
+	if !artifactNamePattern.MatchString(name) || name == "." || name == ".." {
+		return errors.Errorf("invalid artifact name '%s'", name)
+	}
+	return nil
+}
+
+// validateArtifacts validates artifacts uploaded by the job and its steps have unique valid names, and artifacts to
+// download are uploaded by jobs in `depends_on`.
+func validateArtifacts(job *JobDSL) error {
+	uploads := append([]*ArtifactUpload{}, job.Artifacts...)
+	for _, step := range job.Steps {
+		uploads = append(uploads, step.Artifacts...)
+	}
+	names := make(map[string]bool, len(uploads))
+	for _, artifact := range uploads {
+		if err := ValidateArtifactName(artifact.Name); err != nil {
+			return err
+		}
+		if names[artifact.Name] {
+			return errors.Errorf("artifact '%s' is duplicated", artifact.Name)
+		}
+		names[artifact.Name] = true
+	}
+	for _, download := range job.DownloadArtifacts {
+		if !lo.Contains(job.DependsOn, download.Job) {
+			return errors.Errorf("job '%s' of artifact '%s' is not in depends_on", download.Job, download.Name)
+		}
+	}
+	return nil
+}
+
+// validateCache validates templates of the key and restore keys of cache.
+func validateCache(cache *CacheDSL) error {
+	if cache == nil {
+		return nil
+	}
+	for _, key := range append([]string{cache.Key}, cache.RestoreKeys...) {
+		if _, err := ParseCacheKey(key); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -43,7 +43,200 @@ func TestValidateDSL(t *testing.T) {
 	dsl.Jobs[0].RunsOn.Label = ""
 	assert.Assert(t, ValidateDSL(dsl) != nil)
 
+	dsl = getDSL()
+	dsl.Jobs[0].RunsOn.Label = "linux && !"
+	assert.ErrorContains(t, ValidateDSL(dsl), "invalid label")
+
 	dsl = getDSL()
 	dsl.Jobs[0].Name = ""
 	assert.Assert(t, ValidateDSL(dsl) != nil)
+
+	dsl = getDSL()
+	dsl.Jobs[0].KeepAliveOnFailure = 3600
+	assert.NilError(t, ValidateDSL(dsl))
+	dsl.Jobs[0].KeepAliveOnFailure = 3601
+	assert.ErrorContains(t, ValidateDSL(dsl), "KeepAliveOnFailure")
+}
+
+func TestValidateDSL_Interpolation(t *testing.T) {
+	newDSL := func() *PipelineDSL {
+		dsl := getDSL()
+		dsl.Parameters = map[string]string{"os": "linux"}
+		dsl.Jobs = append(dsl.Jobs, &JobDSL{
+			Name:      "deploy",
+			RunsOn:    &RunsOn{Label: "${{ parameters.os }} && amd64"},
+			DependsOn: []string{"uid"},
+			EnvVar:    map[string]string{"IMAGE": "${{ jobs.uid.outputs.image }}"},
+			Steps: []*StepDSL{{
+				Name:             "deploy",
+				WorkingDirectory: "${{ job.name }}",
+				EnvVar:           map[string]string{"TARGET": "${{ step.name }}-${{ pipeline.id }}"},
+				Commands:         []string{"deploy ${{ env.IMAGE }} ${{ env.TARGET }} ${{ env.RUNNER_JOB_ID }}"},
+			}},
+		})
+		return dsl
+	}
+	assert.NilError(t, ValidateDSL(newDSL()))
+	for _, tc := range []struct {
+		name   string
+		modify func(dsl *PipelineDSL)
+		err    string
+	}{
+		{
+			name:   "unknown_parameter",
+			modify: func(dsl *PipelineDSL) { dsl.Jobs[1].RunsOn.Label = "${{ parameters.arch }}" },
+			err:    "unknown variable 'parameters.arch'",
+		},
+		{
+			name:   "runtime_variable_in_runs_on",
+			modify: func(dsl *PipelineDSL) { dsl.Jobs[1].RunsOn.Label = "${{ job.name }}" },
+			err:    "only parameters can be referred",
+		},
+		{
+			name:   "unknown_root",
+			modify: func(dsl *PipelineDSL) { dsl.Jobs[1].Steps[0].Commands = []string{"echo ${{ secrets.TOKEN }}"} },
+			err:    "unknown variable 'secrets.TOKEN'",
+		},
+		{
+			name:   "unknown_env",
+			modify: func(dsl *PipelineDSL) { dsl.Jobs[1].Steps[0].Commands = []string{"echo ${{ env.NOT_FOUND }}"} },
+			err:    "unknown variable 'env.NOT_FOUND'",
+		},
+		{
+			name:   "env_in_env",
+			modify: func(dsl *PipelineDSL) { dsl.Jobs[1].EnvVar["COPY"] = "${{ env.IMAGE }}" },
+			err:    "unknown variable 'env.IMAGE'",
+		},
+		{
+			name:   "step_in_job",
+			modify: func(dsl *PipelineDSL) { dsl.Jobs[1].WorkingDirectory = "${{ step.name }}" },
+			err:    "unknown variable 'step.name'",
+		},
+		{
+			name:   "not_dependency",
+			modify: func(dsl *PipelineDSL) { dsl.Jobs[0].EnvVar = map[string]string{"A": "${{ jobs.deploy.outputs.a }}"} },
+			err:    "job 'deploy' referred by 'jobs.deploy.outputs.a' is not a dependency",
+		},
+		{
+			name:   "unclosed",
+			modify: func(dsl *PipelineDSL) { dsl.Jobs[1].Steps[0].Commands = []string{"echo ${{ job.name"} },
+			err:    "unclosed expression",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dsl := newDSL()
+			tc.modify(dsl)
+			assert.ErrorContains(t, ValidateDSL(dsl), tc.err)
+		})
+	}
+}
+
+func TestValidateDSL_StepCondition(t *testing.T) {
+	dsl := getDSL()
+	dsl.Jobs[0].Steps = append(dsl.Jobs[0].Steps, &StepDSL{
+		Name: "notify",
+		If:   "failure() && steps.step_uid.exit_code != 0 || env.BRANCH == 'main'",
+	})
+	assert.NilError(t, ValidateDSL(dsl))
+
+	dsl.Jobs[0].Steps[1].If = "success("
+	assert.ErrorContains(t, ValidateDSL(dsl), "failed to parse expression")
+
+	dsl.Jobs[0].Steps[1].If = "cancelled()"
+	assert.ErrorContains(t, ValidateDSL(dsl), "unknown function 'cancelled'")
+
+	dsl.Jobs[0].Steps[1].If = "vars.A == 'b'"
+	assert.ErrorContains(t, ValidateDSL(dsl), "unknown variable 'vars'")
+
+	dsl.Jobs[0].Steps[1].If = "steps.not_exist.status == 'failed'"
+	assert.ErrorContains(t, ValidateDSL(dsl), "step 'not_exist' referred by condition of step 'notify' is not found")
+
+	t.Run("later_step", func(t *testing.T) {
+		dsl := getDSL()
+		dsl.Jobs[0].Steps = []*StepDSL{
+			{Name: "build", If: "steps.test.status == 'failed'"},
+			{Name: "test"},
+		}
+		assert.ErrorContains(t, ValidateDSL(dsl), "step 'test' referred by condition of step 'build' doesn't run before it")
+	})
+	t.Run("dag", func(t *testing.T) {
+		dsl := getDSL()
+		dsl.Jobs[0].Steps = []*StepDSL{
+			{Name: "checkout"},
+			{Name: "build", DependsOn: []string{"checkout"}},
+			{Name: "lint", DependsOn: []string{"checkout"}},
+			{Name: "notify", DependsOn: []string{"build"}, If: "steps.checkout.status == 'failed'"},
+		}
+		assert.NilError(t, ValidateDSL(dsl))
+
+		dsl.Jobs[0].Steps[3].If = "steps.lint.status == 'failed'"
+		assert.ErrorContains(t, ValidateDSL(dsl), "step 'lint' referred by condition of step 'notify' doesn't run before it")
+	})
+}
+
+func TestValidateDSL_StepContainer(t *testing.T) {
+	dsl := getDSL()
+	dsl.Jobs[0].RunsOn.Docker = &Docker{
+		Containers:       []*Container{{Name: "golang"}, {Name: "node"}},
+		DefaultContainer: "golang",
+	}
+	dsl.Jobs[0].Steps[0].Container = "node"
+	assert.NilError(t, ValidateDSL(dsl))
+
+	dsl.Jobs[0].Steps[0].Container = "python"
+	assert.ErrorContains(t, ValidateDSL(dsl), "container 'python' of step 'step_uid' is not defined")
+
+	dsl.Jobs[0].Steps[0].Container = ""
+	dsl.Jobs[0].RunsOn.Docker.DefaultContainer = "python"
+	assert.ErrorContains(t, ValidateDSL(dsl), "default container 'python' is not defined")
+
+	dsl = getDSL()
+	dsl.Jobs[0].Steps[0].Container = "golang"
+	assert.ErrorContains(t, ValidateDSL(dsl), "container 'golang' of step 'step_uid' is not defined")
+}
+
+func TestValidateDSL_Artifacts(t *testing.T) {
+	dsl := getDSL()
+	dsl.Jobs[0].Artifacts = []*ArtifactUpload{{Name: "dist", Paths: []string{"dist"}}}
+	dsl.Jobs[0].Steps[0].Artifacts = []*ArtifactUpload{{Name: "report.xml", Paths: []string{"*.xml"}, RetentionDays: 7}}
+	assert.NilError(t, ValidateDSL(dsl))
+
+	dsl.Jobs[0].Steps[0].Artifacts[0].Paths = nil
+	assert.Assert(t, ValidateDSL(dsl) != nil)
+
+	dsl.Jobs[0].Steps[0].Artifacts[0] = &ArtifactUpload{Name: "dist", Paths: []string{"dist"}}
+	assert.ErrorContains(t, ValidateDSL(dsl), "artifact 'dist' is duplicated")
+
+	dsl.Jobs[0].Steps[0].Artifacts[0] = &ArtifactUpload{Name: "../dist", Paths: []string{"dist"}}
+	assert.ErrorContains(t, ValidateDSL(dsl), "invalid artifact name '../dist'")
+
+	dsl = getDSL()
+	dsl.Jobs = append(dsl.Jobs, &JobDSL{
+		Name:              "deploy",
+		RunsOn:            &RunsOn{Label: "label"},
+		DependsOn:         []string{"uid"},
+		Steps:             []*StepDSL{{Name: "deploy"}},
+		DownloadArtifacts: []*ArtifactDownload{{Job: "uid", Name: "dist"}},
+	})
+	assert.NilError(t, ValidateDSL(dsl))
+
+	dsl.Jobs[1].DownloadArtifacts[0].Job = "build"
+	assert.ErrorContains(t, ValidateDSL(dsl), "job 'build' of artifact 'dist' is not in depends_on")
+}
+
+func TestValidateDSL_Cache(t *testing.T) {
+	dsl := getDSL()
+	dsl.Jobs[0].Cache = &CacheDSL{
+		Key:         `go-${hashFiles("go.sum")}`,
+		RestoreKeys: []string{"go-"},
+		Paths:       []string{".cache/go"},
+	}
+	assert.NilError(t, ValidateDSL(dsl))
+
+	dsl.Jobs[0].Cache.Paths = nil
+	assert.Assert(t, ValidateDSL(dsl) != nil)
+
+	dsl.Jobs[0].Cache.Paths = []string{".cache/go"}
+	dsl.Jobs[0].Cache.RestoreKeys = []string{`go-${hashFile("go.sum")}`}
+	assert.ErrorContains(t, ValidateDSL(dsl), "unknown function 'hashFile'")
 }
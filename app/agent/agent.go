@@ -2,7 +2,9 @@ package agent
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cox96de/runner/api"
@@ -10,55 +12,130 @@ import (
 	"github.com/cox96de/runner/log"
 	"github.com/cox96de/runner/util"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var StopError = util.StringError("agent is closed")
 
+// agentHeartbeatInterval is the interval of reporting running jobs to the server.
+const agentHeartbeatInterval = time.Second * 10
+
+// requestJobWaitTimeout is the duration that the server waits for a job in a job request.
+// Servers don't support long polling ignore it.
+const requestJobWaitTimeout = time.Second * 30
+
+// Info describes the agent, it's reported to the server on registering.
+type Info struct {
+	// Name identifies the agent in the server, agents with the same name share the same record.
+	Name     string
+	Hostname string
+	Version  string
+	Engine   string
+}
+
 type Agent struct {
 	engine engine.Engine
 	client api.ServerClient
-	label  string
+	labels []string
+	info   *Info
 	stop   chan struct{}
+	// killGracePeriod is the duration between SIGTERM and SIGKILL when a running step is stopped.
+	killGracePeriod time.Duration
+	// id is assigned by the server on registering.
+	id atomic.Int64
+	// concurrency is reported on registering.
+	concurrency int
+	runningLock sync.Mutex
+	// running is the set of running job execution ids.
+	running map[int64]struct{}
+	// longPolling is true if the server waits for jobs in job requests, so job requests are sent without waiting
+	// for the fetch interval.
+	longPolling atomic.Bool
 }
 
-func NewAgent(engine engine.Engine, client api.ServerClient, label string) *Agent {
-	return &Agent{engine: engine, client: client, label: label, stop: make(chan struct{})}
+func NewAgent(engine engine.Engine, client api.ServerClient, labels []string, killGracePeriod time.Duration,
+	info *Info,
+) *Agent {
+	return &Agent{
+		engine: engine, client: client, labels: labels, info: info, stop: make(chan struct{}),
+		killGracePeriod: killGracePeriod, running: map[int64]struct{}{},
+	}
 }
 
 func (a *Agent) poll(ctx context.Context, ticker chan struct{}) (*api.Job, error) {
 	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-a.stop:
-			return nil, StopError
-		case <-ticker:
-			requestJobResponse, err := a.client.RequestJob(ctx, &api.RequestJobRequest{
-				Label: a.label,
-			})
-			if err != nil {
-				log.Errorf("failed to request job: %v", err)
-				continue
+		if a.longPolling.Load() {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-a.stop:
+				return nil, StopError
+			default:
 			}
-			if err != nil {
-				log.Errorf("failed to request job: %v", err)
-				continue
+		} else {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-a.stop:
+				return nil, StopError
+			case <-ticker:
 			}
-			job := requestJobResponse.Job
-			if job != nil {
-				select {
-				// Accept new job immediately, no need to wait for a period.
-				case ticker <- struct{}{}:
-				default:
+		}
+		job, err := a.requestJob(ctx)
+		if err != nil {
+			select {
+			case <-a.stop:
+				return nil, StopError
+			default:
+			}
+			log.Errorf("failed to request job: %v", err)
+			continue
+		}
+		if job != nil {
+			select {
+			// Accept new job immediately, no need to wait for a period.
+			case ticker <- struct{}{}:
+			default:
 
-				}
-				return job, nil
 			}
+			return job, nil
+		}
+	}
+}
+
+// requestJob requests a job from the server, the request is canceled if the agent is stopped.
+// It falls back to polling by the fetch interval if the server doesn't support long polling or the request fails.
+func (a *Agent) requestJob(ctx context.Context) (*api.Job, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-a.stop:
+			cancel()
+		case <-ctx.Done():
 		}
+	}()
+	requestJobResponse, err := a.client.RequestJob(ctx, &api.RequestJobRequest{
+		Labels:      a.labels,
+		AgentID:     a.id.Load(),
+		WaitTimeout: int32(requestJobWaitTimeout / time.Second),
+	})
+	if err != nil {
+		a.longPolling.Store(false)
+		return nil, err
 	}
+	a.longPolling.Store(requestJobResponse.LongPolling)
+	return requestJobResponse.Job, nil
 }
 
 func (a *Agent) Run(ctx context.Context, concurrency int, fetchInterval time.Duration) error {
+	if err := a.register(ctx, concurrency); err != nil {
+		return err
+	}
+	exited := make(chan struct{})
+	defer close(exited)
+	go a.heartbeat(ctx, agentHeartbeatInterval, exited)
 	wg := &sync.WaitGroup{}
 	ticker := make(chan struct{})
 	go func() {
@@ -88,8 +165,11 @@ func (a *Agent) Run(ctx context.Context, concurrency int, fetchInterval time.Dur
 				}
 				logger := log.ExtractLogger(ctx).WithFields(log.Fields{"job": job.ID, "job_execution": job.Execution.ID})
 				logger.Infof("got job")
-				err = NewExecution(a.engine, job, a.client).
-					Execute(log.WithLogger(ctx, logger))
+				execution := NewExecution(a.engine, job, a.client)
+				execution.killGracePeriod = a.killGracePeriod
+				a.setRunning(job.Execution.ID, true)
+				err = execution.Execute(log.WithLogger(ctx, logger))
+				a.setRunning(job.Execution.ID, false)
 				if err != nil {
 					logger.Errorf("failed to execute job: %v", err)
 				}
@@ -103,6 +183,82 @@ func (a *Agent) Run(ctx context.Context, concurrency int, fetchInterval time.Dur
 	return nil
 }
 
+// register registers the agent to the server. The agent isn't registered if info is not set.
+func (a *Agent) register(ctx context.Context, concurrency int) error {
+	if a.info == nil {
+		return nil
+	}
+	registerAgentResponse, err := a.client.RegisterAgent(ctx, &api.RegisterAgentRequest{
+		Name:        a.info.Name,
+		Hostname:    a.info.Hostname,
+		Version:     a.info.Version,
+		Engine:      a.info.Engine,
+		Labels:      a.labels,
+		Concurrency: int32(concurrency),
+	})
+	if err != nil {
+		return errors.WithMessage(err, "failed to register agent")
+	}
+	a.id.Store(registerAgentResponse.Agent.ID)
+	a.concurrency = concurrency
+	log.Infof("agent '%s' is registered with id %d", a.info.Name, registerAgentResponse.Agent.ID)
+	return nil
+}
+
+// heartbeat reports running jobs to the server periodically until exited is closed.
+// The agent is registered again if the server doesn't know it, e.g. the record is deleted.
+func (a *Agent) heartbeat(ctx context.Context, interval time.Duration, exited chan struct{}) {
+	if a.id.Load() == 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-exited:
+			return
+		case <-ticker.C:
+			_, err := a.client.AgentHeartbeat(ctx, &api.AgentHeartbeatRequest{
+				AgentID:                a.id.Load(),
+				RunningJobExecutionIDs: a.getRunning(),
+			})
+			if status.Code(err) == codes.NotFound {
+				log.Warningf("agent is not found by the server, register again")
+				err = a.register(ctx, a.concurrency)
+			}
+			if err != nil {
+				log.Warningf("failed to send agent heartbeat: %v", err)
+			}
+		}
+	}
+}
+
+func (a *Agent) setRunning(jobExecutionID int64, running bool) {
+	a.runningLock.Lock()
+	defer a.runningLock.Unlock()
+	if running {
+		a.running[jobExecutionID] = struct{}{}
+	} else {
+		delete(a.running, jobExecutionID)
+	}
+}
+
+// getRunning returns ids of running job executions in ascending order.
+func (a *Agent) getRunning() []int64 {
+	a.runningLock.Lock()
+	defer a.runningLock.Unlock()
+	ids := make([]int64, 0, len(a.running))
+	for id := range a.running {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	return ids
+}
+
 // GracefulShutdown stops to accept new jobs and await all accepted jobs to be completed and to exit.
 func (a *Agent) GracefulShutdown() {
 	close(a.stop)
@@ -3,10 +3,14 @@ package agent
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/cox96de/runner/api"
 	mockapi "github.com/cox96de/runner/api/mock"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gotest.tools/v3/assert"
 )
 
@@ -28,6 +32,107 @@ func TestAgent_poll(t *testing.T) {
 	assert.Equal(t, dispatchJob, polledJob)
 }
 
+func TestAgent_poll_longPolling(t *testing.T) {
+	client := mockapi.NewMockServerClient(gomock.NewController(t))
+	dispatchJob := &api.Job{}
+	client.EXPECT().RequestJob(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *api.RequestJobRequest, _ ...grpc.CallOption) (*api.RequestJobResponse, error) {
+			assert.Equal(t, request.WaitTimeout, int32(requestJobWaitTimeout/time.Second))
+			return &api.RequestJobResponse{LongPolling: true}, nil
+		})
+	client.EXPECT().RequestJob(gomock.Any(), gomock.Any()).Return(&api.RequestJobResponse{
+		Job:         dispatchJob,
+		LongPolling: true,
+	}, nil)
+	a := &Agent{
+		client: client,
+		stop:   make(chan struct{}),
+	}
+	// Only the first request waits for the ticker.
+	c := make(chan struct{}, 1)
+	c <- struct{}{}
+	polledJob, err := a.poll(context.Background(), c)
+	assert.NilError(t, err)
+	assert.Equal(t, dispatchJob, polledJob)
+	t.Run("stop", func(t *testing.T) {
+		client.EXPECT().RequestJob(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, _ *api.RequestJobRequest, _ ...grpc.CallOption) (*api.RequestJobResponse, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			})
+		go func() {
+			time.Sleep(time.Millisecond * 100)
+			a.GracefulShutdown()
+		}()
+		_, err := a.poll(context.Background(), c)
+		assert.ErrorIs(t, err, StopError)
+	})
+}
+
+func TestAgent_heartbeat(t *testing.T) {
+	client := mockapi.NewMockServerClient(gomock.NewController(t))
+	client.EXPECT().RegisterAgent(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *api.RegisterAgentRequest, _ ...grpc.CallOption) (*api.RegisterAgentResponse, error) {
+			assert.DeepEqual(t, request.Labels, []string{"linux"})
+			assert.Equal(t, request.Concurrency, int32(2))
+			return &api.RegisterAgentResponse{Agent: &api.Agent{ID: 1}}, nil
+		})
+	heartbeats := make(chan *api.AgentHeartbeatRequest, 1)
+	client.EXPECT().AgentHeartbeat(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *api.AgentHeartbeatRequest, _ ...grpc.CallOption) (*api.AgentHeartbeatResponse, error) {
+			select {
+			case heartbeats <- request:
+			default:
+			}
+			return &api.AgentHeartbeatResponse{}, nil
+		}).AnyTimes()
+	a := NewAgent(nil, client, []string{"linux"}, 0, &Info{Name: "agent"})
+	err := a.register(context.Background(), 2)
+	assert.NilError(t, err)
+	a.setRunning(2, true)
+	a.setRunning(1, true)
+	a.setRunning(3, true)
+	a.setRunning(3, false)
+	exited := make(chan struct{})
+	defer close(exited)
+	go a.heartbeat(context.Background(), time.Millisecond*10, exited)
+	heartbeat := <-heartbeats
+	assert.Equal(t, heartbeat.AgentID, int64(1))
+	assert.DeepEqual(t, heartbeat.RunningJobExecutionIDs, []int64{1, 2})
+}
+
+func TestAgent_heartbeat_notFound(t *testing.T) {
+	client := mockapi.NewMockServerClient(gomock.NewController(t))
+	var registered int64
+	client.EXPECT().RegisterAgent(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *api.RegisterAgentRequest, _ ...grpc.CallOption) (*api.RegisterAgentResponse, error) {
+			assert.Equal(t, request.Concurrency, int32(2))
+			registered++
+			return &api.RegisterAgentResponse{Agent: &api.Agent{ID: registered}}, nil
+		}).Times(2)
+	heartbeats := make(chan *api.AgentHeartbeatRequest, 1)
+	client.EXPECT().AgentHeartbeat(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *api.AgentHeartbeatRequest, _ ...grpc.CallOption) (*api.AgentHeartbeatResponse, error) {
+			if request.AgentID == 1 {
+				// The agent is deleted from the server.
+				return nil, status.Error(codes.NotFound, "agent '1' is not registered")
+			}
+			select {
+			case heartbeats <- request:
+			default:
+			}
+			return &api.AgentHeartbeatResponse{}, nil
+		}).AnyTimes()
+	a := NewAgent(nil, client, []string{"linux"}, 0, &Info{Name: "agent"})
+	err := a.register(context.Background(), 2)
+	assert.NilError(t, err)
+	exited := make(chan struct{})
+	defer close(exited)
+	go a.heartbeat(context.Background(), time.Millisecond*10, exited)
+	heartbeat := <-heartbeats
+	assert.Equal(t, heartbeat.AgentID, int64(2))
+}
+
 func TestAgent_Run(t *testing.T) {
 	t.Run("concurrency", func(t *testing.T) {
 		// TODO: add test.
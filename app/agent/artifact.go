@@ -0,0 +1,167 @@
+package agent
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/app/executor/executorpb"
+	"github.com/cox96de/runner/log"
+)
+
+// getJobExecutor returns the executor for job level operations, it's the executor of the default container.
+func (e *Execution) getJobExecutor(ctx context.Context) (executorpb.ExecutorClient, error) {
+	var container string
+	if e.job.RunsOn != nil && e.job.RunsOn.Docker != nil {
+		container = e.job.RunsOn.Docker.DefaultContainer
+	}
+	return e.getExecutor(ctx, e.runner, &api.Step{Container: container})
+}
+
+// restoreArtifacts downloads artifacts in `download_artifacts` of the job and extracts them in the runner.
+// The latest one is used if the artifact is uploaded by multiple executions of the job.
+func (e *Execution) restoreArtifacts(ctx context.Context) error {
+	if len(e.job.DownloadArtifacts) == 0 {
+		return nil
+	}
+	logger := log.ExtractLogger(ctx)
+	executor, err := e.getJobExecutor(ctx)
+	if err != nil {
+		return errors.WithMessage(err, "failed to get executor")
+	}
+	for _, download := range e.job.DownloadArtifacts {
+		listArtifactsResponse, err := e.client.ListArtifacts(ctx, &api.ListArtifactsRequest{
+			PipelineID: e.job.PipelineID,
+			JobName:    download.Job,
+			Name:       download.Name,
+		})
+		if err != nil {
+			return errors.WithMessagef(err, "failed to list artifacts of job '%s'", download.Job)
+		}
+		artifacts := listArtifactsResponse.Artifacts
+		if len(artifacts) == 0 {
+			return errors.Errorf("artifact '%s' of job '%s' is not found", download.Name, download.Job)
+		}
+		artifact := artifacts[len(artifacts)-1]
+		dir := joinPath(e.job.WorkingDirectory, download.Path)
+		logger.Infof("restore artifact '%s' of job '%s' to '%s', size: %d bytes", download.Name, download.Job, dir,
+			artifact.Size)
+		if err = e.restoreArtifact(ctx, executor, artifact.ID, dir); err != nil {
+			return errors.WithMessagef(err, "failed to restore artifact '%s' of job '%s'", download.Name,
+				download.Job)
+		}
+	}
+	return nil
+}
+
+func (e *Execution) restoreArtifact(ctx context.Context, executor executorpb.ExecutorClient, artifactID int64,
+	dir string,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	download, err := e.client.DownloadArtifact(ctx, &api.DownloadArtifactRequest{ArtifactID: artifactID})
+	if err != nil {
+		return errors.WithMessage(err, "failed to download artifact")
+	}
+	return putFiles(ctx, executor, dir, func() ([]byte, error) {
+		chunk, err := download.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return chunk.Data, nil
+	})
+}
+
+// uploadArtifacts archives files in the runner and uploads them as artifacts of the job execution.
+// dir is the directory the paths of artifacts relative to.
+func (e *Execution) uploadArtifacts(ctx context.Context, executor executorpb.ExecutorClient, dir string,
+	artifacts []*api.ArtifactUpload,
+) ([]*api.Artifact, error) {
+	uploaded := make([]*api.Artifact, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		a, err := e.uploadArtifact(ctx, executor, dir, artifact)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to upload artifact '%s'", artifact.Name)
+		}
+		uploaded = append(uploaded, a)
+	}
+	return uploaded, nil
+}
+
+func (e *Execution) uploadArtifact(ctx context.Context, executor executorpb.ExecutorClient, dir string,
+	artifact *api.ArtifactUpload,
+) (*api.Artifact, error) {
+	// Cancel the upload if it's not completed.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var upload api.Server_UploadArtifactClient
+	err := getFiles(ctx, executor, dir, artifact.Paths, func(data []byte) error {
+		request := &api.UploadArtifactRequest{Data: data}
+		if upload == nil {
+			// Start uploading after files are archived successfully, the first message carries the metadata.
+			var err error
+			upload, err = e.client.UploadArtifact(ctx)
+			if err != nil {
+				return errors.WithMessage(err, "failed to upload artifact")
+			}
+			request.JobExecutionID = e.jobExecution.ID
+			request.Name = artifact.Name
+			request.RetentionDays = artifact.RetentionDays
+		}
+		return upload.Send(request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if upload == nil {
+		return nil, errors.New("no file is archived")
+	}
+	response, err := upload.CloseAndRecv()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to upload artifact")
+	}
+	return response.Artifact, nil
+}
+
+// uploadJobArtifacts uploads artifacts of the job, the paths are relative to the working directory of the job.
+func (e *Execution) uploadJobArtifacts(ctx context.Context) error {
+	if len(e.job.Artifacts) == 0 {
+		return nil
+	}
+	executor, err := e.getJobExecutor(ctx)
+	if err != nil {
+		return errors.WithMessage(err, "failed to get executor")
+	}
+	artifacts, err := e.uploadArtifacts(ctx, executor, e.job.WorkingDirectory, e.job.Artifacts)
+	if err != nil {
+		return err
+	}
+	for _, artifact := range artifacts {
+		log.ExtractLogger(ctx).Infof("artifact '%s' is uploaded, size: %d bytes", artifact.Name, artifact.Size)
+	}
+	return nil
+}
+
+// joinPath joins p to base if p is relative. Paths are in the runner, so they are not cleaned with the os of agent.
+func joinPath(base string, p string) string {
+	if base == "" || isAbsPath(p) {
+		return p
+	}
+	if p == "" {
+		return base
+	}
+	if strings.Contains(base, `\`) {
+		return strings.TrimRight(base, `\`) + `\` + p
+	}
+	return path.Join(base, p)
+}
+
+// isAbsPath reports whether p is an absolute path in unix or windows.
+func isAbsPath(p string) bool {
+	if strings.HasPrefix(p, "/") || strings.HasPrefix(p, `\`) {
+		return true
+	}
+	return len(p) >= 3 && p[1] == ':' && (p[2] == '\\' || p[2] == '/')
+}
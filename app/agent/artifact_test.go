@@ -0,0 +1,24 @@
+package agent
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestJoinPath(t *testing.T) {
+	for _, c := range []struct {
+		base string
+		p    string
+		want string
+	}{
+		{base: "", p: "dist", want: "dist"},
+		{base: "/workspace", p: "", want: "/workspace"},
+		{base: "/workspace", p: "dist", want: "/workspace/dist"},
+		{base: "/workspace", p: "/tmp/dist", want: "/tmp/dist"},
+		{base: `c:\workspace\`, p: "dist", want: `c:\workspace\dist`},
+		{base: `c:\workspace`, p: `d:\dist`, want: `d:\dist`},
+	} {
+		assert.Equal(t, joinPath(c.base, c.p), c.want, "base: %s, path: %s", c.base, c.p)
+	}
+}
@@ -0,0 +1,165 @@
+package agent
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/app/executor/executorpb"
+	"github.com/cox96de/runner/lib/expr"
+	"github.com/cox96de/runner/log"
+)
+
+// restoreCache restores the cache of the job before the first step. Failures of cache don't fail the job, they are
+// logged as warnings and the job runs without cache.
+func (e *Execution) restoreCache(ctx context.Context) {
+	if e.job.Cache == nil {
+		return
+	}
+	logger := log.ExtractLogger(ctx)
+	hit, err := e.tryRestoreCache(ctx)
+	if err != nil {
+		logger.Warnf("failed to restore cache: %v", err)
+		return
+	}
+	if hit == nil {
+		logger.Infof("cache is not found for key '%s'", e.cacheKey)
+		return
+	}
+	logger.Infof("cache is restored from key '%s', size: %d bytes", hit.Key, hit.Size)
+}
+
+func (e *Execution) tryRestoreCache(ctx context.Context) (*api.Cache, error) {
+	executor, err := e.getJobExecutor(ctx)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get executor")
+	}
+	functions := e.cacheFunctions(ctx, executor)
+	// The key is rendered before steps, so it's not affected by files changed by steps.
+	key, err := renderCacheKey(e.job.Cache.Key, functions)
+	if err != nil {
+		return nil, err
+	}
+	e.cacheKey = key
+	restoreKeys := make([]string, 0, len(e.job.Cache.RestoreKeys))
+	for _, restoreKey := range e.job.Cache.RestoreKeys {
+		rendered, err := renderCacheKey(restoreKey, functions)
+		if err != nil {
+			return nil, err
+		}
+		restoreKeys = append(restoreKeys, rendered)
+	}
+	getCacheResponse, err := e.client.GetCache(ctx, &api.GetCacheRequest{
+		JobExecutionID: e.jobExecution.ID,
+		Key:            key,
+		RestoreKeys:    restoreKeys,
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get cache")
+	}
+	cache := getCacheResponse.Cache
+	if cache == nil {
+		return nil, nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	download, err := e.client.DownloadCache(ctx, &api.DownloadCacheRequest{CacheID: cache.ID})
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to download cache")
+	}
+	err = putFiles(ctx, executor, e.job.WorkingDirectory, func() ([]byte, error) {
+		chunk, err := download.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return chunk.Data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	e.cacheHit = cache.Key == key
+	return cache, nil
+}
+
+// saveCache saves the cache of the job after all steps are succeeded. It's skipped if the cache is restored from
+// the key exactly. Failures of cache are logged as warnings.
+func (e *Execution) saveCache(ctx context.Context) {
+	if e.job.Cache == nil || e.cacheKey == "" || e.cacheHit {
+		return
+	}
+	logger := log.ExtractLogger(ctx)
+	cache, err := e.trySaveCache(ctx)
+	if err != nil {
+		logger.Warnf("failed to save cache: %v", err)
+		return
+	}
+	logger.Infof("cache is saved with key '%s', size: %d bytes", cache.Key, cache.Size)
+}
+
+func (e *Execution) trySaveCache(ctx context.Context) (*api.Cache, error) {
+	executor, err := e.getJobExecutor(ctx)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get executor")
+	}
+	// Cancel the upload if it's not completed.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var upload api.Server_UploadCacheClient
+	err = getFiles(ctx, executor, e.job.WorkingDirectory, e.job.Cache.Paths, func(data []byte) error {
+		request := &api.UploadCacheRequest{Data: data}
+		if upload == nil {
+			// Start uploading after files are archived successfully, the first message carries the metadata.
+			var err error
+			upload, err = e.client.UploadCache(ctx)
+			if err != nil {
+				return errors.WithMessage(err, "failed to upload cache")
+			}
+			request.JobExecutionID = e.jobExecution.ID
+			request.Key = e.cacheKey
+		}
+		return upload.Send(request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if upload == nil {
+		return nil, errors.New("no file is archived")
+	}
+	response, err := upload.CloseAndRecv()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to upload cache")
+	}
+	return response.Cache, nil
+}
+
+// cacheFunctions returns functions available in templates of cache keys.
+func (e *Execution) cacheFunctions(ctx context.Context, executor executorpb.ExecutorClient) map[string]expr.Function {
+	return map[string]expr.Function{
+		api.CacheFuncHashFiles: func(args ...interface{}) (interface{}, error) {
+			patterns := make([]string, 0, len(args))
+			for _, arg := range args {
+				patterns = append(patterns, expr.ToString(arg))
+			}
+			response, err := executor.HashFiles(ctx, &executorpb.HashFilesRequest{
+				Dir:      e.job.WorkingDirectory,
+				Patterns: patterns,
+			})
+			if err != nil {
+				return nil, errors.WithMessagef(err, "failed to hash files %v", patterns)
+			}
+			return response.Hash, nil
+		},
+	}
+}
+
+func renderCacheKey(key string, functions map[string]expr.Function) (string, error) {
+	template, err := api.ParseCacheKey(key)
+	if err != nil {
+		return "", err
+	}
+	rendered, err := template.Render(functions)
+	if err != nil {
+		return "", errors.WithMessagef(err, "failed to render cache key '%s'", key)
+	}
+	return rendered, nil
+}
@@ -0,0 +1,54 @@
+package agent
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/lib/expr"
+	"github.com/samber/lo"
+)
+
+// evaluateCondition evaluates the `if` condition of the step.
+// Status check functions (success(), failure() and always()) are calculated from the deep previous steps.
+// If the condition doesn't contain any status check function, `success() && ` is implied.
+func (e *Execution) evaluateCondition(step *api.Step) (bool, error) {
+	condition, err := api.ParseStepCondition(step.If)
+	if err != nil {
+		return false, err
+	}
+	deepPres, err := e.dag.DeepPre(step.Name)
+	if err != nil {
+		return false, errors.WithMessage(err, "failed to get deep previous steps")
+	}
+	anyFailed := false
+	steps := make(map[string]interface{}, len(deepPres))
+	for _, pre := range deepPres {
+		stepExecution := e.stepExecutions[pre.Step.ID]
+		if stepExecution.Status == api.StatusFailed {
+			anyFailed = true
+		}
+		steps[pre.Name] = map[string]interface{}{
+			"status":    stepExecution.Status.ToString(),
+			"exit_code": stepExecution.ExitCode,
+		}
+	}
+	functions := expr.BuiltinFunctions()
+	functions[api.ConditionFuncSuccess] = func(args ...interface{}) (interface{}, error) {
+		return !anyFailed, nil
+	}
+	functions[api.ConditionFuncFailure] = func(args ...interface{}) (interface{}, error) {
+		return anyFailed, nil
+	}
+	functions[api.ConditionFuncAlways] = func(args ...interface{}) (interface{}, error) {
+		return true, nil
+	}
+	if !lo.SomeBy(condition.Functions(), api.IsStatusConditionFunction) && anyFailed {
+		return false, nil
+	}
+	return condition.EvaluateBool(&expr.Context{
+		Variables: map[string]interface{}{
+			api.ConditionVarEnv:   lo.Assign(e.job.EnvVar, step.EnvVar),
+			api.ConditionVarSteps: steps,
+		},
+		Functions: functions,
+	})
+}
@@ -0,0 +1,148 @@
+package agent
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/app/executor/executorpb"
+	"github.com/cox96de/runner/log"
+	"github.com/cox96de/runner/util"
+)
+
+// serveDebug relays a debug session between the server and the executor of the runner. Failures of debug sessions
+// don't affect the job.
+func (e *Execution) serveDebug(ctx context.Context, sessionID string) {
+	logger := log.ExtractLogger(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := e.client.ServeDebug(ctx)
+	if err != nil {
+		logger.Warnf("failed to serve debug session '%s': %v", sessionID, err)
+		return
+	}
+	if err = stream.Send(&api.ServeDebugRequest{SessionID: sessionID}); err != nil {
+		logger.Warnf("failed to serve debug session '%s': %v", sessionID, err)
+		return
+	}
+	if err = e.relayDebug(ctx, sessionID, stream); err != nil {
+		logger.Warnf("debug session '%s' is failed: %v", sessionID, err)
+		_ = stream.Send(&api.ServeDebugRequest{Response: &api.DebugResponse{
+			Exited:   true,
+			ExitCode: -1,
+			Error:    err.Error(),
+		}})
+	}
+	_ = stream.CloseSend()
+}
+
+func (e *Execution) relayDebug(ctx context.Context, sessionID string, stream api.Server_ServeDebugClient) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return errors.WithMessage(err, "failed to receive the first request")
+	}
+	start := first.Start
+	if start == nil {
+		return errors.New("start is required in the first request")
+	}
+	select {
+	case <-e.runnerStarted:
+	default:
+		return errors.New("runner is not started")
+	}
+	var executor executorpb.ExecutorClient
+	if start.Container == "" {
+		executor, err = e.getJobExecutor(ctx)
+	} else {
+		executor, err = e.getExecutor(ctx, e.runner, &api.Step{Container: start.Container})
+	}
+	if err != nil {
+		return errors.WithMessage(err, "failed to get executor")
+	}
+	commands := start.Commands
+	if len(commands) == 0 {
+		runtimeInfo, err := executor.GetRuntimeInfo(ctx, &executorpb.GetRuntimeInfoRequest{})
+		if err != nil {
+			return errors.WithMessage(err, "failed to get runtime info")
+		}
+		commands = getDebugShell(runtimeInfo.OS)
+	}
+	log.ExtractLogger(ctx).Infof("debug session '%s' runs %v in container '%s'", sessionID, commands,
+		start.Container)
+	exec, err := executor.Exec(ctx)
+	if err != nil {
+		return errors.WithMessage(err, "failed to exec")
+	}
+	execStart := &executorpb.ExecStart{
+		Commands: commands,
+		Dir:      e.job.WorkingDirectory,
+		Username: start.User,
+		TTY:      start.TTY,
+	}
+	if start.Size != nil {
+		execStart.Size = &executorpb.WindowSize{Rows: start.Size.Rows, Cols: start.Size.Cols}
+	}
+	if err = exec.Send(&executorpb.ExecRequest{Start: execStart}); err != nil {
+		return errors.WithMessage(err, "failed to start command")
+	}
+	go func() {
+		for {
+			request, err := stream.Recv()
+			if err != nil {
+				_ = exec.CloseSend()
+				return
+			}
+			execRequest := &executorpb.ExecRequest{Stdin: request.Stdin, CloseStdin: request.CloseStdin}
+			if request.Resize != nil {
+				execRequest.Resize = &executorpb.WindowSize{Rows: request.Resize.Rows, Cols: request.Resize.Cols}
+			}
+			if err = exec.Send(execRequest); err != nil {
+				return
+			}
+		}
+	}()
+	for {
+		execResponse, err := exec.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return errors.New("command is ended without exit status")
+			}
+			return errors.WithMessage(err, "failed to receive from executor")
+		}
+		response := &api.DebugResponse{
+			Output: execResponse.Output,
+			Stderr: execResponse.Source == executorpb.LogSourceStderr,
+		}
+		if execResponse.Status != nil {
+			response.Exited = true
+			response.ExitCode = execResponse.Status.ExitCode
+			response.Error = execResponse.Status.Error
+		}
+		if err = stream.Send(&api.ServeDebugRequest{Response: response}); err != nil {
+			return errors.WithMessage(err, "failed to send to server")
+		}
+		if response.Exited {
+			return nil
+		}
+	}
+}
+
+func getDebugShell(os string) []string {
+	if os == "windows" {
+		return []string{"cmd.exe"}
+	}
+	return []string{"/bin/sh"}
+}
+
+// keepAliveOnFailure delays stopping the runner after the job is failed, so that it can be inspected by debug
+// sessions.
+func (e *Execution) keepAliveOnFailure(ctx context.Context) {
+	if e.job.KeepAliveOnFailure <= 0 || e.jobExecution.Status != api.StatusFailed {
+		return
+	}
+	keepAlive := time.Duration(e.job.KeepAliveOnFailure) * time.Second
+	log.ExtractLogger(ctx).Infof("job is failed, the runner is kept alive for %s for debugging", keepAlive)
+	_ = util.Wait(ctx, keepAlive)
+}
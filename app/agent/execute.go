@@ -19,6 +19,9 @@ import (
 	"github.com/cox96de/runner/engine"
 )
 
+// defaultKillGracePeriod is the default duration between SIGTERM and SIGKILL when a step is stopped.
+const defaultKillGracePeriod = time.Second * 10
+
 type abortedReason uint32
 
 const (
@@ -34,10 +37,17 @@ type Execution struct {
 	stepExecutions   map[int64]*api.StepExecution
 	client           api.ServerClient
 	logFlushInternal time.Duration
+	// killGracePeriod is the duration between SIGTERM and SIGKILL when the running step is stopped
+	// because of cancel or timeout.
+	killGracePeriod time.Duration
 
 	runner    engine.Runner
 	dag       *lib.DAG[*dagNode]
 	logWriter *logCollector
+	// masker masks secrets of the job in logs.
+	masker *lib.Masker
+	// runnerStarted is closed after the runner is started, debug sessions are served after it.
+	runnerStarted chan struct{}
 
 	// jobCtx is the context for the job execution ctx.
 	// If the job has a timeout, it will be canceled when the job is done.
@@ -45,6 +55,11 @@ type Execution struct {
 	jobCtx        context.Context
 	jobCanceller  context.CancelFunc
 	abortedReason atomic.Uint32
+
+	// cacheKey is the key of cache rendered before the first step, the cache is saved with it.
+	cacheKey string
+	// cacheHit is true if the cache is restored from cacheKey exactly, so it needn't be saved again.
+	cacheHit bool
 }
 
 func NewExecution(engine engine.Engine, job *api.Job, client api.ServerClient) *Execution {
@@ -55,6 +70,9 @@ func NewExecution(engine engine.Engine, job *api.Job, client api.ServerClient) *
 		stepExecutions:   map[int64]*api.StepExecution{},
 		client:           client,
 		logFlushInternal: time.Second,
+		killGracePeriod:  defaultKillGracePeriod,
+		runnerStarted:    make(chan struct{}),
+		masker:           lib.NewMasker(lo.Values(job.SecretEnv)),
 	}
 	for _, step := range e.jobExecution.Steps {
 		e.stepExecutions[step.StepID] = step
@@ -67,7 +85,8 @@ func (e *Execution) Execute(ctx context.Context) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	var err error
-	e.logWriter = newLogCollector(e.client, e.jobExecution, "_", log.ExtractLogger(ctx), e.logFlushInternal)
+	e.logWriter = newLogCollector(e.client, e.jobExecution, "_", log.ExtractLogger(ctx), e.logFlushInternal,
+		e.masker)
 	logger := log.ExtractLogger(ctx).WithOutput(io.MultiWriter(os.Stdout, e.logWriter))
 	ctx = log.WithLogger(ctx, logger)
 	e.startMonitor(ctx)
@@ -83,13 +102,21 @@ func (e *Execution) Execute(ctx context.Context) error {
 		}
 		return nil
 	}
+	if err = e.interpolateJob(); err != nil {
+		logger.Errorf("failed to interpolate job: %v", err)
+		if err = e.updateJobStatus(ctx, api.StatusFailed, nil); err != nil {
+			return errors.WithMessage(err, "failed to update status")
+		}
+		return nil
+	}
 	e.runner, err = e.engine.CreateRunner(e.jobCtx, e, e.job)
 	if err != nil {
 		return errors.WithMessage(err, "failed to create runner")
 	}
 	// TODO: timeout is configurable.
-	startCtx, _ := context.WithTimeout(ctx, time.Minute*4)
+	startCtx, startCancel := context.WithTimeout(ctx, time.Minute*4)
 	err = e.runner.Start(startCtx)
+	startCancel()
 	if err != nil {
 		e.stop(ctx)
 		logger.Errorf("failed to start runner: %v", err)
@@ -99,23 +126,25 @@ func (e *Execution) Execute(ctx context.Context) error {
 		}
 		return nil
 	}
-	defer e.stop(ctx)
+	close(e.runnerStarted)
+	defer func() {
+		e.keepAliveOnFailure(ctx)
+		e.stop(ctx)
+	}()
 	if err = e.updateJobStatus(ctx, api.StatusRunning, nil); err != nil {
 		return errors.WithMessage(err, "failed to update status")
 	}
-	if e.job.Timeout > 0 {
-		var timeoutCancel context.CancelFunc
-		e.jobCtx, timeoutCancel = context.WithCancel(ctx)
-		go func() {
-			select {
-			case <-time.After(time.Duration(e.job.Timeout) * time.Second):
-				e.abortedReason.Store(uint32(TimeoutAbortReason))
-				timeoutCancel()
-			case <-ctx.Done():
-			}
-		}()
-		defer timeoutCancel()
+	if err = e.restoreArtifacts(ctx); err != nil {
+		logger.Errorf("failed to restore artifacts: %v", err)
+		if err = e.updateJobStatus(ctx, api.StatusFailed, &api.Reason{
+			Reason:  api.FailedReasonInternalError,
+			Message: err.Error(),
+		}); err != nil {
+			return errors.WithMessage(err, "failed to update status")
+		}
+		return nil
 	}
+	e.restoreCache(ctx)
 	if err = e.executeSteps(ctx); err != nil {
 		if !isErrorContextCancel(err) {
 			return errors.WithMessage(err, "failed to execute steps")
@@ -152,6 +181,15 @@ func (e *Execution) updateJobFinalStatus(ctx context.Context) error {
 		if abortedReason(e.abortedReason.Load()) == TimeoutAbortReason {
 			reason.Reason = api.FailedReasonTimeout
 		}
+	} else if err := e.uploadJobArtifacts(ctx); err != nil {
+		logger.Errorf("failed to upload artifacts: %v", err)
+		jobStatus = api.StatusFailed
+		reason = &api.Reason{
+			Reason:  api.FailedReasonInternalError,
+			Message: err.Error(),
+		}
+	} else {
+		e.saveCache(ctx)
 	}
 	execution, err := e.client.UpdateJobExecution(ctx, &api.UpdateJobExecutionRequest{
 		JobExecutionID: e.jobExecution.ID,
@@ -207,16 +245,38 @@ func (e *Execution) isSerialSteps() bool {
 func (e *Execution) executeSteps(ctx context.Context) error {
 	e.normalizeSerialSteps()
 	dagRunner := dag.NewRunner()
+	// limiter limits the number of steps running at the same time, nil means no limit.
+	var limiter chan struct{}
+	if e.job.MaxStepParallelism > 0 {
+		limiter = make(chan struct{}, e.job.MaxStepParallelism)
+	}
 	for _, step := range e.job.Steps {
-		err := e.executeStep(ctx, step)
-		if err != nil {
+		dagRunner.AddVertex(step.Name, func() error {
+			if limiter != nil {
+				select {
+				case limiter <- struct{}{}:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+				defer func() {
+					<-limiter
+				}()
+			}
+			err := e.executeStep(ctx, step)
+			if err == nil {
+				return nil
+			}
 			if !isErrorContextCancel(err) {
 				// TODO: update job status to failed with reason.
 				return errors.WithMessagef(err, "failed to execute step '%s'", step.Name)
 			}
-			if err = e.updateStepExecution(ctx, step, lo.ToPtr(api.StatusFailed), nil); err != nil {
+			if err = e.updateStepExecution(ctx, step, lo.ToPtr(api.StatusFailed), nil, nil); err != nil {
 				return errors.WithMessage(err, "failed to update step jobExecution")
 			}
+			return nil
+		})
+		for _, depend := range step.DependsOn {
+			dagRunner.AddEdge(depend, step.Name)
 		}
 	}
 	return dagRunner.Run()
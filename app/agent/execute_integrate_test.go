@@ -1,36 +1,56 @@
 package agent
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/app/executor/executorpb"
+	"github.com/cox96de/runner/engine"
+	"google.golang.org/grpc"
+
 	"github.com/cox96de/runner/app/server/eventhook"
 
 	"gotest.tools/v3/fs"
 
 	"github.com/cox96de/runner/api/httpserverclient"
+	"github.com/cox96de/runner/app/server/artifact"
+	"github.com/cox96de/runner/app/server/cache"
 	"github.com/cox96de/runner/app/server/dispatch"
 	"github.com/cox96de/runner/app/server/handler"
 	"github.com/cox96de/runner/app/server/logstorage"
+	"github.com/cox96de/runner/app/server/notify"
 	"github.com/cox96de/runner/app/server/pipeline"
+	"github.com/cox96de/runner/app/server/secret"
 	"github.com/cox96de/runner/mock"
 	"github.com/gin-gonic/gin"
 
 	"github.com/cox96de/runner/api"
 
 	"github.com/cox96de/runner/engine/shell"
+	"github.com/samber/lo"
 	"gotest.tools/v3/assert"
 )
 
 func newMockServerHandler(t *testing.T) *httpserverclient.Client {
 	dbClient := mock.NewMockDB(t)
 	eventhook := eventhook.NewService(eventhook.NewNopSender())
-	h := handler.NewHandler(dbClient, pipeline.NewService(dbClient), dispatch.NewService(dbClient, eventhook), mock.NewMockLocker(),
-		logstorage.NewService(mock.NewMockRedis(t), logstorage.NewFilesystemOSS(fs.NewDir(t, "baseDir").Path())), eventhook)
+	oss := logstorage.NewFilesystemOSS(fs.NewDir(t, "baseDir").Path())
+	secretCipher, err := secret.NewCipher(bytes.Repeat([]byte("k"), 32))
+	assert.NilError(t, err)
+	h := handler.NewHandler(dbClient, pipeline.NewService(dbClient), dispatch.NewService(dbClient, eventhook, notify.NewLocalNotifier()), mock.NewMockLocker(),
+		logstorage.NewService(mock.NewMockRedis(t), oss), eventhook, artifact.NewService(dbClient, oss),
+		cache.NewService(dbClient, cache.NewFilesystemStorage(fs.NewDir(t, "cache").Path()), 0),
+		secret.NewService(dbClient, secretCipher), nil)
 	engine := gin.New()
 	h.RegisterRouter(engine.Group(""))
 	server := httptest.NewServer(engine)
@@ -39,6 +59,76 @@ func newMockServerHandler(t *testing.T) *httpserverclient.Client {
 	return client
 }
 
+// multipleContainerEngine creates runners with multiple containers. Each container is simulated by a shell
+// runner, commands started in a container have the environment variable `CONTAINER` set to the container name.
+type multipleContainerEngine struct{}
+
+func (m *multipleContainerEngine) Ping(_ context.Context) error {
+	return nil
+}
+
+func (m *multipleContainerEngine) CreateRunner(_ context.Context, _ engine.LogProvider, job *api.Job) (engine.Runner, error) {
+	r := &multipleContainerRunner{
+		defaultContainer: job.RunsOn.Docker.DefaultContainer,
+		containers:       map[string]*shell.Runner{},
+	}
+	for _, container := range job.RunsOn.Docker.Containers {
+		r.containers[container.Name] = shell.NewRunner()
+	}
+	return r, nil
+}
+
+type multipleContainerRunner struct {
+	defaultContainer string
+	containers       map[string]*shell.Runner
+}
+
+func (m *multipleContainerRunner) Start(ctx context.Context) error {
+	for _, container := range m.containers {
+		if err := container.Start(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *multipleContainerRunner) GetExecutor(ctx context.Context) (executorpb.ExecutorClient, error) {
+	return m.GetContainerExecutor(ctx, m.defaultContainer)
+}
+
+func (m *multipleContainerRunner) GetContainerExecutor(ctx context.Context, containerName string) (executorpb.ExecutorClient, error) {
+	container, ok := m.containers[containerName]
+	if !ok {
+		return nil, errors.Errorf("container '%s' not found", containerName)
+	}
+	executor, err := container.GetExecutor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &containerExecutor{ExecutorClient: executor, name: containerName}, nil
+}
+
+func (m *multipleContainerRunner) Stop(ctx context.Context) error {
+	for _, container := range m.containers {
+		if err := container.Stop(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type containerExecutor struct {
+	executorpb.ExecutorClient
+	name string
+}
+
+func (c *containerExecutor) StartCommand(ctx context.Context, in *executorpb.StartCommandRequest,
+	opts ...grpc.CallOption,
+) (*executorpb.StartCommandResponse, error) {
+	in.Env = append(in.Env, "CONTAINER="+c.name)
+	return c.ExecutorClient.StartCommand(ctx, in, opts...)
+}
+
 func TestExecution(t *testing.T) {
 	t.Run("simple", func(t *testing.T) {
 		if runtime.GOOS == "windows" {
@@ -117,6 +207,155 @@ func TestExecution(t *testing.T) {
 		assert.Equal(t, jobExecution.Steps[1].Status, api.StatusSkipped)
 		assert.Equal(t, jobExecution.Reason.Reason, api.FailedReasonTimeout)
 	})
+	t.Run("graceful_stop", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip test on windows")
+		}
+		client := newMockServerHandler(t)
+		ctx := context.Background()
+		label := t.Name()
+		_, err := client.CreatePipeline(ctx, &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{{
+					RunsOn:  &api.RunsOn{Label: label},
+					Name:    "job1",
+					Timeout: 1,
+					Steps: []*api.StepDSL{{
+						Name:     "step1",
+						Commands: []string{"trap 'echo graceful; exit 1' TERM", "while true; do sleep 0.1; done"},
+					}},
+				}},
+			},
+		})
+		assert.NilError(t, err)
+		requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{Label: label})
+		assert.NilError(t, err)
+		execution := NewExecution(shell.NewEngine(), requestJobResponse.Job, client)
+		execution.killGracePeriod = time.Second * 5
+		err = execution.Execute(ctx)
+		assert.NilError(t, err)
+		getLogLinesResponse, err := client.GetLogLines(ctx, &api.GetLogLinesRequest{
+			JobExecutionID: requestJobResponse.Job.Execution.ID,
+			Name:           "step1",
+		})
+		assert.NilError(t, err)
+		assert.Assert(t, lo.ContainsBy(getLogLinesResponse.Lines, func(item *api.LogLine) bool {
+			return strings.Contains(item.Output, "graceful")
+		}))
+	})
+	t.Run("graceful_stop_on_cancel", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip test on windows")
+		}
+		client := newMockServerHandler(t)
+		ctx := context.Background()
+		label := t.Name()
+		_, err := client.CreatePipeline(ctx, &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{{
+					RunsOn:  &api.RunsOn{Label: label},
+					Name:    "job1",
+					Timeout: int32(time.Hour / time.Second),
+					Steps: []*api.StepDSL{{
+						Name: "step1",
+						// SIGTERM is ignored, so the step is killed after the grace period.
+						Commands: []string{"trap 'echo graceful' TERM", "while true; do sleep 0.1; done"},
+					}},
+				}},
+			},
+		})
+		assert.NilError(t, err)
+		requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{Label: label})
+		assert.NilError(t, err)
+		execution := NewExecution(shell.NewEngine(), requestJobResponse.Job, client)
+		execution.killGracePeriod = time.Second
+		executed := make(chan error, 1)
+		go func() {
+			executed <- execution.Execute(ctx)
+		}()
+		// Cancel the job once it's running, the cancellation is noticed by the next heartbeat.
+		for {
+			getJobExecutionResponse, err := client.GetJobExecution(ctx, &api.GetJobExecutionRequest{
+				JobExecutionID: requestJobResponse.Job.Execution.ID,
+			})
+			assert.NilError(t, err)
+			if getJobExecutionResponse.JobExecution.Status == api.StatusRunning {
+				break
+			}
+			time.Sleep(time.Millisecond * 10)
+		}
+		_, err = client.CancelJobExecution(ctx, &api.CancelJobExecutionRequest{
+			JobExecutionID: requestJobResponse.Job.Execution.ID,
+		})
+		assert.NilError(t, err)
+		select {
+		case err = <-executed:
+			assert.NilError(t, err)
+		case <-time.After(time.Second * 30):
+			t.Fatal("the canceled job is not stopped")
+		}
+		getLogLinesResponse, err := client.GetLogLines(ctx, &api.GetLogLinesRequest{
+			JobExecutionID: requestJobResponse.Job.Execution.ID,
+			Name:           "step1",
+		})
+		assert.NilError(t, err)
+		assert.Assert(t, lo.ContainsBy(getLogLinesResponse.Lines, func(item *api.LogLine) bool {
+			return strings.Contains(item.Output, "graceful")
+		}))
+		assert.Assert(t, execution.stepExecutions[requestJobResponse.Job.Steps[0].ID].Status.IsCompleted())
+	})
+	t.Run("step_timeout", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip test on windows")
+		}
+		client := newMockServerHandler(t)
+		ctx := context.Background()
+		label := t.Name()
+		_, err := client.CreatePipeline(ctx, &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{{
+					RunsOn:  &api.RunsOn{Label: label},
+					Name:    "job1",
+					Timeout: int32(time.Hour / time.Second),
+					Steps: []*api.StepDSL{
+						{
+							Name:     "step1",
+							Commands: []string{"sleep 30 & wait"},
+							Timeout:  1,
+						},
+						{
+							Name:     "step2",
+							Commands: []string{"echo 'on failure'"},
+							If:       "failure()",
+						},
+						{
+							Name:     "step3",
+							Commands: []string{"echo 'should skip'"},
+						},
+					},
+				}},
+			},
+		})
+		assert.NilError(t, err)
+		requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{Label: label})
+		assert.NilError(t, err)
+		execution := NewExecution(shell.NewEngine(), requestJobResponse.Job, client)
+		assert.Assert(t, execution != nil)
+		start := time.Now()
+		err = execution.Execute(ctx)
+		assert.NilError(t, err)
+		assert.Assert(t, time.Since(start) < time.Second*20, "step is not killed in time")
+		executions, err := client.ListJobExecutions(ctx, &api.ListJobExecutionsRequest{
+			JobID: requestJobResponse.Job.ID,
+		})
+		assert.NilError(t, err)
+		jobExecution := executions.Jobs[0]
+		assert.Equal(t, jobExecution.Status, api.StatusFailed)
+		assert.Equal(t, jobExecution.Steps[0].Status, api.StatusFailed)
+		assert.Equal(t, jobExecution.Steps[0].Reason.Reason, api.FailedReasonTimeout)
+		assert.Equal(t, jobExecution.Steps[1].Status, api.StatusSucceeded)
+		assert.Equal(t, jobExecution.Steps[2].Status, api.StatusSkipped)
+	})
 	t.Run("step_failed", func(t *testing.T) {
 		if runtime.GOOS == "windows" {
 			t.Skip("skip test on windows")
@@ -307,4 +546,733 @@ func TestExecution(t *testing.T) {
 		jobExecution := executions.Jobs[0]
 		assert.Equal(t, jobExecution.Status, api.StatusFailed)
 	})
+	t.Run("parallel", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip test on windows")
+		}
+		client := newMockServerHandler(t)
+		ctx := context.Background()
+		label := t.Name()
+		workDir := fs.NewDir(t, "parallel").Path()
+		// step2 and step3 wait for each other to start, they can only succeed if they run concurrently.
+		waitFor := func(name string) string {
+			return "for i in $(seq 1 50); do test -f " + name + " && break; sleep 0.1; done; test -f " + name
+		}
+		_, err := client.CreatePipeline(ctx, &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{{
+					RunsOn:  &api.RunsOn{Label: label},
+					Name:    "job1",
+					Timeout: int32(time.Minute / time.Second),
+					Steps: []*api.StepDSL{
+						{
+							Name:             "step1",
+							Commands:         []string{"touch step1_done"},
+							WorkingDirectory: workDir,
+						},
+						{
+							Name:             "step2",
+							Commands:         []string{"test -f step1_done", "touch step2_started", waitFor("step3_started"), "touch step2_done"},
+							DependsOn:        []string{"step1"},
+							WorkingDirectory: workDir,
+						},
+						{
+							Name:             "step3",
+							Commands:         []string{"test -f step1_done", "touch step3_started", waitFor("step2_started"), "touch step3_done"},
+							DependsOn:        []string{"step1"},
+							WorkingDirectory: workDir,
+						},
+						{
+							Name:             "step4",
+							Commands:         []string{"test -f step2_done", "test -f step3_done"},
+							DependsOn:        []string{"step2", "step3"},
+							WorkingDirectory: workDir,
+						},
+					},
+				}},
+			},
+		})
+		assert.NilError(t, err)
+		requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{Label: label})
+		assert.NilError(t, err)
+		execution := NewExecution(shell.NewEngine(), requestJobResponse.Job, client)
+		err = execution.Execute(ctx)
+		assert.NilError(t, err)
+		executions, err := client.ListJobExecutions(ctx, &api.ListJobExecutionsRequest{
+			JobID: requestJobResponse.Job.ID,
+		})
+		assert.NilError(t, err)
+		jobExecution := executions.Jobs[0]
+		assert.Equal(t, jobExecution.Status, api.StatusSucceeded)
+		for _, step := range jobExecution.Steps {
+			assert.Equal(t, step.Status, api.StatusSucceeded)
+		}
+	})
+	t.Run("parallel_failed", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip test on windows")
+		}
+		client := newMockServerHandler(t)
+		ctx := context.Background()
+		label := t.Name()
+		_, err := client.CreatePipeline(ctx, &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{{
+					RunsOn:  &api.RunsOn{Label: label},
+					Name:    "job1",
+					Timeout: int32(time.Minute / time.Second),
+					Steps: []*api.StepDSL{
+						{
+							Name:     "step1",
+							Commands: []string{"exit 1"},
+						},
+						{
+							Name:     "step2",
+							Commands: []string{"echo step2"},
+						},
+						{
+							Name:      "step3",
+							Commands:  []string{"echo step3"},
+							DependsOn: []string{"step1"},
+						},
+						{
+							Name:      "step4",
+							Commands:  []string{"echo step4"},
+							DependsOn: []string{"step2"},
+						},
+					},
+				}},
+			},
+		})
+		assert.NilError(t, err)
+		requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{Label: label})
+		assert.NilError(t, err)
+		execution := NewExecution(shell.NewEngine(), requestJobResponse.Job, client)
+		err = execution.Execute(ctx)
+		assert.NilError(t, err)
+		executions, err := client.ListJobExecutions(ctx, &api.ListJobExecutionsRequest{
+			JobID: requestJobResponse.Job.ID,
+		})
+		assert.NilError(t, err)
+		jobExecution := executions.Jobs[0]
+		assert.Equal(t, jobExecution.Status, api.StatusFailed)
+		assert.Equal(t, jobExecution.Steps[0].Status, api.StatusFailed)
+		assert.Equal(t, jobExecution.Steps[1].Status, api.StatusSucceeded)
+		assert.Equal(t, jobExecution.Steps[2].Status, api.StatusSkipped)
+		assert.Equal(t, jobExecution.Steps[3].Status, api.StatusSucceeded)
+	})
+	t.Run("max_step_parallelism", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip test on windows")
+		}
+		client := newMockServerHandler(t)
+		ctx := context.Background()
+		label := t.Name()
+		workDir := fs.NewDir(t, "max_step_parallelism").Path()
+		// Each step fails if another step is running at the same time.
+		exclusive := func(name string) []string {
+			return []string{
+				"test -z \"$(ls | grep running)\"",
+				"touch " + name + "_running",
+				"sleep 0.3",
+				"rm " + name + "_running",
+			}
+		}
+		_, err := client.CreatePipeline(ctx, &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{{
+					RunsOn:             &api.RunsOn{Label: label},
+					Name:               "job1",
+					Timeout:            int32(time.Minute / time.Second),
+					MaxStepParallelism: 1,
+					Steps: []*api.StepDSL{
+						{
+							Name:             "step1",
+							Commands:         exclusive("step1"),
+							WorkingDirectory: workDir,
+						},
+						{
+							Name:             "step2",
+							Commands:         exclusive("step2"),
+							DependsOn:        []string{"step1"},
+							WorkingDirectory: workDir,
+						},
+						{
+							Name:             "step3",
+							Commands:         exclusive("step3"),
+							DependsOn:        []string{"step1"},
+							WorkingDirectory: workDir,
+						},
+						{
+							Name:             "step4",
+							Commands:         exclusive("step4"),
+							DependsOn:        []string{"step1"},
+							WorkingDirectory: workDir,
+						},
+					},
+				}},
+			},
+		})
+		assert.NilError(t, err)
+		requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{Label: label})
+		assert.NilError(t, err)
+		assert.Equal(t, requestJobResponse.Job.MaxStepParallelism, int32(1))
+		execution := NewExecution(shell.NewEngine(), requestJobResponse.Job, client)
+		err = execution.Execute(ctx)
+		assert.NilError(t, err)
+		executions, err := client.ListJobExecutions(ctx, &api.ListJobExecutionsRequest{
+			JobID: requestJobResponse.Job.ID,
+		})
+		assert.NilError(t, err)
+		jobExecution := executions.Jobs[0]
+		assert.Equal(t, jobExecution.Status, api.StatusSucceeded)
+	})
+	t.Run("condition", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip test on windows")
+		}
+		client := newMockServerHandler(t)
+		ctx := context.Background()
+		label := t.Name()
+		_, err := client.CreatePipeline(ctx, &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{{
+					RunsOn:  &api.RunsOn{Label: label},
+					Name:    "job1",
+					Timeout: int32(time.Minute / time.Second),
+					EnvVar:  map[string]string{"BRANCH": "main"},
+					Steps: []*api.StepDSL{
+						{
+							Name:     "build",
+							Commands: []string{"exit 3"},
+						},
+						{
+							Name:     "skipped_by_failure",
+							Commands: []string{"echo should skip"},
+						},
+						{
+							Name:     "notify",
+							Commands: []string{"echo notify"},
+							If:       "failure() && steps.build.exit_code == 3",
+						},
+						{
+							Name:     "cleanup",
+							Commands: []string{"echo cleanup"},
+							If:       "always()",
+						},
+						{
+							Name:     "gated_by_env",
+							Commands: []string{"echo gated"},
+							If:       "always() && env.BRANCH == 'release'",
+						},
+						{
+							Name:     "implied_success",
+							Commands: []string{"echo implied"},
+							If:       "env.BRANCH == 'main'",
+						},
+					},
+				}},
+			},
+		})
+		assert.NilError(t, err)
+		requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{Label: label})
+		assert.NilError(t, err)
+		execution := NewExecution(shell.NewEngine(), requestJobResponse.Job, client)
+		err = execution.Execute(ctx)
+		assert.NilError(t, err)
+		executions, err := client.ListJobExecutions(ctx, &api.ListJobExecutionsRequest{
+			JobID: requestJobResponse.Job.ID,
+		})
+		assert.NilError(t, err)
+		jobExecution := executions.Jobs[0]
+		assert.Equal(t, jobExecution.Status, api.StatusFailed)
+		assert.Equal(t, jobExecution.Steps[0].Status, api.StatusFailed)
+		assert.Equal(t, jobExecution.Steps[1].Status, api.StatusSkipped)
+		assert.Equal(t, jobExecution.Steps[2].Status, api.StatusSucceeded)
+		assert.Equal(t, jobExecution.Steps[3].Status, api.StatusSucceeded)
+		assert.Equal(t, jobExecution.Steps[4].Status, api.StatusSkipped)
+		assert.Equal(t, jobExecution.Steps[5].Status, api.StatusSkipped)
+	})
+	t.Run("retry", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip test on windows")
+		}
+		client := newMockServerHandler(t)
+		ctx := context.Background()
+		label := t.Name()
+		workDir := fs.NewDir(t, "retry").Path()
+		_, err := client.CreatePipeline(ctx, &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{{
+					RunsOn:  &api.RunsOn{Label: label},
+					Name:    "job1",
+					Timeout: int32(time.Minute / time.Second),
+					Steps: []*api.StepDSL{
+						{
+							Name:             "flaky",
+							WorkingDirectory: workDir,
+							// Fails at the first attempt only.
+							Commands: []string{"echo attempt", "test -f marker || { touch marker; exit 3; }"},
+							Retry:    &api.Retry{MaxAttempts: 3, ExitCodes: []uint32{3}},
+						},
+						{
+							Name:     "broken",
+							Commands: []string{"exit 1"},
+							Retry:    &api.Retry{MaxAttempts: 2},
+						},
+						{
+							Name:     "unmatched_exit_code",
+							Commands: []string{"exit 1"},
+							Retry:    &api.Retry{MaxAttempts: 2, ExitCodes: []uint32{3}},
+							If:       "always()",
+						},
+					},
+				}},
+			},
+		})
+		assert.NilError(t, err)
+		requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{Label: label})
+		assert.NilError(t, err)
+		execution := NewExecution(shell.NewEngine(), requestJobResponse.Job, client)
+		err = execution.Execute(ctx)
+		assert.NilError(t, err)
+		executions, err := client.ListJobExecutions(ctx, &api.ListJobExecutionsRequest{
+			JobID: requestJobResponse.Job.ID,
+		})
+		assert.NilError(t, err)
+		jobExecution := executions.Jobs[0]
+		assert.Equal(t, jobExecution.Status, api.StatusFailed)
+		attempts := lo.GroupBy(jobExecution.Steps, func(item *api.StepExecution) int64 {
+			return item.StepID
+		})
+		steps := requestJobResponse.Job.Steps
+		flaky := attempts[steps[0].ID]
+		assert.Equal(t, len(flaky), 2)
+		assert.Equal(t, flaky[0].Status, api.StatusFailed)
+		assert.Equal(t, flaky[0].Attempt, int32(1))
+		assert.Equal(t, flaky[1].Status, api.StatusSucceeded)
+		assert.Equal(t, flaky[1].Attempt, int32(2))
+		broken := attempts[steps[1].ID]
+		assert.Equal(t, len(broken), 2)
+		assert.Equal(t, broken[1].Status, api.StatusFailed)
+		assert.Equal(t, len(attempts[steps[2].ID]), 1)
+		for _, name := range []string{"flaky", "flaky@2"} {
+			getLogLinesResponse, err := client.GetLogLines(ctx, &api.GetLogLinesRequest{
+				JobExecutionID: jobExecution.ID,
+				Name:           name,
+			})
+			assert.NilError(t, err)
+			assert.Assert(t, lo.ContainsBy(getLogLinesResponse.Lines, func(item *api.LogLine) bool {
+				return strings.Contains(item.Output, "attempt")
+			}), name)
+		}
+	})
+	t.Run("job_env_and_working_directory", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip test on windows")
+		}
+		client := newMockServerHandler(t)
+		ctx := context.Background()
+		label := t.Name()
+		workDir := fs.NewDir(t, "job_env", fs.WithDir("sub")).Path()
+		_, err := client.CreatePipeline(ctx, &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{{
+					RunsOn:           &api.RunsOn{Label: label},
+					Name:             "job1",
+					WorkingDirectory: workDir,
+					EnvVar:           map[string]string{"FOO": "job", "BAR": "job"},
+					Steps: []*api.StepDSL{
+						{
+							Name:     "root",
+							Commands: []string{`echo "$FOO $BAR $RUNNER_STEP_NAME" > out`},
+						},
+						{
+							Name:             "sub",
+							WorkingDirectory: "sub",
+							EnvVar:           map[string]string{"BAR": "step"},
+							Commands: []string{
+								`echo "$FOO $BAR $RUNNER_STEP_NAME $RUNNER_WORKSPACE $RUNNER_JOB_ID $RUNNER_JOB_EXECUTION_ID" > out`,
+							},
+						},
+					},
+				}},
+			},
+		})
+		assert.NilError(t, err)
+		requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{Label: label})
+		assert.NilError(t, err)
+		execution := NewExecution(shell.NewEngine(), requestJobResponse.Job, client)
+		err = execution.Execute(ctx)
+		assert.NilError(t, err)
+		out, err := os.ReadFile(filepath.Join(workDir, "out"))
+		assert.NilError(t, err)
+		assert.Equal(t, string(out), "job job root\n")
+		out, err = os.ReadFile(filepath.Join(workDir, "sub", "out"))
+		assert.NilError(t, err)
+		job := requestJobResponse.Job
+		assert.Equal(t, string(out), fmt.Sprintf("job step sub %s %d %d\n", workDir, job.ID, job.Execution.ID))
+	})
+	t.Run("interpolation", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip test on windows")
+		}
+		client := newMockServerHandler(t)
+		ctx := context.Background()
+		label := t.Name()
+		workDir := fs.NewDir(t, "interpolation", fs.WithDir("v1")).Path()
+		_, err := client.CreatePipeline(ctx, &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Parameters: map[string]string{"version": "v1"},
+				Jobs: []*api.JobDSL{{
+					RunsOn:           &api.RunsOn{Label: label},
+					Name:             "job1",
+					WorkingDirectory: workDir,
+					EnvVar:           map[string]string{"VERSION": "${{ parameters.version }}"},
+					Steps: []*api.StepDSL{{
+						Name:             "build",
+						WorkingDirectory: "${{ env.VERSION }}",
+						EnvVar:           map[string]string{"TARGET": "${{ job.name }}-${{ step.name }}"},
+						Commands: []string{
+							`echo "${{ env.VERSION }} ${{ env.TARGET }} ${{ format('{0}', job.id) }}" > out`,
+						},
+					}},
+				}},
+			},
+		})
+		assert.NilError(t, err)
+		requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{Label: label})
+		assert.NilError(t, err)
+		execution := NewExecution(shell.NewEngine(), requestJobResponse.Job, client)
+		err = execution.Execute(ctx)
+		assert.NilError(t, err)
+		out, err := os.ReadFile(filepath.Join(workDir, "v1", "out"))
+		assert.NilError(t, err)
+		assert.Equal(t, string(out), fmt.Sprintf("v1 job1-build %d\n", requestJobResponse.Job.ID))
+	})
+	t.Run("artifacts", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip test on windows")
+		}
+		client := newMockServerHandler(t)
+		ctx := context.Background()
+		label := t.Name()
+		buildDir := fs.NewDir(t, "build").Path()
+		deployDir := fs.NewDir(t, "deploy").Path()
+		createPipelineResponse, err := client.CreatePipeline(ctx, &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{
+					{
+						RunsOn:           &api.RunsOn{Label: label},
+						Name:             "build",
+						WorkingDirectory: buildDir,
+						Timeout:          int32(time.Minute / time.Second),
+						Steps: []*api.StepDSL{
+							{
+								Name:             "compile",
+								WorkingDirectory: buildDir,
+								Commands:         []string{"mkdir -p dist/lib", "echo app > dist/app", "echo lib > dist/lib/lib"},
+								Artifacts:        []*api.ArtifactUpload{{Name: "dist", Paths: []string{"dist"}}},
+							},
+							{
+								Name:             "test",
+								WorkingDirectory: buildDir,
+								Commands:         []string{"echo passed > report.xml"},
+							},
+						},
+						Artifacts: []*api.ArtifactUpload{{Name: "report", Paths: []string{"*.xml"}, RetentionDays: 7}},
+					},
+					{
+						RunsOn:            &api.RunsOn{Label: label},
+						Name:              "deploy",
+						WorkingDirectory:  deployDir,
+						DependsOn:         []string{"build"},
+						Timeout:           int32(time.Minute / time.Second),
+						DownloadArtifacts: []*api.ArtifactDownload{{Job: "build", Name: "dist", Path: "restored"}},
+						Steps: []*api.StepDSL{{
+							Name:             "deploy",
+							WorkingDirectory: deployDir,
+							Commands:         []string{"cat restored/dist/app restored/dist/lib/lib > deployed"},
+						}},
+					},
+				},
+			},
+		})
+		assert.NilError(t, err)
+		for _, name := range []string{"build", "deploy"} {
+			requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{Label: label})
+			assert.NilError(t, err)
+			assert.Equal(t, requestJobResponse.Job.Name, name)
+			execution := NewExecution(shell.NewEngine(), requestJobResponse.Job, client)
+			err = execution.Execute(ctx)
+			assert.NilError(t, err)
+			assert.Equal(t, execution.jobExecution.Status, api.StatusSucceeded)
+		}
+		listArtifactsResponse, err := client.ListArtifacts(ctx, &api.ListArtifactsRequest{
+			PipelineID: createPipelineResponse.Pipeline.ID,
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, lo.Map(listArtifactsResponse.Artifacts, func(item *api.Artifact, _ int) string {
+			return item.Name
+		}), []string{"dist", "report"})
+		assert.Assert(t, listArtifactsResponse.Artifacts[1].ExpireAt != nil)
+		deployed, err := os.ReadFile(filepath.Join(deployDir, "deployed"))
+		assert.NilError(t, err)
+		assert.Equal(t, string(deployed), "app\nlib\n")
+	})
+	t.Run("outputs", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip test on windows")
+		}
+		client := newMockServerHandler(t)
+		ctx := context.Background()
+		label := t.Name()
+		buildDir := fs.NewDir(t, "build").Path()
+		deployDir := fs.NewDir(t, "deploy").Path()
+		_, err := client.CreatePipeline(ctx, &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{
+					{
+						RunsOn:           &api.RunsOn{Label: label},
+						Name:             "build",
+						WorkingDirectory: buildDir,
+						Steps: []*api.StepDSL{
+							{Name: "tag", Commands: []string{`echo "tag=v1" >> "$RUNNER_OUTPUT"`}},
+							{Name: "image", Commands: []string{`echo "image=runner" > "$RUNNER_OUTPUT"`}},
+						},
+					},
+					{
+						RunsOn:           &api.RunsOn{Label: label},
+						Name:             "deploy",
+						WorkingDirectory: deployDir,
+						DependsOn:        []string{"build"},
+						Steps: []*api.StepDSL{{
+							Name:     "deploy",
+							Commands: []string{`echo "$image:$tag ${{ jobs.build.outputs.tag }}" > deployed`},
+						}},
+					},
+				},
+			},
+		})
+		assert.NilError(t, err)
+		for _, name := range []string{"build", "deploy"} {
+			requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{Label: label})
+			assert.NilError(t, err)
+			assert.Equal(t, requestJobResponse.Job.Name, name)
+			execution := NewExecution(shell.NewEngine(), requestJobResponse.Job, client)
+			err = execution.Execute(ctx)
+			assert.NilError(t, err)
+			assert.Equal(t, execution.jobExecution.Status, api.StatusSucceeded)
+			if name == "build" {
+				getJobExecutionResponse, err := client.GetJobExecution(ctx, &api.GetJobExecutionRequest{
+					JobExecutionID: execution.jobExecution.ID,
+				})
+				assert.NilError(t, err)
+				assert.DeepEqual(t, getJobExecutionResponse.JobExecution.Outputs,
+					map[string]string{"tag": "v1", "image": "runner"})
+			}
+		}
+		deployed, err := os.ReadFile(filepath.Join(deployDir, "deployed"))
+		assert.NilError(t, err)
+		assert.Equal(t, string(deployed), "runner:v1 v1\n")
+	})
+	t.Run("cache", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip test on windows")
+		}
+		client := newMockServerHandler(t)
+		ctx := context.Background()
+		label := t.Name()
+		// Each run has its own working directory, the cache is shared by the label.
+		run := func(goSum string) string {
+			dir := fs.NewDir(t, "workspace", fs.WithFile("go.sum", goSum)).Path()
+			_, err := client.CreatePipeline(ctx, &api.CreatePipelineRequest{
+				Pipeline: &api.PipelineDSL{
+					Jobs: []*api.JobDSL{{
+						RunsOn:           &api.RunsOn{Label: label},
+						Name:             "build",
+						WorkingDirectory: dir,
+						Timeout:          int32(time.Minute / time.Second),
+						Cache: &api.CacheDSL{
+							Key:         `deps-${hashFiles("go.sum")}`,
+							RestoreKeys: []string{"deps-"},
+							Paths:       []string{".cache"},
+						},
+						Steps: []*api.StepDSL{{
+							Name:             "build",
+							WorkingDirectory: dir,
+							Commands: []string{
+								"mkdir -p .cache",
+								"test -f .cache/deps || echo " + goSum + " > .cache/deps",
+								"cat .cache/deps > used",
+							},
+						}},
+					}},
+				},
+			})
+			assert.NilError(t, err)
+			requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{Label: label})
+			assert.NilError(t, err)
+			execution := NewExecution(shell.NewEngine(), requestJobResponse.Job, client)
+			assert.NilError(t, execution.Execute(ctx))
+			assert.Equal(t, execution.jobExecution.Status, api.StatusSucceeded)
+			used, err := os.ReadFile(filepath.Join(dir, "used"))
+			assert.NilError(t, err)
+			return string(used)
+		}
+		assert.Equal(t, run("v1"), "v1\n")
+		// Restored from the same key, it's not saved again.
+		assert.Equal(t, run("v1"), "v1\n")
+		// Restored from the restore key, and saved with the new key.
+		assert.Equal(t, run("v2"), "v1\n")
+		listCachesResponse, err := client.ListCaches(ctx, &api.ListCachesRequest{Label: label})
+		assert.NilError(t, err)
+		assert.Equal(t, len(listCachesResponse.Caches), 2)
+		for _, cache := range listCachesResponse.Caches {
+			assert.Assert(t, strings.HasPrefix(cache.Key, "deps-") && len(cache.Key) > len("deps-"), cache.Key)
+		}
+	})
+	t.Run("artifact_not_found", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip test on windows")
+		}
+		client := newMockServerHandler(t)
+		ctx := context.Background()
+		label := t.Name()
+		_, err := client.CreatePipeline(ctx, &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{{
+					RunsOn: &api.RunsOn{Label: label},
+					Name:   "job1",
+					Steps: []*api.StepDSL{{
+						Name:      "step1",
+						Commands:  []string{"echo hello"},
+						Artifacts: []*api.ArtifactUpload{{Name: "dist", Paths: []string{"not_exists"}}},
+					}},
+				}},
+			},
+		})
+		assert.NilError(t, err)
+		requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{Label: label})
+		assert.NilError(t, err)
+		execution := NewExecution(shell.NewEngine(), requestJobResponse.Job, client)
+		err = execution.Execute(ctx)
+		assert.NilError(t, err)
+		stepExecution := execution.stepExecutions[requestJobResponse.Job.Steps[0].ID]
+		assert.Equal(t, stepExecution.Status, api.StatusFailed)
+		assert.Equal(t, stepExecution.Reason.Reason, api.FailedReasonInternalError)
+		assert.Equal(t, execution.jobExecution.Status, api.StatusFailed)
+	})
+	t.Run("multiple_container", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip test on windows")
+		}
+		client := newMockServerHandler(t)
+		ctx := context.Background()
+		label := t.Name()
+		workDir := fs.NewDir(t, "multiple_container").Path()
+		_, err := client.CreatePipeline(ctx, &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{{
+					RunsOn: &api.RunsOn{
+						Label: label,
+						Docker: &api.Docker{
+							Containers:       []*api.Container{{Name: "golang"}, {Name: "node"}},
+							DefaultContainer: "golang",
+						},
+					},
+					Name:    "job1",
+					Timeout: int32(time.Minute / time.Second),
+					Steps: []*api.StepDSL{
+						{
+							Name:             "step1",
+							Commands:         []string{"echo $CONTAINER > step1"},
+							WorkingDirectory: workDir,
+						},
+						{
+							Name:             "step2",
+							Container:        "node",
+							Commands:         []string{"echo $CONTAINER > step2"},
+							WorkingDirectory: workDir,
+						},
+					},
+				}},
+			},
+		})
+		assert.NilError(t, err)
+		requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{Label: label})
+		assert.NilError(t, err)
+		execution := NewExecution(&multipleContainerEngine{}, requestJobResponse.Job, client)
+		err = execution.Execute(ctx)
+		assert.NilError(t, err)
+		executions, err := client.ListJobExecutions(ctx, &api.ListJobExecutionsRequest{
+			JobID: requestJobResponse.Job.ID,
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, executions.Jobs[0].Status, api.StatusSucceeded)
+		step1, err := os.ReadFile(filepath.Join(workDir, "step1"))
+		assert.NilError(t, err)
+		assert.Equal(t, strings.TrimSpace(string(step1)), "golang")
+		step2, err := os.ReadFile(filepath.Join(workDir, "step2"))
+		assert.NilError(t, err)
+		assert.Equal(t, strings.TrimSpace(string(step2)), "node")
+	})
+	t.Run("debug_keep_alive_on_failure", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip test on windows")
+		}
+		client := newMockServerHandler(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		label := t.Name()
+		_, err := client.CreatePipeline(ctx, &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{{
+					RunsOn:             &api.RunsOn{Label: label},
+					Name:               "job1",
+					KeepAliveOnFailure: 60,
+					Steps: []*api.StepDSL{{
+						Name:     "step1",
+						Commands: []string{"exit 1"},
+					}},
+				}},
+			},
+		})
+		assert.NilError(t, err)
+		requestJobResponse, err := client.RequestJob(ctx, &api.RequestJobRequest{Label: label})
+		assert.NilError(t, err)
+		jobExecutionID := requestJobResponse.Job.Execution.ID
+		execution := NewExecution(shell.NewEngine(), requestJobResponse.Job, client)
+		executed := make(chan error, 1)
+		go func() {
+			executed <- execution.Execute(ctx)
+		}()
+		for status := api.StatusCreated; status != api.StatusFailed; time.Sleep(time.Millisecond * 100) {
+			getJobExecutionResponse, err := client.GetJobExecution(ctx, &api.GetJobExecutionRequest{
+				JobExecutionID: jobExecutionID,
+			})
+			assert.NilError(t, err)
+			status = getJobExecutionResponse.JobExecution.Status
+		}
+		stream, err := client.Debug(ctx)
+		assert.NilError(t, err)
+		err = stream.Send(&api.DebugRequest{
+			JobExecutionID: jobExecutionID,
+			Start:          &api.DebugStart{Commands: []string{"sh", "-c", "echo debug; exit 3"}},
+		})
+		assert.NilError(t, err)
+		output := ""
+		for {
+			response, err := stream.Recv()
+			assert.NilError(t, err)
+			output += string(response.Output)
+			if response.Exited {
+				assert.Equal(t, response.ExitCode, int32(3))
+				break
+			}
+		}
+		assert.Equal(t, strings.TrimSpace(output), "debug")
+		cancel()
+		assert.NilError(t, <-executed)
+	})
 }
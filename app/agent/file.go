@@ -0,0 +1,68 @@
+package agent
+
+import (
+	"context"
+	"io"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/app/executor/executorpb"
+)
+
+// getFiles archives files matched by patterns in dir of the runner, and calls send with chunks of the archive.
+// It stops without error if send returns io.EOF, which means the receiver is aborted and the real error should be
+// got from the receiver.
+func getFiles(ctx context.Context, executor executorpb.ExecutorClient, dir string, patterns []string,
+	send func(data []byte) error,
+) error {
+	files, err := executor.GetFiles(ctx, &executorpb.GetFilesRequest{
+		Dir:      dir,
+		Patterns: patterns,
+	})
+	if err != nil {
+		return errors.WithMessage(err, "failed to get files")
+	}
+	for {
+		chunk, err := files.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.WithMessage(err, "failed to get files")
+		}
+		if err = send(chunk.Data); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.WithMessage(err, "failed to send files")
+		}
+	}
+}
+
+// putFiles extracts the archive read by recv into dir of the runner. recv returns io.EOF when the archive is ended.
+func putFiles(ctx context.Context, executor executorpb.ExecutorClient, dir string,
+	recv func() ([]byte, error),
+) error {
+	put, err := executor.PutFiles(ctx)
+	if err != nil {
+		return errors.WithMessage(err, "failed to put files")
+	}
+	// Errors of Send are io.EOF if the stream is aborted, the real error is returned by CloseAndRecv.
+	sendErr := put.Send(&executorpb.PutFilesRequest{Dir: dir})
+	for sendErr == nil {
+		data, err := recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return errors.WithMessage(err, "failed to receive files")
+		}
+		sendErr = put.Send(&executorpb.PutFilesRequest{Data: data})
+	}
+	if _, err = put.CloseAndRecv(); err != nil {
+		return errors.WithMessage(err, "failed to put files")
+	}
+	if sendErr != nil && !errors.Is(sendErr, io.EOF) {
+		return errors.WithMessage(sendErr, "failed to put files")
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package agent
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/samber/lo"
+)
+
+// interpolationVariables returns variables to render `${{ }}` expressions in fields of the job.
+// Parameters have been rendered by the server.
+func (e *Execution) interpolationVariables() map[string]interface{} {
+	return map[string]interface{}{
+		api.InterpolationVarPipeline: map[string]interface{}{"id": e.job.PipelineID},
+		api.InterpolationVarJob: map[string]interface{}{
+			"id":           e.job.ID,
+			"name":         e.job.Name,
+			"execution_id": e.jobExecution.GetID(),
+		},
+		api.InterpolationVarJobs: lo.MapValues(e.jobExecution.GetDependencyOutputs(),
+			func(outputs *api.JobOutputs, _ string) interface{} {
+				return map[string]interface{}{"outputs": lo.Assign(outputs.GetOutputs())}
+			}),
+	}
+}
+
+// dependencyEnv returns outputs of dependencies as environment variables. Outputs of jobs later in `depends_on`
+// override the earlier ones with the same keys.
+func (e *Execution) dependencyEnv() map[string]string {
+	dependencyOutputs := e.jobExecution.GetDependencyOutputs()
+	return lo.Assign(lo.Map(e.job.DependsOn, func(dependency string, _ int) map[string]string {
+		return dependencyOutputs[dependency].GetOutputs()
+	})...)
+}
+
+// interpolateJob renders `${{ }}` expressions in environment variables and the working directory of the job.
+// Environment variables are rendered first, so they can be referred in the working directory. Outputs of
+// dependencies are merged into environment variables of the job, which take precedence.
+func (e *Execution) interpolateJob() error {
+	variables := e.interpolationVariables()
+	env, err := api.InterpolateEnv(e.job.EnvVar, variables)
+	if err != nil {
+		return err
+	}
+	e.job.EnvVar = lo.Assign(e.dependencyEnv(), env)
+	variables[api.InterpolationVarEnv] = lo.Assign(e.job.EnvVar, e.job.SecretEnv)
+	if e.job.WorkingDirectory, err = api.Interpolate(e.job.WorkingDirectory, variables); err != nil {
+		return errors.WithMessage(err, "failed to render working directory")
+	}
+	return nil
+}
+
+// interpolateStep renders `${{ }}` expressions in environment variables, commands and the working directory of
+// the step. Environment variables of the step are rendered first, so they can be referred in other fields.
+func (e *Execution) interpolateStep(step *api.Step) error {
+	variables := e.interpolationVariables()
+	variables[api.InterpolationVarStep] = map[string]interface{}{"name": step.Name}
+	env, err := api.InterpolateEnv(step.EnvVar, variables)
+	if err != nil {
+		return err
+	}
+	step.EnvVar = env
+	variables[api.InterpolationVarEnv] = e.stepEnv(step)
+	for i, command := range step.Commands {
+		if step.Commands[i], err = api.Interpolate(command, variables); err != nil {
+			return errors.WithMessagef(err, "failed to render command #%d", i+1)
+		}
+	}
+	if step.WorkingDirectory, err = api.Interpolate(step.WorkingDirectory, variables); err != nil {
+		return errors.WithMessage(err, "failed to render working directory")
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cockroachdb/errors"
 
 	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/lib"
 	"github.com/cox96de/runner/log"
 )
 
@@ -21,6 +22,8 @@ type logCollector struct {
 	logger  *log.Logger
 	client  api.ServerClient
 	logName string
+	// masker masks sensitive values in lines before they are uploaded.
+	masker *lib.Masker
 	// lineNo is the line number of the log. Line number starts from 0.
 	lineNo int64
 	// start is the time when the logCollector is created.
@@ -41,12 +44,13 @@ type logCollector struct {
 }
 
 func newLogCollector(client api.ServerClient, jobExecution *api.JobExecution, logName string, logger *log.Logger,
-	flushInterval time.Duration,
+	flushInterval time.Duration, masker *lib.Masker,
 ) *logCollector {
 	l := &logCollector{
 		client:        client,
 		jobExecution:  jobExecution,
 		logName:       logName,
+		masker:        masker,
 		logger:        logger,
 		start:         time.Now(),
 		flushInterval: flushInterval,
@@ -59,6 +63,7 @@ func newLogCollector(client api.ServerClient, jobExecution *api.JobExecution, lo
 }
 
 // Write writes p to the log collector.
+// Lines are masked once they are complete, so sensitive values split across writes are masked too.
 // It's thread safe.
 func (l *logCollector) Write(p []byte) (n int, err error) {
 	l.lock.Lock()
@@ -68,7 +73,7 @@ func (l *logCollector) Write(p []byte) (n int, err error) {
 	scanner.Split(getLineScanner(buf))
 	t := time.Since(l.start).Seconds()
 	for scanner.Scan() {
-		line := scanner.Text()
+		line := l.masker.Mask(scanner.Text())
 		l.lineNo++
 		l.logs = append(l.logs, &api.LogLine{
 			Timestamp: int64(t),
@@ -201,7 +206,7 @@ func dropCR(data []byte) []byte {
 }
 
 func (e *Execution) CreateLogWriter(ctx context.Context, logName string) io.WriteCloser {
-	return newLogCollector(e.client, e.jobExecution, logName, log.ExtractLogger(ctx), e.logFlushInternal)
+	return newLogCollector(e.client, e.jobExecution, logName, log.ExtractLogger(ctx), e.logFlushInternal, e.masker)
 }
 
 func (e *Execution) GetDefaultLogWriter(ctx context.Context) io.WriteCloser {
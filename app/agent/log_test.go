@@ -9,6 +9,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/cox96de/runner/api"
 	mockapi "github.com/cox96de/runner/api/mock"
+	"github.com/cox96de/runner/lib"
 	"github.com/cox96de/runner/log"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
@@ -35,7 +36,7 @@ func Test_newLogCollector(t *testing.T) {
 		)
 		mockServerClient := getMockServerClient(t, &logs, &l)
 		flushInterval := time.Millisecond * 10
-		collector := newLogCollector(mockServerClient, &api.JobExecution{}, logName, log.ExtractLogger(context.Background()), flushInterval)
+		collector := newLogCollector(mockServerClient, &api.JobExecution{}, logName, log.ExtractLogger(context.Background()), flushInterval, nil)
 		_, err := collector.Write([]byte("a\nb\n"))
 		assert.NilError(t, err)
 		time.Sleep(flushInterval * 2)
@@ -51,7 +52,7 @@ func Test_newLogCollector(t *testing.T) {
 		)
 		mockServerClient := getMockServerClient(t, &logs, &l)
 		flushInterval := time.Millisecond * 10
-		collector := newLogCollector(mockServerClient, &api.JobExecution{}, logName, log.ExtractLogger(context.Background()), flushInterval)
+		collector := newLogCollector(mockServerClient, &api.JobExecution{}, logName, log.ExtractLogger(context.Background()), flushInterval, nil)
 		_, err := collector.Write([]byte("a\nb\nc"))
 		assert.NilError(t, err)
 		time.Sleep(flushInterval * 3)
@@ -79,7 +80,7 @@ func Test_newLogCollector(t *testing.T) {
 		)
 		mockServerClient := getMockServerClient(t, &logs, &l)
 		flushInterval := time.Millisecond * 10
-		collector := newLogCollector(mockServerClient, &api.JobExecution{}, logName, log.ExtractLogger(context.Background()), flushInterval)
+		collector := newLogCollector(mockServerClient, &api.JobExecution{}, logName, log.ExtractLogger(context.Background()), flushInterval, nil)
 		_, err := collector.Write([]byte("\r\na\nb\rc"))
 		assert.NilError(t, err)
 		time.Sleep(flushInterval * 2)
@@ -99,6 +100,23 @@ func Test_newLogCollector(t *testing.T) {
 		validateLogs(t, logs, []string{"", "a", "b", "cd", "e"})
 		l.Unlock()
 	})
+	t.Run("mask", func(t *testing.T) {
+		logName := t.Name()
+		var (
+			logs []*api.LogLine
+			l    sync.Mutex
+		)
+		mockServerClient := getMockServerClient(t, &logs, &l)
+		collector := newLogCollector(mockServerClient, &api.JobExecution{}, logName,
+			log.ExtractLogger(context.Background()), time.Millisecond*10, lib.NewMasker([]string{"secret"}))
+		_, err := collector.Write([]byte("token: sec"))
+		assert.NilError(t, err)
+		_, err = collector.Write([]byte("ret\nencoded: c2VjcmV0\n"))
+		assert.NilError(t, err)
+		err = collector.Close()
+		assert.NilError(t, err)
+		validateLogs(t, logs, []string{"token: ***", "encoded: ***"})
+	})
 }
 
 func validateLogs(t *testing.T, loglines []*api.LogLine, expected []string) {
@@ -118,7 +136,7 @@ func validateLogs(t *testing.T, loglines []*api.LogLine, expected []string) {
 
 func Test_logCollector_Close(t *testing.T) {
 	t.Run("multiple_close", func(t *testing.T) {
-		collector := newLogCollector(nil, &api.JobExecution{}, "", nil, 0)
+		collector := newLogCollector(nil, &api.JobExecution{}, "", nil, 0, nil)
 		err := collector.Close()
 		assert.NilError(t, err)
 		err = collector.Close()
@@ -127,7 +145,7 @@ func Test_logCollector_Close(t *testing.T) {
 	t.Run("retry_to_flush", func(t *testing.T) {
 		mockServerClient := mockapi.NewMockServerClient(gomock.NewController(t))
 		mockServerClient.EXPECT().UploadLogLines(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("something error")).AnyTimes()
-		collector := newLogCollector(mockServerClient, &api.JobExecution{}, t.Name(), log.ExtractLogger(context.Background()), 0)
+		collector := newLogCollector(mockServerClient, &api.JobExecution{}, t.Name(), log.ExtractLogger(context.Background()), 0, nil)
 		_, err := collector.Write([]byte("abcd"))
 		assert.NilError(t, err)
 		err = collector.Close()
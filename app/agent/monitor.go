@@ -51,6 +51,9 @@ func (e *Execution) monitorHeartbeat(ctx context.Context, internal time.Duration
 				logger.Info("execution aborted by server")
 				e.jobCanceller()
 			}
+			for _, sessionID := range heartbeatResponse.DebugSessions {
+				go e.serveDebug(ctx, sessionID)
+			}
 		case <-ctx.Done():
 			return
 		}
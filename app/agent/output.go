@@ -0,0 +1,99 @@
+package agent
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/app/executor/executorpb"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stepOutputFileName returns the name of the file referred by `RUNNER_OUTPUT`, it's in the working directory of
+// the job. Each attempt of a step has its own file, so outputs of failed attempts are discarded.
+func (e *Execution) stepOutputFileName(step *api.Step) string {
+	stepExecution := e.stepExecutions[step.ID]
+	return fmt.Sprintf(".runner_output_%d_%d", stepExecution.GetID(), max(stepExecution.GetAttempt(), 1))
+}
+
+// reportStepOutputs reads outputs written by the step and reports them to the server. It returns the keys of
+// reported outputs.
+func (e *Execution) reportStepOutputs(ctx context.Context, executor executorpb.ExecutorClient, step *api.Step,
+) ([]string, error) {
+	outputs, err := e.readStepOutputs(ctx, executor, step)
+	if err != nil {
+		return nil, err
+	}
+	if len(outputs) == 0 {
+		return nil, nil
+	}
+	err = backoff.Retry(func() error {
+		_, err := e.client.UpdateJobExecution(ctx, &api.UpdateJobExecutionRequest{
+			JobExecutionID: e.jobExecution.ID,
+			Outputs:        outputs,
+		})
+		return err
+	}, backoff.WithMaxRetries(backoff.NewExponentialBackOff(), 3))
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to report outputs")
+	}
+	return lo.Keys(outputs), nil
+}
+
+// readStepOutputs reads the file referred by `RUNNER_OUTPUT` of the step from the runner. It returns nil if the
+// file is not written.
+func (e *Execution) readStepOutputs(ctx context.Context, executor executorpb.ExecutorClient, step *api.Step,
+) (map[string]string, error) {
+	name := e.stepOutputFileName(step)
+	_, err := executor.Stat(ctx, &executorpb.StatRequest{Path: joinPath(e.job.WorkingDirectory, name)})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, errors.WithMessage(err, "failed to stat output file")
+	}
+	archive := &bytes.Buffer{}
+	err = getFiles(ctx, executor, e.job.WorkingDirectory, []string{name}, func(data []byte) error {
+		_, err := archive.Write(data)
+		return err
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to read output file")
+	}
+	reader := tar.NewReader(archive)
+	if _, err = reader.Next(); err != nil {
+		return nil, errors.WithMessage(err, "failed to read output file")
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to read output file")
+	}
+	return parseOutputs(string(content))
+}
+
+// parseOutputs parses outputs in `key=value` format, one per line. Empty lines are ignored, the last one wins if
+// a key is written multiple times.
+func parseOutputs(content string) (map[string]string, error) {
+	outputs := map[string]string{}
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, errors.Errorf("invalid output at line %d, it should be in 'key=value' format", i+1)
+		}
+		outputs[key] = value
+	}
+	return outputs, nil
+}
@@ -0,0 +1,17 @@
+package agent
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParseOutputs(t *testing.T) {
+	outputs, err := parseOutputs("image=runner:v1\r\n\nversion=1\nversion=2\nargs=a=b\n")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, outputs, map[string]string{"image": "runner:v1", "version": "2", "args": "a=b"})
+	_, err = parseOutputs("image=runner:v1\nversion\n")
+	assert.ErrorContains(t, err, "invalid output at line 2")
+	_, err = parseOutputs("=1")
+	assert.ErrorContains(t, err, "invalid output at line 1")
+}
@@ -2,7 +2,11 @@ package agent
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -19,7 +23,9 @@ import (
 	"github.com/samber/lo"
 )
 
-func (e *Execution) updateStepExecution(ctx context.Context, step *api.Step, status *api.Status, exitCode *uint32) error {
+func (e *Execution) updateStepExecution(ctx context.Context, step *api.Step, status *api.Status, exitCode *uint32,
+	reason *api.Reason,
+) error {
 	stepExecution, ok := e.stepExecutions[step.ID]
 	if !ok {
 		return errors.Errorf("step execution not found: %d", step.ID)
@@ -29,6 +35,7 @@ func (e *Execution) updateStepExecution(ctx context.Context, step *api.Step, sta
 			StepExecutionID: stepExecution.ID,
 			Status:          status,
 			ExitCode:        exitCode,
+			Reason:          reason,
 		})
 		return err
 	}, backoff.WithMaxRetries(backoff.NewExponentialBackOff(), 3))
@@ -41,45 +48,113 @@ func (e *Execution) updateStepExecution(ctx context.Context, step *api.Step, sta
 	if exitCode != nil {
 		stepExecution.ExitCode = *exitCode
 	}
+	if reason != nil {
+		stepExecution.Reason = reason
+	}
 	return nil
 }
 
 func (e *Execution) executeStep(ctx context.Context, step *api.Step) (err error) {
 	logger := log.ExtractLogger(ctx).WithField("step", step.Name)
-	collector := newLogCollector(e.client, e.jobExecution, step.Name, logger, e.logFlushInternal)
+	collector := newLogCollector(e.client, e.jobExecution,
+		api.StepLogName(step.Name, e.stepExecutions[step.ID].GetAttempt()), logger, e.logFlushInternal, e.masker)
 	defer func() {
-		if err == nil {
-			return
-		}
-		if _, writeErr := collector.Write([]byte("$$ Internal Error: " + err.Error())); writeErr != nil {
-			logger.Errorf("failed to write error log: %v", writeErr)
+		if err != nil {
+			if _, writeErr := collector.Write([]byte("$$ Internal Error: " + err.Error())); writeErr != nil {
+				logger.Errorf("failed to write error log: %v", writeErr)
+			}
 		}
 		if closeErr := collector.Close(); closeErr != nil {
 			logger.Errorf("failed to close log collector: %v", closeErr)
 		}
-		if updateStepError := e.updateStepExecution(ctx, step, lo.ToPtr(api.StatusFailed), lo.ToPtr(uint32(1))); updateStepError != nil {
+		if err == nil {
+			return
+		}
+		if updateStepError := e.updateStepExecution(ctx, step, lo.ToPtr(api.StatusFailed), lo.ToPtr(uint32(1)), nil); updateStepError != nil {
 			err = multierror.Append(err, errors.WithMessage(updateStepError, "failed to update step jobExecution"))
 		}
 	}()
 	continueExecute, err := e.preStep(step)
 	if err != nil {
-		// TODO: update to failed.
-		return errors.WithMessage(err, "failed to pre step")
+		// The condition of step can't be evaluated, fail the step instead of the whole job.
+		logger.Warnf("failed to pre step: %v", err)
+		if _, err := collector.Write([]byte("failed to evaluate step condition: " + err.Error() + "\n")); err != nil {
+			logger.Errorf("failed to write log: %v", err)
+		}
+		if err := e.updateStepExecution(ctx, step, lo.ToPtr(api.StatusFailed), nil, nil); err != nil {
+			return errors.WithMessage(err, "failed to update step jobExecution")
+		}
+		return nil
 	}
 	if !continueExecute {
 		logger.Infof("skip step")
-		if err := e.updateStepExecution(ctx, step, lo.ToPtr(api.StatusSkipped), nil); err != nil {
+		if err := e.updateStepExecution(ctx, step, lo.ToPtr(api.StatusSkipped), nil, nil); err != nil {
+			return errors.WithMessage(err, "failed to update step jobExecution")
+		}
+		return nil
+	}
+	if err := e.interpolateStep(step); err != nil {
+		logger.Warnf("failed to interpolate step: %v", err)
+		if _, err := collector.Write([]byte("failed to interpolate step: " + err.Error() + "\n")); err != nil {
+			logger.Errorf("failed to write log: %v", err)
+		}
+		if err := e.updateStepExecution(ctx, step, lo.ToPtr(api.StatusFailed), nil, nil); err != nil {
 			return errors.WithMessage(err, "failed to update step jobExecution")
 		}
 		return nil
 	}
+	for {
+		stepStatus, exitCode, stepReason, err := e.runStep(ctx, logger, step, collector)
+		if err != nil {
+			return err
+		}
+		if stepReason != nil {
+			logger.Infof("step is failed: %s", stepReason.Message)
+			if _, err := collector.Write([]byte("\n" + stepReason.Message + "\n")); err != nil {
+				logger.Errorf("failed to write log: %v", err)
+			}
+		}
+		if err = e.updateStepExecution(ctx, step, &stepStatus, lo.ToPtr(uint32(exitCode)), stepReason); err != nil {
+			return errors.WithMessage(err, "failed to update step jobExecution")
+		}
+		logger.Infof("command is completed, exit code: %+v", exitCode)
+		attempt := max(e.stepExecutions[step.ID].Attempt, 1)
+		retry, delay := e.shouldRetry(step, stepStatus, uint32(exitCode), attempt)
+		if !retry {
+			return nil
+		}
+		logger.Infof("step is failed, retry in %s", delay)
+		if _, err := collector.Write([]byte(fmt.Sprintf("step is failed, retry in %s (attempt %d/%d)\n", delay,
+			attempt+1, step.Retry.MaxAttempts))); err != nil {
+			logger.Errorf("failed to write log: %v", err)
+		}
+		if util.Wait(e.jobCtx, delay) != nil {
+			logger.Infof("job is aborted, stop retrying")
+			return nil
+		}
+		if err = e.retryStepExecution(ctx, step); err != nil {
+			return errors.WithMessage(err, "failed to retry step execution")
+		}
+		if closeErr := collector.Close(); closeErr != nil {
+			logger.Errorf("failed to close log collector: %v", closeErr)
+		}
+		// Each attempt has its own log.
+		collector = newLogCollector(e.client, e.jobExecution, api.StepLogName(step.Name, attempt+1), logger,
+			e.logFlushInternal, e.masker)
+	}
+}
+
+// runStep runs the commands of the step in the executor and waits for it to complete.
+// It returns the status, exit code and failed reason of the step, the step execution is not updated to completed.
+func (e *Execution) runStep(ctx context.Context, logger *log.Logger, step *api.Step, collector *logCollector,
+) (stepStatus api.Status, exitCode int32, stepReason *api.Reason, err error) {
 	executor, err := e.getExecutor(ctx, e.runner, step)
 	if err != nil {
-		return errors.WithMessage(err, "failed to get executor")
+		return 0, 0, nil, errors.WithMessage(err, "failed to get executor")
 	}
 	getRuntimeInfoResp, err := executor.GetRuntimeInfo(ctx, &executorpb.GetRuntimeInfoRequest{})
 	if err != nil {
-		return errors.WithMessage(err, "failed to get runtime info")
+		return 0, 0, nil, errors.WithMessage(err, "failed to get runtime info")
 	}
 	var (
 		commands []string
@@ -94,17 +169,17 @@ func (e *Execution) executeStep(ctx context.Context, step *api.Step) (err error)
 			commands = getUnixCommands()
 			script = compileUnixScript(step.Commands)
 		default:
-			return errors.Errorf("unsupported os: '%s'", getRuntimeInfoResp.OS)
+			return 0, 0, nil, errors.Errorf("unsupported os: '%s'", getRuntimeInfoResp.OS)
 		}
 	}
 
 	environment, err := executor.Environment(ctx, &executorpb.EnvironmentRequest{})
 	if err != nil {
-		return errors.WithMessage(err, "failed to get environment")
+		return 0, 0, nil, errors.WithMessage(err, "failed to get environment")
 	}
-	err = e.updateStepExecution(ctx, step, lo.ToPtr(api.StatusRunning), nil)
+	err = e.updateStepExecution(ctx, step, lo.ToPtr(api.StatusRunning), nil, nil)
 	if err != nil {
-		return errors.WithMessage(err, "failed to update step jobExecution")
+		return 0, 0, nil, errors.WithMessage(err, "failed to update step jobExecution")
 	}
 	stepDone := make(chan interface{})
 	defer close(stepDone)
@@ -119,8 +194,7 @@ func (e *Execution) executeStep(ctx context.Context, step *api.Step) (err error)
 				return
 			case <-time.After(time.Second * 5):
 				// Use it as ping.
-				getRuntimeInfoResp, err = executor.GetRuntimeInfo(ctx, &executorpb.GetRuntimeInfoRequest{})
-				if err != nil {
+				if _, err := executor.GetRuntimeInfo(ctx, &executorpb.GetRuntimeInfoRequest{}); err != nil {
 					logger.Errorf("failed to get runtime info: %v", err)
 					count++
 					if count > 3 {
@@ -134,25 +208,26 @@ func (e *Execution) executeStep(ctx context.Context, step *api.Step) (err error)
 			}
 		}
 	}()
-	stepEnv := lo.MapToSlice(step.EnvVar, func(key string, value string) string {
-		return key + "=" + value
-	})
+	workingDirectory := e.getStepWorkingDirectory(step)
 	startCommandResponse, err := executor.StartCommand(ctx, &executorpb.StartCommandRequest{
 		Commands: commands,
 		Script:   step.Script,
-		Dir:      step.WorkingDirectory,
-		Env:      append(append(environment.Environment, stepEnv...), "RUNNER_SCRIPT="+script),
+		Dir:      workingDirectory,
+		Env:      append(append(environment.Environment, e.getStepEnv(step)...), "RUNNER_SCRIPT="+script),
 		Username: step.User,
+		Timeout:  int64(time.Duration(step.Timeout) * time.Second),
+		// Use the same grace period for step timeout.
+		KillGracePeriod: int64(e.killGracePeriod),
 	})
 	if err != nil {
-		return errors.WithMessage(err, "failed to start command")
+		return 0, 0, nil, errors.WithMessage(err, "failed to start command")
 	}
 	logger.Infof("success to start command with pid: %d", startCommandResponse.Status.Pid)
 	getCommandLogResp, err := executor.GetCommandLog(ctx, &executorpb.GetCommandLogRequest{
 		CommandID: startCommandResponse.CommandID,
 	})
 	if err != nil {
-		return errors.WithMessage(err, "failed to get command log")
+		return 0, 0, nil, errors.WithMessage(err, "failed to get command log")
 	}
 	logCh := make(chan struct{})
 	go func() {
@@ -181,28 +256,32 @@ func (e *Execution) executeStep(ctx context.Context, step *api.Step) (err error)
 			}
 		}
 	}()
-	var (
-		stepStatus = api.StatusSucceeded
-		exitCode   int32
-	)
+	stepStatus = api.StatusSucceeded
 	for {
 		commandResponse, err := executor.WaitCommand(e.jobCtx, &executorpb.WaitCommandRequest{
 			CommandID: startCommandResponse.CommandID,
-			Timeout:   int64(time.Hour), // TODO: change it, it should refer to step timeout.
+			// The step timeout is enforced by the executor, it's just the polling interval.
+			Timeout: int64(time.Hour),
 		})
 		if err != nil {
 			statusError, ok := status.FromError(err)
 			if !ok {
 				// TODO: auto retry or fail the job.
-				return errors.WithMessage(err, "failed to wait command")
+				return 0, 0, nil, errors.WithMessage(err, "failed to wait command")
 			}
 			if statusError.Code() == codes.Canceled {
-				// Aborted by context.
-				logger.Infof("context is canceled, stop waiting command")
+				// Aborted by context, stop the command gracefully.
+				logger.Infof("context is canceled, kill command with grace period %s", e.killGracePeriod)
+				if _, err := executor.KillCommand(ctx, &executorpb.KillCommandRequest{
+					CommandID:   startCommandResponse.CommandID,
+					GracePeriod: int64(e.killGracePeriod),
+				}); err != nil {
+					logger.Errorf("failed to kill command: %v", err)
+				}
 				stepStatus = api.StatusFailed
 				break
 			}
-			return errors.WithMessage(err, "failed to wait command")
+			return 0, 0, nil, errors.WithMessage(err, "failed to wait command")
 		}
 		processStatus := commandResponse.Status
 		if commandResponse.Status.Exit {
@@ -210,6 +289,13 @@ func (e *Execution) executeStep(ctx context.Context, step *api.Step) (err error)
 				stepStatus = api.StatusFailed
 				exitCode = processStatus.ExitCode
 			}
+			if processStatus.TimedOut {
+				stepStatus = api.StatusFailed
+				stepReason = &api.Reason{
+					Reason:  api.FailedReasonTimeout,
+					Message: fmt.Sprintf("step is timed out after %d seconds", step.Timeout),
+				}
+			}
 			break
 		}
 	}
@@ -220,10 +306,109 @@ func (e *Execution) executeStep(ctx context.Context, step *api.Step) (err error)
 	case <-time.After(time.Second * 5):
 		logger.Warnf("log collector is not closed in time")
 	}
-	if err = e.updateStepExecution(ctx, step, &stepStatus, lo.ToPtr(uint32(exitCode))); err != nil {
-		return errors.WithMessage(err, "failed to update step jobExecution")
+	if stepStatus == api.StatusSucceeded && len(step.Artifacts) > 0 {
+		artifacts, err := e.uploadArtifacts(ctx, executor, workingDirectory, step.Artifacts)
+		if err != nil {
+			logger.Errorf("failed to upload artifacts: %v", err)
+			return api.StatusFailed, exitCode, &api.Reason{
+				Reason:  api.FailedReasonInternalError,
+				Message: err.Error(),
+			}, nil
+		}
+		for _, artifact := range artifacts {
+			if _, err := collector.Write([]byte(fmt.Sprintf("\nartifact '%s' is uploaded, size: %d bytes\n",
+				artifact.Name, artifact.Size))); err != nil {
+				logger.Errorf("failed to write log: %v", err)
+			}
+		}
 	}
-	logger.Infof("command is completed, exit code: %+v", exitCode)
+	if stepStatus == api.StatusSucceeded {
+		keys, err := e.reportStepOutputs(ctx, executor, step)
+		if err != nil {
+			logger.Errorf("failed to report outputs: %v", err)
+			return api.StatusFailed, exitCode, &api.Reason{
+				Reason:  api.FailedReasonInternalError,
+				Message: err.Error(),
+			}, nil
+		}
+		if len(keys) > 0 {
+			sort.Strings(keys)
+			if _, err := collector.Write([]byte(fmt.Sprintf("\noutputs are reported: %s\n",
+				strings.Join(keys, ", ")))); err != nil {
+				logger.Errorf("failed to write log: %v", err)
+			}
+		}
+	}
+	return stepStatus, exitCode, stepReason, nil
+}
+
+// getStepWorkingDirectory returns the working directory of the step. A relative working directory of the step is
+// resolved against the working directory of the job.
+func (e *Execution) getStepWorkingDirectory(step *api.Step) string {
+	return joinPath(e.job.WorkingDirectory, step.WorkingDirectory)
+}
+
+// stepEnv returns environment variables of the step.
+// Environment variables and secrets of the job are overridden by environment variables of the step, built-in
+// variables override all of them.
+func (e *Execution) stepEnv(step *api.Step) map[string]string {
+	return lo.Assign(e.job.EnvVar, e.job.SecretEnv, step.EnvVar, map[string]string{
+		"RUNNER_JOB_ID":           strconv.FormatInt(e.job.ID, 10),
+		"RUNNER_JOB_EXECUTION_ID": strconv.FormatInt(e.jobExecution.GetID(), 10),
+		"RUNNER_STEP_NAME":        step.Name,
+		"RUNNER_WORKSPACE":        e.job.WorkingDirectory,
+		"RUNNER_OUTPUT":           joinPath(e.job.WorkingDirectory, e.stepOutputFileName(step)),
+	})
+}
+
+// getStepEnv returns environment variables of the step in `key=value` format, sorted by keys.
+func (e *Execution) getStepEnv(step *api.Step) []string {
+	env := e.stepEnv(step)
+	keys := lo.Keys(env)
+	sort.Strings(keys)
+	return lo.Map(keys, func(key string, _ int) string {
+		return key + "=" + env[key]
+	})
+}
+
+// shouldRetry reports whether the failed step should be retried, and the delay before the next attempt.
+func (e *Execution) shouldRetry(step *api.Step, stepStatus api.Status, exitCode uint32, attempt int32) (bool, time.Duration) {
+	retry := step.Retry
+	if stepStatus != api.StatusFailed || retry == nil || attempt >= retry.MaxAttempts {
+		return false, 0
+	}
+	if abortedReason(e.abortedReason.Load()) != None || e.jobCtx.Err() != nil {
+		return false, 0
+	}
+	if len(retry.ExitCodes) > 0 && !lo.Contains(retry.ExitCodes, exitCode) {
+		return false, 0
+	}
+	return true, time.Duration(retry.Backoff) * time.Second << (attempt - 1)
+}
+
+// retryStepExecution creates a new step execution for the next attempt of the step.
+func (e *Execution) retryStepExecution(ctx context.Context, step *api.Step) error {
+	stepExecution, ok := e.stepExecutions[step.ID]
+	if !ok {
+		return errors.Errorf("step execution not found: %d", step.ID)
+	}
+	var resp *api.RetryStepExecutionResponse
+	err := backoff.Retry(func() error {
+		var err error
+		resp, err = e.client.RetryStepExecution(ctx, &api.RetryStepExecutionRequest{
+			StepExecutionID: stepExecution.ID,
+		})
+		return err
+	}, backoff.WithMaxRetries(backoff.NewExponentialBackOff(), 3))
+	if err != nil {
+		return err
+	}
+	// Keep the pointer, it's shared with the job execution.
+	stepExecution.ID = resp.StepExecution.ID
+	stepExecution.Attempt = resp.StepExecution.Attempt
+	stepExecution.Status = resp.StepExecution.Status
+	stepExecution.ExitCode = resp.StepExecution.ExitCode
+	stepExecution.Reason = resp.StepExecution.Reason
 	return nil
 }
 
@@ -244,5 +429,8 @@ func (e *Execution) preStep(step *api.Step) (bool, error) {
 	if abortedReason(e.abortedReason.Load()) != None {
 		return false, nil
 	}
+	if step.If != "" {
+		return e.evaluateCondition(step)
+	}
 	return e.continueWhenNoPreFailed(step)
 }
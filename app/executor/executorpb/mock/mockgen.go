@@ -86,6 +86,50 @@ func (c *MockExecutorClientEnvironmentCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// Exec mocks base method.
+func (m *MockExecutorClient) Exec(ctx context.Context, opts ...grpc.CallOption) (executorpb.Executor_ExecClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Exec", varargs...)
+	ret0, _ := ret[0].(executorpb.Executor_ExecClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exec indicates an expected call of Exec.
+func (mr *MockExecutorClientMockRecorder) Exec(ctx any, opts ...any) *MockExecutorClientExecCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockExecutorClient)(nil).Exec), varargs...)
+	return &MockExecutorClientExecCall{Call: call}
+}
+
+// MockExecutorClientExecCall wrap *gomock.Call
+type MockExecutorClientExecCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExecutorClientExecCall) Return(arg0 executorpb.Executor_ExecClient, arg1 error) *MockExecutorClientExecCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExecutorClientExecCall) Do(f func(context.Context, ...grpc.CallOption) (executorpb.Executor_ExecClient, error)) *MockExecutorClientExecCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExecutorClientExecCall) DoAndReturn(f func(context.Context, ...grpc.CallOption) (executorpb.Executor_ExecClient, error)) *MockExecutorClientExecCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetCommandLog mocks base method.
 func (m *MockExecutorClient) GetCommandLog(ctx context.Context, in *executorpb.GetCommandLogRequest, opts ...grpc.CallOption) (executorpb.Executor_GetCommandLogClient, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +174,50 @@ func (c *MockExecutorClientGetCommandLogCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// GetFiles mocks base method.
+func (m *MockExecutorClient) GetFiles(ctx context.Context, in *executorpb.GetFilesRequest, opts ...grpc.CallOption) (executorpb.Executor_GetFilesClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFiles", varargs...)
+	ret0, _ := ret[0].(executorpb.Executor_GetFilesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFiles indicates an expected call of GetFiles.
+func (mr *MockExecutorClientMockRecorder) GetFiles(ctx, in any, opts ...any) *MockExecutorClientGetFilesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFiles", reflect.TypeOf((*MockExecutorClient)(nil).GetFiles), varargs...)
+	return &MockExecutorClientGetFilesCall{Call: call}
+}
+
+// MockExecutorClientGetFilesCall wrap *gomock.Call
+type MockExecutorClientGetFilesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExecutorClientGetFilesCall) Return(arg0 executorpb.Executor_GetFilesClient, arg1 error) *MockExecutorClientGetFilesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExecutorClientGetFilesCall) Do(f func(context.Context, *executorpb.GetFilesRequest, ...grpc.CallOption) (executorpb.Executor_GetFilesClient, error)) *MockExecutorClientGetFilesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExecutorClientGetFilesCall) DoAndReturn(f func(context.Context, *executorpb.GetFilesRequest, ...grpc.CallOption) (executorpb.Executor_GetFilesClient, error)) *MockExecutorClientGetFilesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetRuntimeInfo mocks base method.
 func (m *MockExecutorClient) GetRuntimeInfo(ctx context.Context, in *executorpb.GetRuntimeInfoRequest, opts ...grpc.CallOption) (*executorpb.GetRuntimeInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -174,6 +262,138 @@ func (c *MockExecutorClientGetRuntimeInfoCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// HashFiles mocks base method.
+func (m *MockExecutorClient) HashFiles(ctx context.Context, in *executorpb.HashFilesRequest, opts ...grpc.CallOption) (*executorpb.HashFilesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HashFiles", varargs...)
+	ret0, _ := ret[0].(*executorpb.HashFilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HashFiles indicates an expected call of HashFiles.
+func (mr *MockExecutorClientMockRecorder) HashFiles(ctx, in any, opts ...any) *MockExecutorClientHashFilesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashFiles", reflect.TypeOf((*MockExecutorClient)(nil).HashFiles), varargs...)
+	return &MockExecutorClientHashFilesCall{Call: call}
+}
+
+// MockExecutorClientHashFilesCall wrap *gomock.Call
+type MockExecutorClientHashFilesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExecutorClientHashFilesCall) Return(arg0 *executorpb.HashFilesResponse, arg1 error) *MockExecutorClientHashFilesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExecutorClientHashFilesCall) Do(f func(context.Context, *executorpb.HashFilesRequest, ...grpc.CallOption) (*executorpb.HashFilesResponse, error)) *MockExecutorClientHashFilesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExecutorClientHashFilesCall) DoAndReturn(f func(context.Context, *executorpb.HashFilesRequest, ...grpc.CallOption) (*executorpb.HashFilesResponse, error)) *MockExecutorClientHashFilesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// KillCommand mocks base method.
+func (m *MockExecutorClient) KillCommand(ctx context.Context, in *executorpb.KillCommandRequest, opts ...grpc.CallOption) (*executorpb.KillCommandResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "KillCommand", varargs...)
+	ret0, _ := ret[0].(*executorpb.KillCommandResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// KillCommand indicates an expected call of KillCommand.
+func (mr *MockExecutorClientMockRecorder) KillCommand(ctx, in any, opts ...any) *MockExecutorClientKillCommandCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KillCommand", reflect.TypeOf((*MockExecutorClient)(nil).KillCommand), varargs...)
+	return &MockExecutorClientKillCommandCall{Call: call}
+}
+
+// MockExecutorClientKillCommandCall wrap *gomock.Call
+type MockExecutorClientKillCommandCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExecutorClientKillCommandCall) Return(arg0 *executorpb.KillCommandResponse, arg1 error) *MockExecutorClientKillCommandCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExecutorClientKillCommandCall) Do(f func(context.Context, *executorpb.KillCommandRequest, ...grpc.CallOption) (*executorpb.KillCommandResponse, error)) *MockExecutorClientKillCommandCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExecutorClientKillCommandCall) DoAndReturn(f func(context.Context, *executorpb.KillCommandRequest, ...grpc.CallOption) (*executorpb.KillCommandResponse, error)) *MockExecutorClientKillCommandCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListDir mocks base method.
+func (m *MockExecutorClient) ListDir(ctx context.Context, in *executorpb.ListDirRequest, opts ...grpc.CallOption) (*executorpb.ListDirResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDir", varargs...)
+	ret0, _ := ret[0].(*executorpb.ListDirResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDir indicates an expected call of ListDir.
+func (mr *MockExecutorClientMockRecorder) ListDir(ctx, in any, opts ...any) *MockExecutorClientListDirCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDir", reflect.TypeOf((*MockExecutorClient)(nil).ListDir), varargs...)
+	return &MockExecutorClientListDirCall{Call: call}
+}
+
+// MockExecutorClientListDirCall wrap *gomock.Call
+type MockExecutorClientListDirCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExecutorClientListDirCall) Return(arg0 *executorpb.ListDirResponse, arg1 error) *MockExecutorClientListDirCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExecutorClientListDirCall) Do(f func(context.Context, *executorpb.ListDirRequest, ...grpc.CallOption) (*executorpb.ListDirResponse, error)) *MockExecutorClientListDirCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExecutorClientListDirCall) DoAndReturn(f func(context.Context, *executorpb.ListDirRequest, ...grpc.CallOption) (*executorpb.ListDirResponse, error)) *MockExecutorClientListDirCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Ping mocks base method.
 func (m *MockExecutorClient) Ping(ctx context.Context, in *executorpb.PingRequest, opts ...grpc.CallOption) (*executorpb.PingResponse, error) {
 	m.ctrl.T.Helper()
@@ -218,6 +438,94 @@ func (c *MockExecutorClientPingCall) DoAndReturn(f func(context.Context, *execut
 	return c
 }
 
+// PutFiles mocks base method.
+func (m *MockExecutorClient) PutFiles(ctx context.Context, opts ...grpc.CallOption) (executorpb.Executor_PutFilesClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutFiles", varargs...)
+	ret0, _ := ret[0].(executorpb.Executor_PutFilesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutFiles indicates an expected call of PutFiles.
+func (mr *MockExecutorClientMockRecorder) PutFiles(ctx any, opts ...any) *MockExecutorClientPutFilesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutFiles", reflect.TypeOf((*MockExecutorClient)(nil).PutFiles), varargs...)
+	return &MockExecutorClientPutFilesCall{Call: call}
+}
+
+// MockExecutorClientPutFilesCall wrap *gomock.Call
+type MockExecutorClientPutFilesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExecutorClientPutFilesCall) Return(arg0 executorpb.Executor_PutFilesClient, arg1 error) *MockExecutorClientPutFilesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExecutorClientPutFilesCall) Do(f func(context.Context, ...grpc.CallOption) (executorpb.Executor_PutFilesClient, error)) *MockExecutorClientPutFilesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExecutorClientPutFilesCall) DoAndReturn(f func(context.Context, ...grpc.CallOption) (executorpb.Executor_PutFilesClient, error)) *MockExecutorClientPutFilesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SignalCommand mocks base method.
+func (m *MockExecutorClient) SignalCommand(ctx context.Context, in *executorpb.SignalCommandRequest, opts ...grpc.CallOption) (*executorpb.SignalCommandResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SignalCommand", varargs...)
+	ret0, _ := ret[0].(*executorpb.SignalCommandResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignalCommand indicates an expected call of SignalCommand.
+func (mr *MockExecutorClientMockRecorder) SignalCommand(ctx, in any, opts ...any) *MockExecutorClientSignalCommandCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalCommand", reflect.TypeOf((*MockExecutorClient)(nil).SignalCommand), varargs...)
+	return &MockExecutorClientSignalCommandCall{Call: call}
+}
+
+// MockExecutorClientSignalCommandCall wrap *gomock.Call
+type MockExecutorClientSignalCommandCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExecutorClientSignalCommandCall) Return(arg0 *executorpb.SignalCommandResponse, arg1 error) *MockExecutorClientSignalCommandCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExecutorClientSignalCommandCall) Do(f func(context.Context, *executorpb.SignalCommandRequest, ...grpc.CallOption) (*executorpb.SignalCommandResponse, error)) *MockExecutorClientSignalCommandCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExecutorClientSignalCommandCall) DoAndReturn(f func(context.Context, *executorpb.SignalCommandRequest, ...grpc.CallOption) (*executorpb.SignalCommandResponse, error)) *MockExecutorClientSignalCommandCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // StartCommand mocks base method.
 func (m *MockExecutorClient) StartCommand(ctx context.Context, in *executorpb.StartCommandRequest, opts ...grpc.CallOption) (*executorpb.StartCommandResponse, error) {
 	m.ctrl.T.Helper()
@@ -262,6 +570,50 @@ func (c *MockExecutorClientStartCommandCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// Stat mocks base method.
+func (m *MockExecutorClient) Stat(ctx context.Context, in *executorpb.StatRequest, opts ...grpc.CallOption) (*executorpb.StatResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Stat", varargs...)
+	ret0, _ := ret[0].(*executorpb.StatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stat indicates an expected call of Stat.
+func (mr *MockExecutorClientMockRecorder) Stat(ctx, in any, opts ...any) *MockExecutorClientStatCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stat", reflect.TypeOf((*MockExecutorClient)(nil).Stat), varargs...)
+	return &MockExecutorClientStatCall{Call: call}
+}
+
+// MockExecutorClientStatCall wrap *gomock.Call
+type MockExecutorClientStatCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExecutorClientStatCall) Return(arg0 *executorpb.StatResponse, arg1 error) *MockExecutorClientStatCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExecutorClientStatCall) Do(f func(context.Context, *executorpb.StatRequest, ...grpc.CallOption) (*executorpb.StatResponse, error)) *MockExecutorClientStatCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExecutorClientStatCall) DoAndReturn(f func(context.Context, *executorpb.StatRequest, ...grpc.CallOption) (*executorpb.StatResponse, error)) *MockExecutorClientStatCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WaitCommand mocks base method.
 func (m *MockExecutorClient) WaitCommand(ctx context.Context, in *executorpb.WaitCommandRequest, opts ...grpc.CallOption) (*executorpb.WaitCommandResponse, error) {
 	m.ctrl.T.Helper()
@@ -34,6 +34,29 @@ type ExecutorClient interface {
 	WaitCommand(ctx context.Context, in *WaitCommandRequest, opts ...grpc.CallOption) (*WaitCommandResponse, error)
 	// GetCommandLog returns the log of a command.
 	GetCommandLog(ctx context.Context, in *GetCommandLogRequest, opts ...grpc.CallOption) (Executor_GetCommandLogClient, error)
+	// KillCommand kills the whole process tree of a command.
+	// SIGTERM is sent first, and SIGKILL is sent if the command is still running after the grace period.
+	// It returns after the command exits or SIGKILL is sent.
+	KillCommand(ctx context.Context, in *KillCommandRequest, opts ...grpc.CallOption) (*KillCommandResponse, error)
+	// SignalCommand sends a signal to the whole process tree of a command.
+	SignalCommand(ctx context.Context, in *SignalCommandRequest, opts ...grpc.CallOption) (*SignalCommandResponse, error)
+	// GetFiles archives files matched by the patterns into a tar stream.
+	// Files out of the directory, including the ones referred through symlinks, are rejected.
+	GetFiles(ctx context.Context, in *GetFilesRequest, opts ...grpc.CallOption) (Executor_GetFilesClient, error)
+	// PutFiles extracts a tar stream into a directory. The directory is carried by the first message.
+	// Entries out of the directory, including the ones written through symlinks, are rejected.
+	PutFiles(ctx context.Context, opts ...grpc.CallOption) (Executor_PutFilesClient, error)
+	// Stat returns the information of a file. It returns NotFound code if the file doesn't exist.
+	Stat(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*StatResponse, error)
+	// ListDir returns the entries of a directory, sorted by name.
+	ListDir(ctx context.Context, in *ListDirRequest, opts ...grpc.CallOption) (*ListDirResponse, error)
+	// HashFiles returns the sha256 hash of regular files matched by the patterns. Matched directories are walked.
+	// The hash is empty if no file matches.
+	HashFiles(ctx context.Context, in *HashFilesRequest, opts ...grpc.CallOption) (*HashFilesResponse, error)
+	// Exec runs a command interactively. The first message carries the command, the following ones carry the input
+	// and the size of the terminal. Outputs are streamed back, and the last message carries the exit status.
+	// The process tree of the command is killed if the stream is broken.
+	Exec(ctx context.Context, opts ...grpc.CallOption) (Executor_ExecClient, error)
 }
 
 type executorClient struct {
@@ -121,6 +144,148 @@ func (x *executorGetCommandLogClient) Recv() (*Log, error) {
 	return m, nil
 }
 
+func (c *executorClient) KillCommand(ctx context.Context, in *KillCommandRequest, opts ...grpc.CallOption) (*KillCommandResponse, error) {
+	out := new(KillCommandResponse)
+	err := c.cc.Invoke(ctx, "/Executor/KillCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorClient) SignalCommand(ctx context.Context, in *SignalCommandRequest, opts ...grpc.CallOption) (*SignalCommandResponse, error) {
+	out := new(SignalCommandResponse)
+	err := c.cc.Invoke(ctx, "/Executor/SignalCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorClient) GetFiles(ctx context.Context, in *GetFilesRequest, opts ...grpc.CallOption) (Executor_GetFilesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Executor_ServiceDesc.Streams[1], "/Executor/GetFiles", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executorGetFilesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Executor_GetFilesClient interface {
+	Recv() (*FileChunk, error)
+	grpc.ClientStream
+}
+
+type executorGetFilesClient struct {
+	grpc.ClientStream
+}
+
+func (x *executorGetFilesClient) Recv() (*FileChunk, error) {
+	m := new(FileChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *executorClient) PutFiles(ctx context.Context, opts ...grpc.CallOption) (Executor_PutFilesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Executor_ServiceDesc.Streams[2], "/Executor/PutFiles", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executorPutFilesClient{stream}
+	return x, nil
+}
+
+type Executor_PutFilesClient interface {
+	Send(*PutFilesRequest) error
+	CloseAndRecv() (*PutFilesResponse, error)
+	grpc.ClientStream
+}
+
+type executorPutFilesClient struct {
+	grpc.ClientStream
+}
+
+func (x *executorPutFilesClient) Send(m *PutFilesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *executorPutFilesClient) CloseAndRecv() (*PutFilesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(PutFilesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *executorClient) Stat(ctx context.Context, in *StatRequest, opts ...grpc.CallOption) (*StatResponse, error) {
+	out := new(StatResponse)
+	err := c.cc.Invoke(ctx, "/Executor/Stat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorClient) ListDir(ctx context.Context, in *ListDirRequest, opts ...grpc.CallOption) (*ListDirResponse, error) {
+	out := new(ListDirResponse)
+	err := c.cc.Invoke(ctx, "/Executor/ListDir", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorClient) HashFiles(ctx context.Context, in *HashFilesRequest, opts ...grpc.CallOption) (*HashFilesResponse, error) {
+	out := new(HashFilesResponse)
+	err := c.cc.Invoke(ctx, "/Executor/HashFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executorClient) Exec(ctx context.Context, opts ...grpc.CallOption) (Executor_ExecClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Executor_ServiceDesc.Streams[3], "/Executor/Exec", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executorExecClient{stream}
+	return x, nil
+}
+
+type Executor_ExecClient interface {
+	Send(*ExecRequest) error
+	Recv() (*ExecResponse, error)
+	grpc.ClientStream
+}
+
+type executorExecClient struct {
+	grpc.ClientStream
+}
+
+func (x *executorExecClient) Send(m *ExecRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *executorExecClient) Recv() (*ExecResponse, error) {
+	m := new(ExecResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ExecutorServer is the server API for Executor service.
 // All implementations must embed UnimplementedExecutorServer
 // for forward compatibility
@@ -137,6 +302,29 @@ type ExecutorServer interface {
 	WaitCommand(context.Context, *WaitCommandRequest) (*WaitCommandResponse, error)
 	// GetCommandLog returns the log of a command.
 	GetCommandLog(*GetCommandLogRequest, Executor_GetCommandLogServer) error
+	// KillCommand kills the whole process tree of a command.
+	// SIGTERM is sent first, and SIGKILL is sent if the command is still running after the grace period.
+	// It returns after the command exits or SIGKILL is sent.
+	KillCommand(context.Context, *KillCommandRequest) (*KillCommandResponse, error)
+	// SignalCommand sends a signal to the whole process tree of a command.
+	SignalCommand(context.Context, *SignalCommandRequest) (*SignalCommandResponse, error)
+	// GetFiles archives files matched by the patterns into a tar stream.
+	// Files out of the directory, including the ones referred through symlinks, are rejected.
+	GetFiles(*GetFilesRequest, Executor_GetFilesServer) error
+	// PutFiles extracts a tar stream into a directory. The directory is carried by the first message.
+	// Entries out of the directory, including the ones written through symlinks, are rejected.
+	PutFiles(Executor_PutFilesServer) error
+	// Stat returns the information of a file. It returns NotFound code if the file doesn't exist.
+	Stat(context.Context, *StatRequest) (*StatResponse, error)
+	// ListDir returns the entries of a directory, sorted by name.
+	ListDir(context.Context, *ListDirRequest) (*ListDirResponse, error)
+	// HashFiles returns the sha256 hash of regular files matched by the patterns. Matched directories are walked.
+	// The hash is empty if no file matches.
+	HashFiles(context.Context, *HashFilesRequest) (*HashFilesResponse, error)
+	// Exec runs a command interactively. The first message carries the command, the following ones carry the input
+	// and the size of the terminal. Outputs are streamed back, and the last message carries the exit status.
+	// The process tree of the command is killed if the stream is broken.
+	Exec(Executor_ExecServer) error
 	mustEmbedUnimplementedExecutorServer()
 }
 
@@ -162,6 +350,30 @@ func (UnimplementedExecutorServer) WaitCommand(context.Context, *WaitCommandRequ
 func (UnimplementedExecutorServer) GetCommandLog(*GetCommandLogRequest, Executor_GetCommandLogServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetCommandLog not implemented")
 }
+func (UnimplementedExecutorServer) KillCommand(context.Context, *KillCommandRequest) (*KillCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillCommand not implemented")
+}
+func (UnimplementedExecutorServer) SignalCommand(context.Context, *SignalCommandRequest) (*SignalCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalCommand not implemented")
+}
+func (UnimplementedExecutorServer) GetFiles(*GetFilesRequest, Executor_GetFilesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetFiles not implemented")
+}
+func (UnimplementedExecutorServer) PutFiles(Executor_PutFilesServer) error {
+	return status.Errorf(codes.Unimplemented, "method PutFiles not implemented")
+}
+func (UnimplementedExecutorServer) Stat(context.Context, *StatRequest) (*StatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stat not implemented")
+}
+func (UnimplementedExecutorServer) ListDir(context.Context, *ListDirRequest) (*ListDirResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDir not implemented")
+}
+func (UnimplementedExecutorServer) HashFiles(context.Context, *HashFilesRequest) (*HashFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HashFiles not implemented")
+}
+func (UnimplementedExecutorServer) Exec(Executor_ExecServer) error {
+	return status.Errorf(codes.Unimplemented, "method Exec not implemented")
+}
 func (UnimplementedExecutorServer) mustEmbedUnimplementedExecutorServer() {}
 
 // UnsafeExecutorServer may be embedded to opt out of forward compatibility for this service.
@@ -286,6 +498,169 @@ func (x *executorGetCommandLogServer) Send(m *Log) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Executor_KillCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).KillCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Executor/KillCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).KillCommand(ctx, req.(*KillCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Executor_SignalCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).SignalCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Executor/SignalCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).SignalCommand(ctx, req.(*SignalCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Executor_GetFiles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFilesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutorServer).GetFiles(m, &executorGetFilesServer{stream})
+}
+
+type Executor_GetFilesServer interface {
+	Send(*FileChunk) error
+	grpc.ServerStream
+}
+
+type executorGetFilesServer struct {
+	grpc.ServerStream
+}
+
+func (x *executorGetFilesServer) Send(m *FileChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Executor_PutFiles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExecutorServer).PutFiles(&executorPutFilesServer{stream})
+}
+
+type Executor_PutFilesServer interface {
+	SendAndClose(*PutFilesResponse) error
+	Recv() (*PutFilesRequest, error)
+	grpc.ServerStream
+}
+
+type executorPutFilesServer struct {
+	grpc.ServerStream
+}
+
+func (x *executorPutFilesServer) SendAndClose(m *PutFilesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *executorPutFilesServer) Recv() (*PutFilesRequest, error) {
+	m := new(PutFilesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Executor_Stat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).Stat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Executor/Stat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).Stat(ctx, req.(*StatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Executor_ListDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDirRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).ListDir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Executor/ListDir",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).ListDir(ctx, req.(*ListDirRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Executor_HashFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutorServer).HashFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Executor/HashFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutorServer).HashFiles(ctx, req.(*HashFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Executor_Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExecutorServer).Exec(&executorExecServer{stream})
+}
+
+type Executor_ExecServer interface {
+	Send(*ExecResponse) error
+	Recv() (*ExecRequest, error)
+	grpc.ServerStream
+}
+
+type executorExecServer struct {
+	grpc.ServerStream
+}
+
+func (x *executorExecServer) Send(m *ExecResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *executorExecServer) Recv() (*ExecRequest, error) {
+	m := new(ExecRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Executor_ServiceDesc is the grpc.ServiceDesc for Executor service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -313,6 +688,26 @@ var Executor_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WaitCommand",
 			Handler:    _Executor_WaitCommand_Handler,
 		},
+		{
+			MethodName: "KillCommand",
+			Handler:    _Executor_KillCommand_Handler,
+		},
+		{
+			MethodName: "SignalCommand",
+			Handler:    _Executor_SignalCommand_Handler,
+		},
+		{
+			MethodName: "Stat",
+			Handler:    _Executor_Stat_Handler,
+		},
+		{
+			MethodName: "ListDir",
+			Handler:    _Executor_ListDir_Handler,
+		},
+		{
+			MethodName: "HashFiles",
+			Handler:    _Executor_HashFiles_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -320,6 +715,22 @@ var Executor_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Executor_GetCommandLog_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetFiles",
+			Handler:       _Executor_GetFiles_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PutFiles",
+			Handler:       _Executor_PutFiles_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Exec",
+			Handler:       _Executor_Exec_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "service.proto",
 }
@@ -7,6 +7,7 @@ import (
 	"os/exec"
 	"os/user"
 	"runtime"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -36,6 +37,10 @@ type Command interface {
 	Wait() <-chan error
 	ExitCode() int
 	Start() error
+	// Done returns a channel which is closed when the command exits.
+	Done() <-chan struct{}
+	// Signal sends the signal to the command and all its child processes.
+	Signal(sig syscall.Signal) error
 }
 
 func (h *Handler) GetCommandLog(request *executorpb.GetCommandLogRequest, server executorpb.Executor_GetCommandLogServer) error {
@@ -96,26 +101,34 @@ var (
 )
 
 func setUser(cmd *exec.Cmd, username string) (u *user.User, err error) {
-	defer func() {
-		if u != nil {
-			setHomeEnv(cmd, u)
-		}
-	}()
+	u, switched, err := resolveUser(username)
+	if err != nil {
+		return nil, err
+	}
+	setHomeEnv(cmd, u)
+	if switched {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+		return u, setUserForSysProcAttr(cmd.SysProcAttr, u)
+	}
+	return u, nil
+}
+
+// resolveUser returns the user to run commands and own files as. switched is true if the user is not the one
+// the executor runs as, it's the real user if username is empty.
+func resolveUser(username string) (u *user.User, switched bool, err error) {
 	if len(username) > 0 {
 		if username == effectiveUser.Username {
-			return effectiveUser, nil
+			return effectiveUser, false, nil
 		}
-		user, err := user.Lookup(username)
+		u, err := user.Lookup(username)
 		if err != nil {
-			return nil, errors.WithMessagef(err, "failed to find user '%s'", username)
+			return nil, false, errors.WithMessagef(err, "failed to find user '%s'", username)
 		}
-		cmd.SysProcAttr = &syscall.SysProcAttr{}
-		return user, setUserForSysProcAttr(cmd.SysProcAttr, user)
+		return u, true, nil
 	} else if effectiveUser.Uid != realUser.Uid {
-		cmd.SysProcAttr = &syscall.SysProcAttr{}
-		return realUser, setUserForSysProcAttr(cmd.SysProcAttr, realUser)
+		return realUser, true, nil
 	}
-	return realUser, nil
+	return realUser, false, nil
 }
 
 func setHomeEnv(cmd *exec.Cmd, user *user.User) {
@@ -162,6 +175,9 @@ func (h *Handler) StartCommand(ctx context.Context, request *executorpb.StartCom
 	if err := c.Start(); err != nil {
 		return nil, errors.WithMessage(err, "failed to start command")
 	}
+	if request.Timeout > 0 {
+		c = newTimeoutCommand(c, time.Duration(request.Timeout), time.Duration(request.KillGracePeriod))
+	}
 
 	commandID, err := h.setCommand(c)
 	if err != nil {
@@ -206,6 +222,9 @@ func (h *Handler) WaitCommand(ctx context.Context, request *executorpb.WaitComma
 				Exit: true,
 			},
 		}
+		if tc, ok := c.(*timeoutCommand); ok {
+			s.Status.TimedOut = tc.timedOut.Load()
+		}
 		if waitError != nil {
 			s.Status.Error = waitError.Error()
 		}
@@ -225,6 +244,7 @@ func newCommand(commands []string, stdout io.ReadWriteCloser, stderr io.WriteClo
 	if err != nil {
 		return nil, errors.WithMessagef(err, "failed to set user: %s", username)
 	}
+	setProcessGroup(cmd)
 	if len(env) > 0 {
 		cmd.Env = append(cmd.Env, env...)
 	}
@@ -232,6 +252,7 @@ func newCommand(commands []string, stdout io.ReadWriteCloser, stderr io.WriteClo
 		Cmd:       cmd,
 		logWriter: stdout,
 		runningCh: make(chan error),
+		done:      make(chan struct{}),
 	}, nil
 }
 
@@ -240,22 +261,37 @@ type command struct {
 	logWriter io.ReadWriteCloser
 	runningCh chan error
 	waitError error
+	// done is closed when the command exits.
+	done chan struct{}
+	tree *processTree
 }
 
 func (c *command) Start() error {
 	err := c.Cmd.Start()
 	if err != nil {
 		close(c.runningCh)
+		close(c.done)
 		_ = c.logWriter.Close()
 		return err
 	}
+	c.tree, err = attachProcessTree(c.Process)
+	if err != nil {
+		_ = c.Process.Kill()
+		_ = c.Cmd.Wait()
+		close(c.runningCh)
+		close(c.done)
+		_ = c.logWriter.Close()
+		return errors.WithMessage(err, "failed to attach process tree")
+	}
 	go func() {
 		c.waitError = c.Cmd.Wait()
 		_ = c.logWriter.Close()
+		close(c.done)
 		if c.waitError != nil {
 			c.runningCh <- c.waitError
 		}
 		close(c.runningCh)
+		c.tree.release()
 	}()
 	return err
 }
@@ -276,3 +312,107 @@ func (c *command) GetPID() int {
 func (c *command) ExitCode() int {
 	return c.ProcessState.ExitCode()
 }
+
+func (c *command) Done() <-chan struct{} {
+	return c.done
+}
+
+// Signal sends the signal to the process tree of the command.
+func (c *command) Signal(sig syscall.Signal) error {
+	return c.tree.signal(sig)
+}
+
+// terminateCommand sends SIGTERM to the command, and sends SIGKILL after the grace period.
+// SIGKILL is sent even if the command exits in the grace period, so that its child processes are killed too.
+// It returns after the command exits or SIGKILL is sent.
+func terminateCommand(c Command, gracePeriod time.Duration) error {
+	select {
+	case <-c.Done():
+	default:
+		if gracePeriod <= 0 {
+			break
+		}
+		if err := c.Signal(syscall.SIGTERM); err != nil {
+			log.Warningf("failed to send SIGTERM to command with pid %d, kill it: %v", c.GetPID(), err)
+			break
+		}
+		select {
+		case <-c.Done():
+		case <-time.After(gracePeriod):
+			log.Infof("command with pid %d is still running after %s, kill it", c.GetPID(), gracePeriod)
+		}
+	}
+	if err := c.Signal(syscall.SIGKILL); err != nil {
+		select {
+		case <-c.Done():
+			// The command and its child processes have exited.
+			return nil
+		default:
+		}
+		return errors.WithMessage(err, "failed to send SIGKILL")
+	}
+	return nil
+}
+
+// timeoutCommand terminates the wrapped command when it runs longer than the timeout.
+type timeoutCommand struct {
+	Command
+	timedOut atomic.Bool
+}
+
+// newTimeoutCommand wraps a started command. The command is terminated after timeout.
+func newTimeoutCommand(c Command, timeout time.Duration, killGracePeriod time.Duration) *timeoutCommand {
+	tc := &timeoutCommand{
+		Command: c,
+	}
+	go func() {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		select {
+		case <-c.Done():
+			return
+		case <-timer.C:
+		}
+		log.Infof("command with pid %d is timed out after %s, terminate it", c.GetPID(), timeout)
+		tc.timedOut.Store(true)
+		if err := terminateCommand(c, killGracePeriod); err != nil {
+			log.Errorf("failed to terminate command with pid %d: %v", c.GetPID(), err)
+		}
+	}()
+	return tc
+}
+
+func (h *Handler) getCommand(commandID string) (Command, error) {
+	h.commandLock.RLock()
+	c, ok := h.commands[commandID]
+	h.commandLock.RUnlock()
+	if !ok {
+		return nil, errors.Errorf("command with id %s not found", commandID)
+	}
+	return c, nil
+}
+
+func (h *Handler) KillCommand(ctx context.Context, request *executorpb.KillCommandRequest) (*executorpb.KillCommandResponse, error) {
+	c, err := h.getCommand(request.CommandID)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("kill command with pid %d, grace period: %s", c.GetPID(), time.Duration(request.GracePeriod))
+	if err = terminateCommand(c, time.Duration(request.GracePeriod)); err != nil {
+		return nil, errors.WithMessagef(err, "failed to kill command with pid %d", c.GetPID())
+	}
+	return &executorpb.KillCommandResponse{}, nil
+}
+
+func (h *Handler) SignalCommand(ctx context.Context, request *executorpb.SignalCommandRequest) (*executorpb.SignalCommandResponse, error) {
+	c, err := h.getCommand(request.CommandID)
+	if err != nil {
+		return nil, err
+	}
+	sig := syscall.Signal(request.Signal)
+	log.Infof("send signal '%s' to command with pid %d", sig, c.GetPID())
+	if err = c.Signal(sig); err != nil {
+		return nil, errors.WithMessagef(err, "failed to send signal '%s' to command with pid %d", sig, c.GetPID())
+	}
+	return &executorpb.SignalCommandResponse{}, nil
+}
@@ -3,6 +3,8 @@
 package handler
 
 import (
+	"os"
+	"os/exec"
 	"os/user"
 	"strconv"
 	"syscall"
@@ -40,3 +42,28 @@ func setUserForSysProcAttr(attr *syscall.SysProcAttr, u *user.User) error {
 	}
 	return nil
 }
+
+// setProcessGroup makes the command run in a new process group, so that its child processes can be signaled together.
+func setProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+}
+
+// processTree is the process group led by the command process.
+type processTree struct {
+	pgid int
+}
+
+func attachProcessTree(p *os.Process) (*processTree, error) {
+	return &processTree{pgid: p.Pid}, nil
+}
+
+// signal sends the signal to all processes in the process group.
+func (t *processTree) signal(sig syscall.Signal) error {
+	return syscall.Kill(-t.pgid, sig)
+}
+
+func (t *processTree) release() {
+}
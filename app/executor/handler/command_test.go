@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"syscall"
 	"testing"
 	"time"
 
@@ -98,6 +99,114 @@ func TestHandler_StartCommand(t *testing.T) {
 		time.Sleep(time.Millisecond * 10)
 		_ = handler.commands[resp.CommandID].(*command).Process.Kill()
 	})
+	t.Run("timeout", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip on windows")
+		}
+		resp, err := client.StartCommand(context.Background(),
+			&executorpb.StartCommandRequest{
+				// The child process holds the log pipe, it must be killed as well.
+				Commands: []string{"sh", "-c", "sleep 30 & wait"},
+				Dir:      "/tmp",
+				Timeout:  int64(time.Millisecond * 100),
+			})
+		assert.NilError(t, err)
+		waitResp, err := client.WaitCommand(context.Background(), &executorpb.WaitCommandRequest{
+			CommandID: resp.CommandID,
+			Timeout:   int64(time.Second * 10),
+		})
+		assert.NilError(t, err)
+		assert.Assert(t, waitResp.Status.Exit)
+		assert.Assert(t, waitResp.Status.TimedOut)
+		commandLogResp, err := client.GetCommandLog(context.Background(), &executorpb.GetCommandLogRequest{
+			CommandID: resp.CommandID,
+		})
+		assert.NilError(t, err)
+		_, err = executorpb.ReadAllFromCommandLog(commandLogResp)
+		assert.NilError(t, err)
+	})
+	t.Run("kill", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip on windows")
+		}
+		startAndKill := func(t *testing.T, script string, gracePeriod time.Duration) *executorpb.ProcessStatus {
+			resp, err := client.StartCommand(context.Background(),
+				&executorpb.StartCommandRequest{
+					Commands: []string{"sh", "-c", script},
+					Dir:      "/tmp",
+				})
+			assert.NilError(t, err)
+			// Wait for the trap to be set.
+			time.Sleep(time.Millisecond * 200)
+			_, err = client.KillCommand(context.Background(), &executorpb.KillCommandRequest{
+				CommandID:   resp.CommandID,
+				GracePeriod: int64(gracePeriod),
+			})
+			assert.NilError(t, err)
+			waitResp, err := client.WaitCommand(context.Background(), &executorpb.WaitCommandRequest{
+				CommandID: resp.CommandID,
+				Timeout:   int64(time.Second * 10),
+			})
+			assert.NilError(t, err)
+			assert.Assert(t, waitResp.Status.Exit)
+			return waitResp.Status
+		}
+		t.Run("graceful", func(t *testing.T) {
+			start := time.Now()
+			status := startAndKill(t, `trap "exit 3" TERM; while true; do sleep 0.1; done`, time.Second*10)
+			assert.Equal(t, status.ExitCode, int32(3))
+			assert.Assert(t, time.Since(start) < time.Second*5)
+		})
+		t.Run("force", func(t *testing.T) {
+			status := startAndKill(t, `trap "" TERM; sleep 30 & wait`, time.Millisecond*200)
+			assert.Assert(t, status.ExitCode != 0)
+		})
+		t.Run("child_ignores_term", func(t *testing.T) {
+			pidFile := filepath.Join(t.TempDir(), "pid")
+			status := startAndKill(t, fmt.Sprintf(`sh -c 'trap "" TERM; exec sleep 30' >/dev/null 2>&1 & echo $! > %s; `+
+				`trap "exit 3" TERM; while true; do sleep 0.1; done`, pidFile), time.Second*10)
+			assert.Equal(t, status.ExitCode, int32(3))
+			pid, err := os.ReadFile(pidFile)
+			assert.NilError(t, err)
+			// The child process is killed with the process group, even if the command exits in the grace period.
+			killed := false
+			for i := 0; i < 50 && !killed; i++ {
+				time.Sleep(time.Millisecond * 100)
+				output, _ := exec.Command("ps", "-o", "stat=", "-p", strings.TrimSpace(string(pid))).Output()
+				state := strings.TrimSpace(string(output))
+				killed = state == "" || strings.HasPrefix(state, "Z")
+			}
+			assert.Assert(t, killed)
+		})
+		t.Run("not_found", func(t *testing.T) {
+			_, err := client.KillCommand(context.Background(), &executorpb.KillCommandRequest{CommandID: "not_found"})
+			assert.ErrorContains(t, err, "not found")
+		})
+	})
+	t.Run("signal", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip on windows")
+		}
+		resp, err := client.StartCommand(context.Background(),
+			&executorpb.StartCommandRequest{
+				Commands: []string{"sh", "-c", `trap "exit 4" TERM; while true; do sleep 0.1; done`},
+				Dir:      "/tmp",
+			})
+		assert.NilError(t, err)
+		time.Sleep(time.Millisecond * 200)
+		_, err = client.SignalCommand(context.Background(), &executorpb.SignalCommandRequest{
+			CommandID: resp.CommandID,
+			Signal:    int32(syscall.SIGTERM),
+		})
+		assert.NilError(t, err)
+		waitResp, err := client.WaitCommand(context.Background(), &executorpb.WaitCommandRequest{
+			CommandID: resp.CommandID,
+			Timeout:   int64(time.Second * 10),
+		})
+		assert.NilError(t, err)
+		assert.Assert(t, waitResp.Status.Exit)
+		assert.Equal(t, waitResp.Status.ExitCode, int32(4))
+	})
 	t.Run("invalid-command", func(t *testing.T) {
 		_, err := client.StartCommand(context.Background(),
 			&executorpb.StartCommandRequest{
@@ -1,10 +1,15 @@
 package handler
 
 import (
+	"os"
+	"os/exec"
 	"os/user"
 	"syscall"
+	"unsafe"
 
+	"github.com/cockroachdb/errors"
 	"github.com/cox96de/runner/log"
+	"golang.org/x/sys/windows"
 )
 
 func init() {
@@ -20,3 +25,79 @@ func setUserForSysProcAttr(_ *syscall.SysProcAttr, _ *user.User) error {
 	// Windows doesn't have the concept of setting the user for a process.
 	return nil
 }
+
+// setProcessGroup makes the command start suspended, so that it's assigned to the job object before it runs and
+// creates child processes. It's resumed by attachProcessTree.
+func setProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.CreationFlags |= windows.CREATE_SUSPENDED
+}
+
+// processTree is a job object contains the command process and its child processes.
+type processTree struct {
+	job windows.Handle
+}
+
+func attachProcessTree(p *os.Process) (*processTree, error) {
+	job, err := windows.CreateJobObject(nil, nil)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to create job object")
+	}
+	handle, err := windows.OpenProcess(windows.PROCESS_SET_QUOTA|windows.PROCESS_TERMINATE, false, uint32(p.Pid))
+	if err != nil {
+		_ = windows.CloseHandle(job)
+		return nil, errors.WithMessage(err, "failed to open process")
+	}
+	defer windows.CloseHandle(handle)
+	if err = windows.AssignProcessToJobObject(job, handle); err != nil {
+		_ = windows.CloseHandle(job)
+		return nil, errors.WithMessage(err, "failed to assign process to job object")
+	}
+	if err = resumeProcess(uint32(p.Pid)); err != nil {
+		_ = windows.CloseHandle(job)
+		return nil, errors.WithMessage(err, "failed to resume process")
+	}
+	return &processTree{job: job}, nil
+}
+
+// resumeProcess resumes threads of the process created suspended.
+func resumeProcess(pid uint32) error {
+	snapshot, err := windows.CreateToolhelp32Snapshot(windows.TH32CS_SNAPTHREAD, 0)
+	if err != nil {
+		return errors.WithMessage(err, "failed to create snapshot of threads")
+	}
+	defer windows.CloseHandle(snapshot)
+	entry := windows.ThreadEntry32{Size: uint32(unsafe.Sizeof(windows.ThreadEntry32{}))}
+	for err = windows.Thread32First(snapshot, &entry); err == nil; err = windows.Thread32Next(snapshot, &entry) {
+		if entry.OwnerProcessID != pid {
+			continue
+		}
+		thread, err := windows.OpenThread(windows.THREAD_SUSPEND_RESUME, false, entry.ThreadID)
+		if err != nil {
+			return errors.WithMessagef(err, "failed to open thread %d", entry.ThreadID)
+		}
+		_, err = windows.ResumeThread(thread)
+		_ = windows.CloseHandle(thread)
+		if err != nil {
+			return errors.WithMessagef(err, "failed to resume thread %d", entry.ThreadID)
+		}
+	}
+	if !errors.Is(err, windows.ERROR_NO_MORE_FILES) {
+		return errors.WithMessage(err, "failed to list threads")
+	}
+	return nil
+}
+
+// signal terminates all processes in the job object. Only SIGKILL is supported on windows.
+func (t *processTree) signal(sig syscall.Signal) error {
+	if sig != syscall.SIGKILL {
+		return errors.Errorf("signal '%s' is not supported on windows", sig)
+	}
+	return windows.TerminateJobObject(t.job, 1)
+}
+
+func (t *processTree) release() {
+	_ = windows.CloseHandle(t.job)
+}
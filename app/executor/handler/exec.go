@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"syscall"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/app/executor/executorpb"
+	"github.com/cox96de/runner/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// eofCharacter is sent to the terminal to close the input of a command running with tty.
+const eofCharacter = "\x04"
+
+// Exec runs a command interactively, the command is not managed by the command map.
+func (h *Handler) Exec(stream executorpb.Executor_ExecServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return errors.WithMessage(err, "failed to receive the first message")
+	}
+	start := first.Start
+	if start == nil || len(start.Commands) == 0 {
+		return status.Error(codes.InvalidArgument, "no command provided")
+	}
+	cmd := exec.Command(start.Commands[0], start.Commands[1:]...)
+	cmd.Dir = start.Dir
+	cmd.Env = os.Environ()
+	if _, err = setUser(cmd, start.Username); err != nil {
+		return errors.WithMessagef(err, "failed to set user: %s", start.Username)
+	}
+	cmd.Env = append(cmd.Env, start.Env...)
+	e := &execution{stream: stream}
+	if start.TTY {
+		err = e.startWithTTY(cmd, start.Size)
+	} else {
+		err = e.start(cmd)
+	}
+	if err != nil {
+		return err
+	}
+	log.Infof("exec command %v with pid %d, tty: %t", start.Commands, cmd.Process.Pid, start.TTY)
+	tree, err := attachProcessTree(cmd.Process)
+	if err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		return errors.WithMessage(err, "failed to attach process tree")
+	}
+	defer tree.release()
+	exited := make(chan struct{})
+	defer close(exited)
+	go func() {
+		select {
+		case <-stream.Context().Done():
+			log.Infof("exec stream of pid %d is broken, kill it", cmd.Process.Pid)
+			_ = tree.signal(syscall.SIGKILL)
+		case <-exited:
+		}
+	}()
+	go e.forwardInput()
+	// Outputs are drained before waiting, it's required by pipes of exec.Cmd.
+	e.outputs.Wait()
+	waitErr := cmd.Wait()
+	if e.tty != nil {
+		_ = e.tty.Close()
+	}
+	processStatus := &executorpb.ProcessStatus{
+		Pid:      int32(cmd.Process.Pid),
+		ExitCode: int32(cmd.ProcessState.ExitCode()),
+		Exit:     true,
+	}
+	var exitErr *exec.ExitError
+	if waitErr != nil && !errors.As(waitErr, &exitErr) {
+		processStatus.Error = waitErr.Error()
+	}
+	return e.send(&executorpb.ExecResponse{Status: processStatus})
+}
+
+// execution is a command started by Exec.
+type execution struct {
+	stream executorpb.Executor_ExecServer
+	// sendLock protects stream.Send, outputs are sent concurrently.
+	sendLock sync.Mutex
+	// outputs is done when all outputs are drained.
+	outputs sync.WaitGroup
+	stdin   io.WriteCloser
+	// tty is the pseudo terminal of the command, it's nil without tty.
+	tty *os.File
+}
+
+func (e *execution) start(cmd *exec.Cmd) error {
+	setProcessGroup(cmd)
+	var err error
+	e.stdin, err = cmd.StdinPipe()
+	if err != nil {
+		return errors.WithMessage(err, "failed to create stdin pipe")
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return errors.WithMessage(err, "failed to create stdout pipe")
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return errors.WithMessage(err, "failed to create stderr pipe")
+	}
+	if err = cmd.Start(); err != nil {
+		return errors.WithMessage(err, "failed to start command")
+	}
+	e.forwardOutput(stdout, executorpb.LogSourceStdout)
+	e.forwardOutput(stderr, executorpb.LogSourceStderr)
+	return nil
+}
+
+func (e *execution) startWithTTY(cmd *exec.Cmd, size *executorpb.WindowSize) error {
+	tty, err := startPTY(cmd, size)
+	if err != nil {
+		return err
+	}
+	e.tty = tty
+	e.stdin = tty
+	e.forwardOutput(tty, executorpb.LogSourceStdout)
+	return nil
+}
+
+func (e *execution) send(response *executorpb.ExecResponse) error {
+	e.sendLock.Lock()
+	defer e.sendLock.Unlock()
+	return e.stream.Send(response)
+}
+
+func (e *execution) forwardOutput(r io.Reader, source executorpb.LogSource) {
+	e.outputs.Add(1)
+	go func() {
+		defer e.outputs.Done()
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := r.Read(buf)
+			if n > 0 {
+				output := make([]byte, n)
+				copy(output, buf[:n])
+				if sendErr := e.send(&executorpb.ExecResponse{Source: source, Output: output}); sendErr != nil {
+					log.Warningf("failed to send output: %v", sendErr)
+				}
+			}
+			// The pseudo terminal returns EIO after the command exits.
+			if err != nil {
+				return
+			}
+		}
+	}()
+}
+
+// forwardInput writes inputs of the stream to the command until the stream is closed.
+func (e *execution) forwardInput() {
+	for {
+		request, err := e.stream.Recv()
+		if err != nil {
+			if e.tty == nil {
+				_ = e.stdin.Close()
+			}
+			return
+		}
+		if len(request.Stdin) > 0 {
+			if _, err = e.stdin.Write(request.Stdin); err != nil {
+				log.Warningf("failed to write stdin: %v", err)
+			}
+		}
+		if request.Resize != nil && e.tty != nil {
+			if err = setTerminalSize(e.tty, request.Resize); err != nil {
+				log.Warningf("failed to resize terminal: %v", err)
+			}
+		}
+		if request.CloseStdin {
+			if e.tty != nil {
+				_, _ = io.WriteString(e.tty, eofCharacter)
+			} else {
+				_ = e.stdin.Close()
+			}
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/app/executor/executorpb"
+	"golang.org/x/sys/unix"
+)
+
+// startPTY starts the command in a new session with a pseudo terminal as its controlling terminal.
+// It returns the master side of the terminal.
+func startPTY(cmd *exec.Cmd, size *executorpb.WindowSize) (*os.File, error) {
+	master, slave, err := openPTY()
+	if err != nil {
+		return nil, err
+	}
+	// The slave side is only used by the command.
+	defer slave.Close()
+	if size != nil {
+		if err = setTerminalSize(master, size); err != nil {
+			_ = master.Close()
+			return nil, err
+		}
+	}
+	if !hasEnv(cmd.Env, "TERM") {
+		cmd.Env = append(cmd.Env, "TERM=xterm")
+	}
+	cmd.Stdin = slave
+	cmd.Stdout = slave
+	cmd.Stderr = slave
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	// The command leads a new session, so it's the leader of a process group as well.
+	cmd.SysProcAttr.Setsid = true
+	cmd.SysProcAttr.Setctty = true
+	if err = cmd.Start(); err != nil {
+		_ = master.Close()
+		return nil, errors.WithMessage(err, "failed to start command")
+	}
+	return master, nil
+}
+
+func openPTY() (master *os.File, slave *os.File, err error) {
+	master, err = os.OpenFile("/dev/ptmx", os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, "failed to open ptmx")
+	}
+	var number int
+	err = control(master, func(fd int) error {
+		if err := unix.IoctlSetPointerInt(fd, unix.TIOCSPTLCK, 0); err != nil {
+			return errors.WithMessage(err, "failed to unlock pty")
+		}
+		var err error
+		number, err = unix.IoctlGetInt(fd, unix.TIOCGPTN)
+		return errors.WithMessage(err, "failed to get pty number")
+	})
+	if err != nil {
+		_ = master.Close()
+		return nil, nil, err
+	}
+	slave, err = os.OpenFile("/dev/pts/"+strconv.Itoa(number), os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		_ = master.Close()
+		return nil, nil, errors.WithMessage(err, "failed to open pts")
+	}
+	return master, slave, nil
+}
+
+func setTerminalSize(tty *os.File, size *executorpb.WindowSize) error {
+	return control(tty, func(fd int) error {
+		return unix.IoctlSetWinsize(fd, unix.TIOCSWINSZ, &unix.Winsize{
+			Row: uint16(size.Rows),
+			Col: uint16(size.Cols),
+		})
+	})
+}
+
+// control calls f with the file descriptor. It doesn't change the file to blocking mode as os.File.Fd does.
+func control(file *os.File, f func(fd int) error) error {
+	conn, err := file.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var fErr error
+	if err = conn.Control(func(fd uintptr) {
+		fErr = f(int(fd))
+	}); err != nil {
+		return err
+	}
+	return fErr
+}
+
+// hasEnv returns true if the environment variable is set in env.
+func hasEnv(env []string, key string) bool {
+	for _, kv := range env {
+		if strings.HasPrefix(kv, key+"=") {
+			return true
+		}
+	}
+	return false
+}
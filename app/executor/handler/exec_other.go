@@ -0,0 +1,21 @@
+//go:build !linux
+
+package handler
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/cox96de/runner/app/executor/executorpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func startPTY(_ *exec.Cmd, _ *executorpb.WindowSize) (*os.File, error) {
+	return nil, status.Errorf(codes.Unimplemented, "tty is not supported on %s", runtime.GOOS)
+}
+
+func setTerminalSize(_ *os.File, _ *executorpb.WindowSize) error {
+	return status.Errorf(codes.Unimplemented, "tty is not supported on %s", runtime.GOOS)
+}
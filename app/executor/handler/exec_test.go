@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/cox96de/runner/app/executor/executorpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/poll"
+)
+
+// readExec reads outputs of stdout and stderr until the exit status is received.
+func readExec(t *testing.T, stream executorpb.Executor_ExecClient) (string, string, *executorpb.ProcessStatus) {
+	stdout, stderr := &strings.Builder{}, &strings.Builder{}
+	for {
+		response, err := stream.Recv()
+		assert.NilError(t, err)
+		if response.Status != nil {
+			return stdout.String(), stderr.String(), response.Status
+		}
+		if response.Source == executorpb.LogSourceStderr {
+			stderr.Write(response.Output)
+		} else {
+			stdout.Write(response.Output)
+		}
+	}
+}
+
+func TestHandler_Exec(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skip on windows")
+	}
+	_, addr := setupHandler(t)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NilError(t, err)
+	client := executorpb.NewExecutorClient(conn)
+	t.Run("pipe", func(t *testing.T) {
+		stream, err := client.Exec(context.Background())
+		assert.NilError(t, err)
+		assert.NilError(t, stream.Send(&executorpb.ExecRequest{
+			Start: &executorpb.ExecStart{
+				Commands: []string{"sh", "-c", `read line; echo "got $line"; cat; echo "$FOO" >&2; exit 3`},
+				Env:      []string{"FOO=bar"},
+			},
+		}))
+		assert.NilError(t, stream.Send(&executorpb.ExecRequest{Stdin: []byte("hello\nworld\n")}))
+		assert.NilError(t, stream.Send(&executorpb.ExecRequest{CloseStdin: true}))
+		stdout, stderr, processStatus := readExec(t, stream)
+		assert.Equal(t, stdout, "got hello\nworld\n")
+		assert.Equal(t, stderr, "bar\n")
+		assert.Assert(t, processStatus.Exit)
+		assert.Equal(t, processStatus.ExitCode, int32(3))
+		_, err = stream.Recv()
+		assert.Equal(t, err, io.EOF)
+	})
+	t.Run("tty", func(t *testing.T) {
+		stream, err := client.Exec(context.Background())
+		assert.NilError(t, err)
+		assert.NilError(t, stream.Send(&executorpb.ExecRequest{
+			Start: &executorpb.ExecStart{
+				Commands: []string{"sh", "-c", `test -t 0 && read line && stty size`},
+				TTY:      true,
+				Size:     &executorpb.WindowSize{Rows: 24, Cols: 80},
+			},
+		}))
+		if runtime.GOOS != "linux" {
+			_, err = stream.Recv()
+			assert.Equal(t, status.Code(err), codes.Unimplemented)
+			return
+		}
+		assert.NilError(t, stream.Send(&executorpb.ExecRequest{Resize: &executorpb.WindowSize{Rows: 30, Cols: 100}}))
+		// Wait for the resize to be handled before the command reads the size.
+		time.Sleep(time.Millisecond * 100)
+		assert.NilError(t, stream.Send(&executorpb.ExecRequest{Stdin: []byte("\n")}))
+		stdout, _, processStatus := readExec(t, stream)
+		assert.Equal(t, processStatus.ExitCode, int32(0))
+		assert.Assert(t, strings.HasSuffix(stdout, "30 100\r\n"), stdout)
+	})
+	t.Run("broken", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := client.Exec(ctx)
+		assert.NilError(t, err)
+		assert.NilError(t, stream.Send(&executorpb.ExecRequest{
+			Start: &executorpb.ExecStart{Commands: []string{"sh", "-c", "echo $$; sleep 30"}},
+		}))
+		response, err := stream.Recv()
+		assert.NilError(t, err)
+		pid, err := strconv.Atoi(strings.TrimSpace(string(response.Output)))
+		assert.NilError(t, err)
+		cancel()
+		_, err = stream.Recv()
+		assert.Equal(t, status.Code(err), codes.Canceled)
+		process, err := os.FindProcess(pid)
+		assert.NilError(t, err)
+		poll.WaitOn(t, func(t poll.LogT) poll.Result {
+			if process.Signal(syscall.Signal(0)) == nil {
+				return poll.Continue("process %d is still running", pid)
+			}
+			return poll.Success()
+		}, poll.WithTimeout(time.Second*10))
+	})
+	t.Run("no_command", func(t *testing.T) {
+		stream, err := client.Exec(context.Background())
+		assert.NilError(t, err)
+		assert.NilError(t, stream.Send(&executorpb.ExecRequest{Start: &executorpb.ExecStart{}}))
+		_, err = stream.Recv()
+		assert.Equal(t, status.Code(err), codes.InvalidArgument)
+	})
+}
@@ -0,0 +1,474 @@
+package handler
+
+import (
+	"archive/tar"
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/app/executor/executorpb"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fileChunkSize is the max size of data in a message of file transfer.
+const fileChunkSize = 32 * 1024
+
+func (h *Handler) GetFiles(request *executorpb.GetFilesRequest, server executorpb.Executor_GetFilesServer) error {
+	dir, err := resolveDir(request.Dir)
+	if err != nil {
+		return err
+	}
+	matches, err := globFiles(dir, request.Patterns)
+	if err != nil {
+		return err
+	}
+	if len(matches) == 0 {
+		return errors.Errorf("no file matches patterns %v in '%s'", request.Patterns, dir)
+	}
+	writer := bufio.NewWriterSize(&chunkWriter{send: func(data []byte) error {
+		return server.Send(&executorpb.FileChunk{Data: data})
+	}}, fileChunkSize)
+	tw := tar.NewWriter(writer)
+	err = walkFiles(dir, matches, func(path string, name string, info fs.FileInfo) error {
+		return writeTarEntry(tw, path, filepath.ToSlash(name), info)
+	})
+	if err != nil {
+		return err
+	}
+	if err = tw.Close(); err != nil {
+		return errors.WithMessage(err, "failed to close tar writer")
+	}
+	if err = writer.Flush(); err != nil {
+		return errors.WithMessage(err, "failed to send files")
+	}
+	return nil
+}
+
+func (h *Handler) HashFiles(ctx context.Context, request *executorpb.HashFilesRequest) (*executorpb.HashFilesResponse, error) {
+	dir, err := resolveDir(request.Dir)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := globFiles(dir, request.Patterns)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make(map[string]string)
+	err = walkFiles(dir, matches, func(path string, name string, info fs.FileInfo) error {
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		hash, err := hashFile(path)
+		if err != nil {
+			return err
+		}
+		hashes[filepath.ToSlash(name)] = hash
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(hashes) == 0 {
+		return &executorpb.HashFilesResponse{}, nil
+	}
+	// The hash covers names of files, so renaming a file changes the hash.
+	names := lo.Keys(hashes)
+	sort.Strings(names)
+	h256 := sha256.New()
+	for _, name := range names {
+		_, _ = fmt.Fprintf(h256, "%s\x00%s\n", name, hashes[name])
+	}
+	return &executorpb.HashFilesResponse{Hash: hex.EncodeToString(h256.Sum(nil))}, nil
+}
+
+func hashFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", errors.WithMessagef(err, "failed to open '%s'", path)
+	}
+	defer file.Close()
+	h := sha256.New()
+	if _, err = io.Copy(h, file); err != nil {
+		return "", errors.WithMessagef(err, "failed to read '%s'", path)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// globFiles returns files in dir matched by patterns. Files out of dir are rejected.
+func globFiles(dir string, patterns []string) ([]string, error) {
+	realDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to resolve '%s'", dir)
+	}
+	var matches []string
+	for _, pattern := range patterns {
+		m, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, errors.WithMessagef(err, "invalid pattern '%s'", pattern)
+		}
+		matches = append(matches, m...)
+	}
+	for _, match := range matches {
+		if !withinDir(dir, match) {
+			return nil, errors.Errorf("'%s' is out of '%s'", match, dir)
+		}
+		// Symlinks in the path of match are followed by glob, make sure they don't lead out of the directory.
+		parent, err := filepath.EvalSymlinks(filepath.Dir(match))
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to resolve '%s'", filepath.Dir(match))
+		}
+		if !withinDir(realDir, parent) {
+			return nil, errors.Errorf("'%s' is out of '%s'", match, dir)
+		}
+	}
+	return matches, nil
+}
+
+// walkFiles walks matches without following symlinks and calls fn with the name relative to dir.
+// A file may be matched by multiple patterns, fn is only called once for it.
+func walkFiles(dir string, matches []string, fn func(path string, name string, info fs.FileInfo) error) error {
+	walked := make(map[string]bool)
+	for _, match := range matches {
+		err := filepath.Walk(match, func(path string, info fs.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			name, err := filepath.Rel(dir, path)
+			if err != nil {
+				return errors.WithMessagef(err, "failed to get relative path of '%s'", path)
+			}
+			if walked[name] {
+				return nil
+			}
+			walked[name] = true
+			return fn(path, name, info)
+		})
+		if err != nil {
+			return errors.WithMessagef(err, "failed to walk '%s'", match)
+		}
+	}
+	return nil
+}
+
+func (h *Handler) PutFiles(server executorpb.Executor_PutFilesServer) error {
+	first, err := server.Recv()
+	if err != nil {
+		return errors.WithMessage(err, "failed to receive the first message")
+	}
+	dir, err := resolveDir(first.Dir)
+	if err != nil {
+		return err
+	}
+	owner, err := fileOwner(first.Username)
+	if err != nil {
+		return err
+	}
+	if err = mkdirAll2(dir, 0o755, owner); err != nil {
+		return errors.WithMessagef(err, "failed to create directory '%s'", dir)
+	}
+	realDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return errors.WithMessagef(err, "failed to resolve '%s'", dir)
+	}
+	reader := &putFilesReader{server: server, buf: first.Data}
+	if err = extractTar(tar.NewReader(reader), realDir, owner); err != nil {
+		return err
+	}
+	// Drain the padding after the end of archive.
+	if _, err = io.Copy(io.Discard, reader); err != nil {
+		return errors.WithMessage(err, "failed to receive files")
+	}
+	return server.SendAndClose(&executorpb.PutFilesResponse{})
+}
+
+// resolveDir returns the absolute path of dir. An empty dir means the current working directory.
+func resolveDir(dir string) (string, error) {
+	if dir == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return "", errors.WithMessage(err, "failed to get working directory")
+		}
+		return wd, nil
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", errors.WithMessagef(err, "failed to get absolute path of '%s'", dir)
+	}
+	return abs, nil
+}
+
+// withinDir reports whether path is dir or in dir lexically.
+func withinDir(dir string, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// evalExistingSymlinks is like filepath.EvalSymlinks, but allows the trailing elements of path not to exist.
+// The longest existing prefix of path is resolved and the rest is appended to it.
+func evalExistingSymlinks(path string) (string, error) {
+	var rest []string
+	for {
+		_, err := os.Lstat(path)
+		if err == nil {
+			break
+		}
+		if !os.IsNotExist(err) {
+			return "", errors.WithMessagef(err, "failed to stat '%s'", path)
+		}
+		parent := filepath.Dir(path)
+		if parent == path {
+			break
+		}
+		rest = append(rest, filepath.Base(path))
+		path = parent
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", errors.WithMessagef(err, "failed to resolve '%s'", path)
+	}
+	for i := len(rest) - 1; i >= 0; i-- {
+		resolved = filepath.Join(resolved, rest[i])
+	}
+	return resolved, nil
+}
+
+func writeTarEntry(tw *tar.Writer, path string, name string, info fs.FileInfo) error {
+	var link string
+	if info.Mode()&fs.ModeSymlink != 0 {
+		var err error
+		link, err = os.Readlink(path)
+		if err != nil {
+			return errors.WithMessagef(err, "failed to read link '%s'", path)
+		}
+	}
+	header, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return errors.WithMessagef(err, "failed to create tar header for '%s'", path)
+	}
+	header.Name = name
+	if info.IsDir() {
+		header.Name += "/"
+	}
+	if err = tw.WriteHeader(header); err != nil {
+		return errors.WithMessagef(err, "failed to write tar header for '%s'", path)
+	}
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.WithMessagef(err, "failed to open '%s'", path)
+	}
+	defer file.Close()
+	if _, err = io.Copy(tw, file); err != nil {
+		return errors.WithMessagef(err, "failed to archive '%s'", path)
+	}
+	return nil
+}
+
+// extractTar extracts the archive into dir, dir must be resolved already. Entries with absolute paths, out of dir or
+// written through symlinks leading out of dir are rejected. Extracted files are owned by owner if it's not nil.
+func extractTar(tr *tar.Reader, dir string, owner *User) error {
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.WithMessage(err, "failed to read tar header")
+		}
+		if strings.HasPrefix(header.Name, "/") || filepath.IsAbs(filepath.FromSlash(header.Name)) {
+			return errors.Errorf("'%s' is an absolute path", header.Name)
+		}
+		target := filepath.Join(dir, filepath.FromSlash(header.Name))
+		if !withinDir(dir, target) {
+			return errors.Errorf("'%s' is out of '%s'", header.Name, dir)
+		}
+		// Symlinks extracted before or existing in the directory may lead out of the directory.
+		parent, err := evalExistingSymlinks(filepath.Dir(target))
+		if err != nil {
+			return err
+		}
+		if !withinDir(dir, parent) {
+			return errors.Errorf("'%s' is out of '%s'", header.Name, dir)
+		}
+		target = filepath.Join(parent, filepath.Base(target))
+		mode := header.FileInfo().Mode().Perm()
+		switch header.Typeflag {
+		case tar.TypeDir:
+			resolved, err := evalExistingSymlinks(target)
+			if err != nil {
+				return err
+			}
+			if !withinDir(dir, resolved) {
+				return errors.Errorf("'%s' is out of '%s'", header.Name, dir)
+			}
+			if err = mkdirAll2(target, mode, owner); err != nil {
+				return errors.WithMessagef(err, "failed to create directory '%s'", target)
+			}
+		case tar.TypeReg:
+			if err = writeFile(target, mode, tr, owner); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err = mkdirAll2(parent, 0o755, owner); err != nil {
+				return errors.WithMessagef(err, "failed to create directory '%s'", parent)
+			}
+			_ = os.Remove(target)
+			if err = os.Symlink(header.Linkname, target); err != nil {
+				return errors.WithMessagef(err, "failed to create symlink '%s'", target)
+			}
+			if err = setFileOwner(target, owner); err != nil {
+				return err
+			}
+		default:
+			// Other types (device, fifo, etc.) are not supported, skip them.
+		}
+	}
+}
+
+func writeFile(path string, mode os.FileMode, r io.Reader, owner *User) error {
+	if err := mkdirAll2(filepath.Dir(path), 0o755, owner); err != nil {
+		return errors.WithMessagef(err, "failed to create directory '%s'", filepath.Dir(path))
+	}
+	// Don't write through an existing symlink, it may lead out of the directory.
+	if info, err := os.Lstat(path); err == nil && info.Mode()&fs.ModeSymlink != 0 {
+		if err = os.Remove(path); err != nil {
+			return errors.WithMessagef(err, "failed to remove symlink '%s'", path)
+		}
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return errors.WithMessagef(err, "failed to create file '%s'", path)
+	}
+	defer file.Close()
+	if _, err = io.Copy(file, r); err != nil {
+		return errors.WithMessagef(err, "failed to write file '%s'", path)
+	}
+	return setFileOwner(path, owner)
+}
+
+func (h *Handler) Stat(ctx context.Context, request *executorpb.StatRequest) (*executorpb.StatResponse, error) {
+	if request.Path == "" {
+		return nil, errors.New("path is required")
+	}
+	info, err := os.Lstat(request.Path)
+	if err != nil {
+		return nil, fileStatusError(err, request.Path, "failed to stat '%s'")
+	}
+	file, err := packFileInfo(request.Path, info)
+	if err != nil {
+		return nil, err
+	}
+	return &executorpb.StatResponse{File: file}, nil
+}
+
+func (h *Handler) ListDir(ctx context.Context, request *executorpb.ListDirRequest) (*executorpb.ListDirResponse, error) {
+	if request.Path == "" {
+		return nil, errors.New("path is required")
+	}
+	info, err := os.Stat(request.Path)
+	if err != nil {
+		return nil, fileStatusError(err, request.Path, "failed to stat '%s'")
+	}
+	if !info.IsDir() {
+		return nil, status.Errorf(codes.FailedPrecondition, "'%s' is not a directory", request.Path)
+	}
+	entries, err := os.ReadDir(request.Path)
+	if err != nil {
+		return nil, fileStatusError(err, request.Path, "failed to read directory '%s'")
+	}
+	files := make([]*executorpb.FileInfo, 0, len(entries))
+	// Entries returned by os.ReadDir are sorted by name.
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				// The file is removed after the directory is read.
+				continue
+			}
+			return nil, errors.WithMessagef(err, "failed to stat '%s'", entry.Name())
+		}
+		file, err := packFileInfo(filepath.Join(request.Path, entry.Name()), info)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return &executorpb.ListDirResponse{Files: files}, nil
+}
+
+// fileStatusError converts the error of accessing path to a gRPC status error, so the client can tell a missing file
+// from an inaccessible one. Other errors are wrapped with the message.
+func fileStatusError(err error, path string, format string) error {
+	switch {
+	case os.IsNotExist(err):
+		return status.Errorf(codes.NotFound, "'%s' is not found", path)
+	case os.IsPermission(err):
+		return status.Errorf(codes.PermissionDenied, "permission denied to access '%s'", path)
+	default:
+		return errors.WithMessagef(err, format, path)
+	}
+}
+
+func packFileInfo(path string, info fs.FileInfo) (*executorpb.FileInfo, error) {
+	file := &executorpb.FileInfo{
+		Name:    info.Name(),
+		Size:    info.Size(),
+		Mode:    uint32(info.Mode()),
+		ModTime: info.ModTime().UnixNano(),
+		IsDir:   info.IsDir(),
+	}
+	if info.Mode()&fs.ModeSymlink != 0 {
+		link, err := os.Readlink(path)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to read link '%s'", path)
+		}
+		file.LinkTarget = link
+	}
+	return file, nil
+}
+
+// chunkWriter splits written data into chunks no larger than fileChunkSize and sends them.
+type chunkWriter struct {
+	send func(data []byte) error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	for i := 0; i < len(p); i += fileChunkSize {
+		if err := w.send(p[i:min(i+fileChunkSize, len(p))]); err != nil {
+			return i, err
+		}
+	}
+	return len(p), nil
+}
+
+// putFilesReader reads the data of PutFilesRequest stream.
+type putFilesReader struct {
+	server executorpb.Executor_PutFilesServer
+	buf    []byte
+}
+
+func (r *putFilesReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		request, err := r.server.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = request.Data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
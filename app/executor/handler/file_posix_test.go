@@ -0,0 +1,67 @@
+//go:build linux || darwin
+
+package handler
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/cox96de/runner/app/executor/executorpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+)
+
+func TestHandler_PutFiles_Owner(t *testing.T) {
+	_, addr := setupHandler(t)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NilError(t, err)
+	client := executorpb.NewExecutorClient(conn)
+	t.Run("owner", func(t *testing.T) {
+		if os.Geteuid() != 0 {
+			t.Skip("only run as root")
+		}
+		nobody, err := lookupUser("nobody")
+		if err != nil {
+			t.Skip("user nobody is not found")
+		}
+		stream, err := client.PutFiles(context.Background())
+		assert.NilError(t, err)
+		dst := fs.NewDir(t, "dst")
+		target := filepath.Join(dst.Path(), "restore")
+		assert.NilError(t, stream.Send(&executorpb.PutFilesRequest{Dir: target, Username: "nobody"}))
+		assert.NilError(t, stream.Send(&executorpb.PutFilesRequest{
+			Data: archive(t, map[string]string{"sub/a.txt": "a"}),
+		}))
+		_, err = stream.CloseAndRecv()
+		assert.NilError(t, err)
+		for _, name := range []string{"", "sub", "sub/a.txt"} {
+			info, err := os.Stat(filepath.Join(target, name))
+			assert.NilError(t, err)
+			stat := info.Sys().(*syscall.Stat_t)
+			assert.Equal(t, int(stat.Uid), nobody.PosixUid, name)
+		}
+	})
+}
+
+func TestHandler_ListDir_PermissionDenied(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("root is not denied")
+	}
+	_, addr := setupHandler(t)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NilError(t, err)
+	client := executorpb.NewExecutorClient(conn)
+	dir := fs.NewDir(t, "dir", fs.WithDir("private", fs.WithMode(0o000)))
+	defer os.Chmod(filepath.Join(dir.Path(), "private"), 0o755)
+	_, err = client.ListDir(context.Background(), &executorpb.ListDirRequest{
+		Path: filepath.Join(dir.Path(), "private"),
+	})
+	assert.Equal(t, status.Code(err), codes.PermissionDenied)
+}
@@ -0,0 +1,285 @@
+package handler
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/cox96de/runner/app/executor/executorpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+)
+
+func getFiles(t *testing.T, client executorpb.ExecutorClient, request *executorpb.GetFilesRequest) ([]byte, error) {
+	stream, err := client.GetFiles(context.Background(), request)
+	assert.NilError(t, err)
+	buf := &bytes.Buffer{}
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return buf.Bytes(), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(chunk.Data)
+	}
+}
+
+func putFiles(t *testing.T, client executorpb.ExecutorClient, dir string, data []byte) error {
+	stream, err := client.PutFiles(context.Background())
+	assert.NilError(t, err)
+	assert.NilError(t, stream.Send(&executorpb.PutFilesRequest{Dir: dir}))
+	for i := 0; i < len(data); i += 100 {
+		assert.NilError(t, stream.Send(&executorpb.PutFilesRequest{Data: data[i:min(i+100, len(data))]}))
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+func TestHandler_GetFiles(t *testing.T) {
+	_, addr := setupHandler(t)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NilError(t, err)
+	client := executorpb.NewExecutorClient(conn)
+	src := fs.NewDir(t, "src",
+		fs.WithFile("a.txt", "a"),
+		fs.WithFile("b.log", "b"),
+		fs.WithDir("dist", fs.WithFile("app", "binary", fs.WithMode(0o755)),
+			fs.WithDir("lib", fs.WithFile("c.txt", "c"))),
+	)
+	t.Run("round_trip", func(t *testing.T) {
+		data, err := getFiles(t, client, &executorpb.GetFilesRequest{
+			Dir:      src.Path(),
+			Patterns: []string{"*.txt", "dist", "dist/lib"},
+		})
+		assert.NilError(t, err)
+		dst := fs.NewDir(t, "dst")
+		target := filepath.Join(dst.Path(), "restore")
+		assert.NilError(t, putFiles(t, client, target, data))
+		for name, content := range map[string]string{
+			"a.txt":          "a",
+			"dist/app":       "binary",
+			"dist/lib/c.txt": "c",
+		} {
+			bs, err := os.ReadFile(filepath.Join(target, name))
+			assert.NilError(t, err)
+			assert.Equal(t, string(bs), content)
+		}
+		_, err = os.Stat(filepath.Join(target, "b.log"))
+		assert.Assert(t, os.IsNotExist(err))
+		stat, err := os.Stat(filepath.Join(target, "dist/app"))
+		assert.NilError(t, err)
+		assert.Equal(t, stat.Mode().Perm(), os.FileMode(0o755))
+	})
+	t.Run("no_match", func(t *testing.T) {
+		_, err := getFiles(t, client, &executorpb.GetFilesRequest{
+			Dir:      src.Path(),
+			Patterns: []string{"*.bin"},
+		})
+		assert.ErrorContains(t, err, "no file matches")
+	})
+	t.Run("out_of_dir", func(t *testing.T) {
+		_, err := getFiles(t, client, &executorpb.GetFilesRequest{
+			Dir:      filepath.Join(src.Path(), "dist"),
+			Patterns: []string{"../*.txt"},
+		})
+		assert.ErrorContains(t, err, "is out of")
+	})
+	t.Run("symlink_out_of_dir", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip on windows")
+		}
+		outside := fs.NewDir(t, "outside", fs.WithFile("secret.txt", "secret"))
+		dir := fs.NewDir(t, "dir")
+		assert.NilError(t, os.Symlink(outside.Path(), filepath.Join(dir.Path(), "link")))
+		_, err := getFiles(t, client, &executorpb.GetFilesRequest{
+			Dir:      dir.Path(),
+			Patterns: []string{"link/*.txt"},
+		})
+		assert.ErrorContains(t, err, "is out of")
+	})
+}
+
+func TestHandler_PutFiles(t *testing.T) {
+	_, addr := setupHandler(t)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NilError(t, err)
+	client := executorpb.NewExecutorClient(conn)
+	t.Run("path_traversal", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		tw := tar.NewWriter(buf)
+		assert.NilError(t, tw.WriteHeader(&tar.Header{Name: "../evil.txt", Mode: 0o644, Size: 4,
+			Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte("evil"))
+		assert.NilError(t, err)
+		assert.NilError(t, tw.Close())
+		dst := fs.NewDir(t, "dst")
+		target := filepath.Join(dst.Path(), "restore")
+		err = putFiles(t, client, target, buf.Bytes())
+		assert.ErrorContains(t, err, "is out of")
+		_, err = os.Stat(filepath.Join(dst.Path(), "evil.txt"))
+		assert.Assert(t, os.IsNotExist(err))
+	})
+	t.Run("absolute_path", func(t *testing.T) {
+		dst := fs.NewDir(t, "dst")
+		err := putFiles(t, client, dst.Path(), archive(t, map[string]string{"/evil.txt": "evil"}))
+		assert.ErrorContains(t, err, "is an absolute path")
+	})
+	t.Run("symlink_traversal", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip on windows")
+		}
+		outside := fs.NewDir(t, "outside")
+		buf := &bytes.Buffer{}
+		tw := tar.NewWriter(buf)
+		assert.NilError(t, tw.WriteHeader(&tar.Header{Name: "link", Linkname: outside.Path(),
+			Typeflag: tar.TypeSymlink}))
+		assert.NilError(t, tw.WriteHeader(&tar.Header{Name: "link/evil.txt", Mode: 0o644, Size: 4,
+			Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte("evil"))
+		assert.NilError(t, err)
+		assert.NilError(t, tw.Close())
+		dst := fs.NewDir(t, "dst")
+		err = putFiles(t, client, dst.Path(), buf.Bytes())
+		assert.ErrorContains(t, err, "is out of")
+		_, err = os.Stat(filepath.Join(outside.Path(), "evil.txt"))
+		assert.Assert(t, os.IsNotExist(err))
+	})
+	t.Run("overwrite_symlink", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip on windows")
+		}
+		outside := fs.NewDir(t, "outside", fs.WithFile("secret.txt", "secret"))
+		dst := fs.NewDir(t, "dst")
+		assert.NilError(t, os.Symlink(filepath.Join(outside.Path(), "secret.txt"), filepath.Join(dst.Path(), "a.txt")))
+		err := putFiles(t, client, dst.Path(), archive(t, map[string]string{"a.txt": "a"}))
+		assert.NilError(t, err)
+		bs, err := os.ReadFile(filepath.Join(outside.Path(), "secret.txt"))
+		assert.NilError(t, err)
+		assert.Equal(t, string(bs), "secret")
+		bs, err = os.ReadFile(filepath.Join(dst.Path(), "a.txt"))
+		assert.NilError(t, err)
+		assert.Equal(t, string(bs), "a")
+	})
+}
+
+func TestHandler_Stat(t *testing.T) {
+	_, addr := setupHandler(t)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NilError(t, err)
+	client := executorpb.NewExecutorClient(conn)
+	dir := fs.NewDir(t, "dir", fs.WithFile("a.txt", "abc", fs.WithMode(0o640)), fs.WithDir("sub"))
+	t.Run("file", func(t *testing.T) {
+		response, err := client.Stat(context.Background(), &executorpb.StatRequest{
+			Path: filepath.Join(dir.Path(), "a.txt"),
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, response.File.Name, "a.txt")
+		assert.Equal(t, response.File.Size, int64(3))
+		assert.Equal(t, response.File.IsDir, false)
+		if runtime.GOOS != "windows" {
+			assert.Equal(t, os.FileMode(response.File.Mode), os.FileMode(0o640))
+		}
+	})
+	t.Run("dir", func(t *testing.T) {
+		response, err := client.Stat(context.Background(), &executorpb.StatRequest{
+			Path: filepath.Join(dir.Path(), "sub"),
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, response.File.IsDir, true)
+	})
+	t.Run("not_found", func(t *testing.T) {
+		_, err := client.Stat(context.Background(), &executorpb.StatRequest{
+			Path: filepath.Join(dir.Path(), "not_found"),
+		})
+		assert.Equal(t, status.Code(err), codes.NotFound)
+	})
+}
+
+func TestHandler_ListDir(t *testing.T) {
+	_, addr := setupHandler(t)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NilError(t, err)
+	client := executorpb.NewExecutorClient(conn)
+	dir := fs.NewDir(t, "dir", fs.WithFile("b.txt", "b"), fs.WithFile("a.txt", "a"), fs.WithDir("sub"))
+	t.Run("list", func(t *testing.T) {
+		response, err := client.ListDir(context.Background(), &executorpb.ListDirRequest{Path: dir.Path()})
+		assert.NilError(t, err)
+		names := make([]string, 0, len(response.Files))
+		for _, file := range response.Files {
+			names = append(names, file.Name)
+		}
+		assert.DeepEqual(t, names, []string{"a.txt", "b.txt", "sub"})
+		assert.Equal(t, response.Files[2].IsDir, true)
+	})
+	t.Run("symlink", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("skip on windows")
+		}
+		dir := fs.NewDir(t, "dir")
+		assert.NilError(t, os.Symlink("target", filepath.Join(dir.Path(), "link")))
+		response, err := client.ListDir(context.Background(), &executorpb.ListDirRequest{Path: dir.Path()})
+		assert.NilError(t, err)
+		assert.Equal(t, len(response.Files), 1)
+		assert.Equal(t, response.Files[0].LinkTarget, "target")
+	})
+	t.Run("not_found", func(t *testing.T) {
+		_, err := client.ListDir(context.Background(), &executorpb.ListDirRequest{
+			Path: filepath.Join(dir.Path(), "not_found"),
+		})
+		assert.Equal(t, status.Code(err), codes.NotFound)
+	})
+	t.Run("not_dir", func(t *testing.T) {
+		_, err := client.ListDir(context.Background(), &executorpb.ListDirRequest{
+			Path: filepath.Join(dir.Path(), "a.txt"),
+		})
+		assert.Equal(t, status.Code(err), codes.FailedPrecondition)
+	})
+}
+
+func TestHandler_HashFiles(t *testing.T) {
+	_, addr := setupHandler(t)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NilError(t, err)
+	client := executorpb.NewExecutorClient(conn)
+	dir := fs.NewDir(t, "dir", fs.WithFile("go.sum", "a"), fs.WithDir("sub", fs.WithFile("go.sum", "b")))
+	hash := func(patterns ...string) string {
+		response, err := client.HashFiles(context.Background(), &executorpb.HashFilesRequest{
+			Dir:      dir.Path(),
+			Patterns: patterns,
+		})
+		assert.NilError(t, err)
+		return response.Hash
+	}
+	first := hash("go.sum", "sub/go.sum")
+	assert.Assert(t, first != "")
+	assert.Equal(t, hash("sub/go.sum", "go.sum", "go.sum"), first)
+	assert.Assert(t, hash("go.sum") != first)
+	assert.Equal(t, hash("not_found"), "")
+	assert.NilError(t, os.WriteFile(filepath.Join(dir.Path(), "go.sum"), []byte("c"), 0o644))
+	assert.Assert(t, hash("go.sum", "sub/go.sum") != first)
+}
+
+// archive creates a tar archive contains regular files.
+func archive(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	for name, content := range files {
+		assert.NilError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)),
+			Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		assert.NilError(t, err)
+	}
+	assert.NilError(t, tw.Close())
+	return buf.Bytes()
+}
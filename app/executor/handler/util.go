@@ -28,6 +28,10 @@ func lookupUser(username string) (*User, error) {
 	if err != nil {
 		return nil, errors.WithMessage(err, "failed to lookup user")
 	}
+	return newUser(u)
+}
+
+func newUser(u *user.User) (*User, error) {
 	uid, err := strconv.Atoi(u.Uid)
 	if err != nil {
 		return nil, errors.WithMessage(err, "failed to parse uid")
@@ -43,6 +47,30 @@ func lookupUser(username string) (*User, error) {
 	}, nil
 }
 
+// fileOwner returns the owner of files created for username, it follows the same rule as the user of commands.
+// It returns nil if files don't need to be chowned.
+func fileOwner(username string) (*User, error) {
+	if runtime.GOOS == "windows" {
+		return nil, nil
+	}
+	u, switched, err := resolveUser(username)
+	if err != nil || !switched {
+		return nil, err
+	}
+	return newUser(u)
+}
+
+// setFileOwner changes the owner of path to u, symlinks are not followed. It does nothing if u is nil.
+func setFileOwner(path string, u *User) error {
+	if u == nil {
+		return nil
+	}
+	if err := os.Lchown(path, u.PosixUid, u.PosixGid); err != nil {
+		return errors.WithMessagef(err, "failed to chown '%s'", path)
+	}
+	return nil
+}
+
 func mkdirAll(path string, perm os.FileMode, username string) error {
 	// Fast path: if we can tell whether path is a directory or file, stop with success or error.
 	dir, err := os.Stat(path)
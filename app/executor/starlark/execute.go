@@ -2,6 +2,7 @@ package starlark
 
 import (
 	"io"
+	"syscall"
 
 	"github.com/cox96de/runner/log"
 	"github.com/samber/lo"
@@ -26,6 +27,9 @@ type Command struct {
 	script    string
 	runningCh chan error
 	waitError error
+	thread    *starlark.Thread
+	// done is closed when the script exits.
+	done chan struct{}
 }
 
 func (s *Command) Read(buf []byte) (int, error) {
@@ -51,11 +55,12 @@ func NewCommand(script string, stdout io.ReadWriteCloser, stderr io.ReadWriteClo
 		},
 		logWriter: stdout,
 		runningCh: make(chan error),
+		done:      make(chan struct{}),
 		script:    script,
 	}, nil
 }
 
-func (s *Command) run() error {
+func (s *Command) newThread() *starlark.Thread {
 	thread := &starlark.Thread{
 		Print: func(_ *starlark.Thread, msg string) {
 			_, err := s.context.stdout.Write([]byte(msg))
@@ -71,6 +76,11 @@ func (s *Command) run() error {
 		env:      s.context.env,
 		username: s.context.username,
 	})
+	return thread
+}
+
+func (s *Command) run() error {
+	thread := s.thread
 	functions, err := NewFunctions(thread)
 	if err != nil {
 		return err
@@ -87,8 +97,10 @@ func (s *Command) run() error {
 }
 
 func (s *Command) Start() error {
+	s.thread = s.newThread()
 	go func() {
 		s.waitError = s.run()
+		close(s.done)
 		if s.waitError != nil {
 			s.runningCh <- s.waitError
 		}
@@ -101,3 +113,13 @@ func (s *Command) Start() error {
 func (s *Command) Wait() <-chan error {
 	return s.runningCh
 }
+
+func (s *Command) Done() <-chan struct{} {
+	return s.done
+}
+
+// Signal cancels the script with any signal. Commands started by the script are not signaled.
+func (s *Command) Signal(sig syscall.Signal) error {
+	s.thread.Cancel("received signal: " + sig.String())
+	return nil
+}
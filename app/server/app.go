@@ -2,14 +2,20 @@ package server
 
 import (
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/cox96de/runner/app/server/artifact"
+	"github.com/cox96de/runner/app/server/cache"
 	"github.com/cox96de/runner/app/server/dispatch"
 	"github.com/cox96de/runner/app/server/eventhook"
 	"github.com/cox96de/runner/app/server/handler"
 	"github.com/cox96de/runner/app/server/logstorage"
 	"github.com/cox96de/runner/app/server/monitor"
+	"github.com/cox96de/runner/app/server/notify"
 	"github.com/cox96de/runner/app/server/pipeline"
+	"github.com/cox96de/runner/app/server/schedule"
+	"github.com/cox96de/runner/app/server/secret"
 	"github.com/cox96de/runner/db"
 	"github.com/cox96de/runner/external/redis"
+	"github.com/cox96de/runner/external/s3"
 	"github.com/cox96de/runner/lib"
 	goredis "github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
@@ -17,13 +23,17 @@ import (
 
 type (
 	LogPersistentStorage logstorage.OSS
+	CacheStorage         cache.Storage
 	Locker               lib.Locker
 	EventHookSender      eventhook.Sender
+	Notifier             notify.Notifier
 )
 
 type App struct {
 	*handler.Handler
 	*monitor.Service
+	// Scheduler triggers schedules, it should be called periodically.
+	Scheduler *schedule.Service
 }
 
 type Config struct {
@@ -32,19 +42,38 @@ type Config struct {
 	LogCacheStorage      *goredis.Client
 	Locker               Locker
 	EventHookSender      EventHookSender
+	CacheStorage         CacheStorage
+	// CacheMaxSize is the default max total size of caches of a label in bytes. Zero means no limit.
+	CacheMaxSize int64
+	// Notifier wakes up agents waiting for jobs when jobs are queued. It must be shared by all replicas of the
+	// server, a local notifier is used if it's not set.
+	Notifier Notifier
+	// SecretCipher encrypts secrets, secrets are disabled if it's not set.
+	SecretCipher *secret.Cipher
 }
 
 func NewApp(c *Config) *App {
 	logStorage := composeLogStorage(c.LogCacheStorage, c.LogPersistentStorage)
 	dbClient := db.NewClient(c.DB)
 	eventhookService := eventhook.NewService(c.EventHookSender)
-	dispatchService := dispatch.NewService(dbClient, eventhookService)
-	h := handler.NewHandler(dbClient, pipeline.NewService(dbClient), dispatchService, c.Locker, logStorage,
-		eventhookService)
+	notifier := c.Notifier
+	if notifier == nil {
+		notifier = NewLocalNotifier()
+	}
+	dispatchService := dispatch.NewService(dbClient, eventhookService, notifier)
+	// Artifacts are stored in the same object storage as archived logs.
+	artifactService := artifact.NewService(dbClient, c.LogPersistentStorage)
+	cacheService := cache.NewService(dbClient, c.CacheStorage, c.CacheMaxSize)
+	secretService := secret.NewService(dbClient, c.SecretCipher)
+	pipelineService := pipeline.NewService(dbClient)
+	scheduleService := schedule.NewService(dbClient, pipelineService, dispatchService, c.Locker)
+	h := handler.NewHandler(dbClient, pipelineService, dispatchService, c.Locker, logStorage,
+		eventhookService, artifactService, cacheService, secretService, scheduleService)
 	monitorService := monitor.NewService(dbClient, logStorage, eventhookService, dispatchService)
 	return &App{
-		Handler: h,
-		Service: monitorService,
+		Handler:   h,
+		Service:   monitorService,
+		Scheduler: scheduleService,
 	}
 }
 
@@ -64,3 +93,21 @@ func NewLocalLogPersistentStorage(dir string) LogPersistentStorage {
 func NewS3LogPersistentStorage(bucket string, s3 s3iface.S3API) LogPersistentStorage {
 	return logstorage.NewS3(bucket, s3)
 }
+
+func NewLocalCacheStorage(dir string) CacheStorage {
+	return cache.NewFilesystemStorage(dir)
+}
+
+func NewS3CacheStorage(bucket string, client *s3.Client) CacheStorage {
+	return cache.NewS3Storage(bucket, client)
+}
+
+func NewLocalNotifier() Notifier {
+	return notify.NewLocalNotifier()
+}
+
+// NewRedisNotifier creates a notifier which broadcasts notifications to all replicas of the server by the
+// redis channel.
+func NewRedisNotifier(client *goredis.Client, channel string) Notifier {
+	return notify.NewRedisNotifier(client, channel)
+}
@@ -0,0 +1,147 @@
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/app/server/logstorage"
+	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/util"
+	"github.com/samber/lo"
+)
+
+// ErrExpired is returned when the artifact is expired.
+const ErrExpired = util.StringError("artifact is expired")
+
+// Service stores artifacts in the same object storage as archived logs.
+type Service struct {
+	db  *db.Client
+	oss logstorage.OSS
+	// now returns the current time, it's replaced in tests.
+	now func() time.Time
+}
+
+func NewService(db *db.Client, oss logstorage.OSS) *Service {
+	return &Service{db: db, oss: oss, now: time.Now}
+}
+
+type SaveOption struct {
+	JobExecutionID int64
+	Name           string
+	// RetentionDays is the number of days to keep the artifact. Zero means keep forever.
+	RetentionDays int32
+}
+
+// Save stores the content of r as an artifact of the job execution.
+// The name of artifact must be unique in the job execution.
+func (s *Service) Save(ctx context.Context, opt *SaveOption, r io.Reader) (*db.Artifact, error) {
+	if err := api.ValidateArtifactName(opt.Name); err != nil {
+		return nil, err
+	}
+	if opt.RetentionDays < 0 {
+		return nil, errors.Errorf("invalid retention days %d", opt.RetentionDays)
+	}
+	jobExecution, err := s.db.GetJobExecution(ctx, opt.JobExecutionID)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to get job execution '%d'", opt.JobExecutionID)
+	}
+	job, err := s.db.GetJobByID(ctx, jobExecution.JobID)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to get job '%d'", jobExecution.JobID)
+	}
+	_, err = s.db.GetArtifactByName(ctx, opt.JobExecutionID, opt.Name)
+	if err == nil {
+		return nil, errors.Errorf("artifact '%s' already exists in job execution '%d'", opt.Name,
+			opt.JobExecutionID)
+	}
+	if !db.IsRecordNotFoundError(err) {
+		return nil, errors.WithMessagef(err, "failed to get artifact '%s'", opt.Name)
+	}
+	// The object storage requires a seekable reader, so spool the content to a temporary file.
+	file, err := os.CreateTemp("", "artifact-*")
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to create temporary file")
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+	size, err := io.Copy(file, r)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to receive artifact")
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.WithMessage(err, "failed to seek temporary file")
+	}
+	if err = s.oss.Save(ctx, buildKey(opt.JobExecutionID, opt.Name), file); err != nil {
+		return nil, errors.WithMessage(err, "failed to save artifact")
+	}
+	var expireAt *time.Time
+	if opt.RetentionDays > 0 {
+		expireAt = lo.ToPtr(s.now().Add(time.Duration(opt.RetentionDays) * 24 * time.Hour))
+	}
+	artifact, err := s.db.CreateArtifact(ctx, &db.CreateArtifactOption{
+		PipelineID:     job.PipelineID,
+		JobID:          job.ID,
+		JobExecutionID: jobExecution.ID,
+		Name:           opt.Name,
+		Size:           size,
+		ExpireAt:       expireAt,
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to create artifact")
+	}
+	return artifact, nil
+}
+
+// List returns artifacts of the pipeline which are not expired, sorted by id in ascending order.
+// jobName and name filter artifacts by the job uploads them and the name, empty means no filter.
+func (s *Service) List(ctx context.Context, pipelineID int64, jobName string, name string) ([]*db.Artifact, error) {
+	opt := &db.ListArtifactsOption{
+		PipelineID:    pipelineID,
+		Name:          name,
+		ExpiredBefore: lo.ToPtr(s.now()),
+	}
+	if jobName != "" {
+		jobs, err := s.db.GetJobsByPipelineID(ctx, pipelineID)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to get jobs of pipeline '%d'", pipelineID)
+		}
+		opt.JobIDs = lo.FilterMap(jobs, func(job *db.Job, _ int) (int64, bool) {
+			return job.ID, job.Name == jobName
+		})
+		if len(opt.JobIDs) == 0 {
+			return nil, nil
+		}
+	}
+	artifacts, err := s.db.ListArtifacts(ctx, opt)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to list artifacts")
+	}
+	return artifacts, nil
+}
+
+// Open returns the artifact and its content. The caller should close the content.
+func (s *Service) Open(ctx context.Context, id int64) (*db.Artifact, io.ReadCloser, error) {
+	artifact, err := s.db.GetArtifact(ctx, id)
+	if err != nil {
+		return nil, nil, errors.WithMessagef(err, "failed to get artifact '%d'", id)
+	}
+	if artifact.IsExpired(s.now()) {
+		return nil, nil, ErrExpired
+	}
+	content, err := s.oss.Open(ctx, buildKey(artifact.JobExecutionID, artifact.Name))
+	if err != nil {
+		return nil, nil, errors.WithMessagef(err, "failed to open artifact '%d'", id)
+	}
+	return artifact, content, nil
+}
+
+func buildKey(jobExecutionID int64, name string) string {
+	return fmt.Sprintf("artifacts/%d/%s.tar", jobExecutionID, name)
+}
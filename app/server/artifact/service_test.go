@@ -0,0 +1,92 @@
+package artifact
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/app/server/logstorage"
+	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/mock"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	dbClient := mock.NewMockDB(t)
+	jobs, err := dbClient.CreateJobs(ctx, []*db.CreateJobOption{
+		{PipelineID: 1, Name: "build"},
+		{PipelineID: 1, Name: "test"},
+	})
+	assert.NilError(t, err)
+	executions, err := dbClient.CreateJobExecutions(ctx, []*db.CreateJobExecutionOption{
+		{JobID: jobs[0].ID, Status: api.StatusRunning},
+		{JobID: jobs[1].ID, Status: api.StatusRunning},
+	})
+	assert.NilError(t, err)
+	service := NewService(dbClient, logstorage.NewFilesystemOSS(fs.NewDir(t, "oss").Path()))
+	now := time.Now()
+	service.now = func() time.Time {
+		return now
+	}
+	dist, err := service.Save(ctx, &SaveOption{JobExecutionID: executions[0].ID, Name: "dist"},
+		strings.NewReader("dist content"))
+	assert.NilError(t, err)
+	assert.Equal(t, dist.PipelineID, int64(1))
+	assert.Equal(t, dist.JobID, jobs[0].ID)
+	assert.Equal(t, dist.Size, int64(len("dist content")))
+	assert.Assert(t, dist.ExpireAt == nil)
+	report, err := service.Save(ctx, &SaveOption{JobExecutionID: executions[1].ID, Name: "report", RetentionDays: 1},
+		strings.NewReader("report content"))
+	assert.NilError(t, err)
+	assert.Equal(t, *report.ExpireAt, now.Add(24*time.Hour))
+	t.Run("duplicated", func(t *testing.T) {
+		_, err := service.Save(ctx, &SaveOption{JobExecutionID: executions[0].ID, Name: "dist"},
+			strings.NewReader("dist content"))
+		assert.ErrorContains(t, err, "already exists")
+	})
+	t.Run("invalid_name", func(t *testing.T) {
+		_, err := service.Save(ctx, &SaveOption{JobExecutionID: executions[0].ID, Name: "../dist"},
+			strings.NewReader("dist content"))
+		assert.ErrorContains(t, err, "invalid artifact name")
+	})
+	t.Run("list", func(t *testing.T) {
+		artifacts, err := service.List(ctx, 1, "", "")
+		assert.NilError(t, err)
+		assert.Equal(t, len(artifacts), 2)
+		artifacts, err = service.List(ctx, 1, "build", "")
+		assert.NilError(t, err)
+		assert.Equal(t, len(artifacts), 1)
+		assert.Equal(t, artifacts[0].ID, dist.ID)
+		artifacts, err = service.List(ctx, 1, "deploy", "")
+		assert.NilError(t, err)
+		assert.Equal(t, len(artifacts), 0)
+	})
+	t.Run("open", func(t *testing.T) {
+		_, content, err := service.Open(ctx, report.ID)
+		assert.NilError(t, err)
+		defer content.Close()
+		bs, err := io.ReadAll(content)
+		assert.NilError(t, err)
+		assert.Equal(t, string(bs), "report content")
+	})
+	t.Run("expired", func(t *testing.T) {
+		service.now = func() time.Time {
+			return now.Add(25 * time.Hour)
+		}
+		defer func() {
+			service.now = func() time.Time {
+				return now
+			}
+		}()
+		_, _, err := service.Open(ctx, report.ID)
+		assert.ErrorIs(t, err, ErrExpired)
+		artifacts, err := service.List(ctx, 1, "", "")
+		assert.NilError(t, err)
+		assert.Equal(t, len(artifacts), 1)
+	})
+}
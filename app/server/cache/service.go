@@ -0,0 +1,247 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/log"
+	"github.com/cox96de/runner/util"
+	"github.com/google/uuid"
+)
+
+// ErrExists is returned when a cache with the same key is saved.
+const ErrExists = util.StringError("cache already exists")
+
+// Service manages caches shared by jobs running on the same label. The label of caches is the canonical form of the
+// label expression in runs_on of jobs, so jobs selecting agents by equivalent expressions share caches.
+type Service struct {
+	db      *db.Client
+	storage Storage
+	// defaultMaxSize is the max total size of caches of a label which has no limit set. Zero means no limit.
+	defaultMaxSize int64
+	// now returns the current time, it's replaced in tests.
+	now func() time.Time
+}
+
+func NewService(db *db.Client, storage Storage, defaultMaxSize int64) *Service {
+	return &Service{db: db, storage: storage, defaultMaxSize: defaultMaxSize, now: time.Now}
+}
+
+// Get finds the cache for the job execution. It matches key exactly first, then restoreKeys as prefixes in order.
+// It returns nil if nothing matches.
+func (s *Service) Get(ctx context.Context, jobExecutionID int64, key string, restoreKeys []string) (*db.Cache, error) {
+	label, err := s.getLabel(ctx, jobExecutionID)
+	if err != nil {
+		return nil, err
+	}
+	cache, err := s.db.GetCacheByKey(ctx, label, key)
+	for i := 0; i < len(restoreKeys) && db.IsRecordNotFoundError(err); i++ {
+		cache, err = s.db.GetLatestCacheByKeyPrefix(ctx, label, restoreKeys[i])
+	}
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.WithMessage(err, "failed to get cache")
+	}
+	cache.LastUsedAt = s.now()
+	if err = s.db.UpdateCacheLastUsedAt(ctx, cache.ID, cache.LastUsedAt); err != nil {
+		return nil, errors.WithMessage(err, "failed to update last used time of cache")
+	}
+	return cache, nil
+}
+
+// Save stores the content of r as a cache of the label the job execution runs on. Least recently used caches are
+// evicted if the total size exceeds the limit.
+func (s *Service) Save(ctx context.Context, jobExecutionID int64, key string, r io.Reader) (*db.Cache, error) {
+	if key == "" {
+		return nil, errors.New("key is required")
+	}
+	label, err := s.getLabel(ctx, jobExecutionID)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.db.GetCacheByKey(ctx, label, key)
+	if err == nil {
+		return nil, errors.WithMessagef(ErrExists, "key '%s'", key)
+	}
+	if !db.IsRecordNotFoundError(err) {
+		return nil, errors.WithMessagef(err, "failed to get cache '%s'", key)
+	}
+	// The storage requires a seekable reader, so spool the content to a temporary file.
+	file, err := os.CreateTemp("", "cache-*")
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to create temporary file")
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+	size, err := io.Copy(file, r)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to receive cache")
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.WithMessage(err, "failed to seek temporary file")
+	}
+	// Caches with the same key may be saved by concurrent jobs, so the name of archive is unique.
+	object := fmt.Sprintf("caches/%s.tar", uuid.NewString())
+	if err = s.storage.Save(ctx, object, file); err != nil {
+		return nil, errors.WithMessage(err, "failed to save cache")
+	}
+	cache, err := s.db.CreateCache(ctx, &db.CreateCacheOption{
+		Label:      label,
+		Key:        key,
+		Object:     object,
+		Size:       size,
+		LastUsedAt: s.now(),
+	})
+	if err != nil {
+		_ = s.storage.Delete(ctx, object)
+		return nil, errors.WithMessage(err, "failed to create cache")
+	}
+	if err = s.evict(ctx, label); err != nil {
+		return nil, err
+	}
+	return cache, nil
+}
+
+// List returns caches of the label sorted by id, the total size and the max size of caches of the label.
+func (s *Service) List(ctx context.Context, label string, keyPrefix string) ([]*db.Cache, int64, int64, error) {
+	label, err := normalizeLabel(label)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	caches, err := s.db.ListCaches(ctx, &db.ListCachesOption{Label: label, KeyPrefix: keyPrefix})
+	if err != nil {
+		return nil, 0, 0, errors.WithMessage(err, "failed to list caches")
+	}
+	totalSize, err := s.db.GetCachesSize(ctx, label)
+	if err != nil {
+		return nil, 0, 0, errors.WithMessage(err, "failed to get size of caches")
+	}
+	maxSize, err := s.getMaxSize(ctx, label)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return caches, totalSize, maxSize, nil
+}
+
+// Open returns the cache and its content. The caller should close the content.
+func (s *Service) Open(ctx context.Context, id int64) (*db.Cache, io.ReadCloser, error) {
+	cache, err := s.db.GetCache(ctx, id)
+	if err != nil {
+		return nil, nil, errors.WithMessagef(err, "failed to get cache '%d'", id)
+	}
+	content, err := s.storage.Open(ctx, cache.Object)
+	if err != nil {
+		return nil, nil, errors.WithMessagef(err, "failed to open cache '%d'", id)
+	}
+	return cache, content, nil
+}
+
+// Delete evicts a cache.
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	cache, err := s.db.GetCache(ctx, id)
+	if err != nil {
+		return errors.WithMessagef(err, "failed to get cache '%d'", id)
+	}
+	return s.delete(ctx, cache)
+}
+
+// SetLimit sets the max total size of caches of the label, and evicts caches exceed the limit.
+func (s *Service) SetLimit(ctx context.Context, label string, maxSize int64) error {
+	if maxSize < 0 {
+		return errors.Errorf("invalid max size %d", maxSize)
+	}
+	label, err := normalizeLabel(label)
+	if err != nil {
+		return err
+	}
+	if _, err = s.db.SetCacheLimit(ctx, label, maxSize); err != nil {
+		return errors.WithMessagef(err, "failed to set cache limit of label '%s'", label)
+	}
+	return s.evict(ctx, label)
+}
+
+func (s *Service) getLabel(ctx context.Context, jobExecutionID int64) (string, error) {
+	jobExecution, err := s.db.GetJobExecution(ctx, jobExecutionID)
+	if err != nil {
+		return "", errors.WithMessagef(err, "failed to get job execution '%d'", jobExecutionID)
+	}
+	job, err := s.db.GetJobByID(ctx, jobExecution.JobID)
+	if err != nil {
+		return "", errors.WithMessagef(err, "failed to get job '%d'", jobExecution.JobID)
+	}
+	runsOn, err := db.UnmarshalRunsOn(job.RunsOn)
+	if err != nil {
+		return "", errors.WithMessagef(err, "failed to unmarshal runs_on of job '%d'", job.ID)
+	}
+	return normalizeLabel(runsOn.Label)
+}
+
+// normalizeLabel returns the canonical form of the label expression.
+func normalizeLabel(label string) (string, error) {
+	selector, err := api.ParseLabelSelector(label)
+	if err != nil {
+		return "", err
+	}
+	return selector.String(), nil
+}
+
+func (s *Service) getMaxSize(ctx context.Context, label string) (int64, error) {
+	limit, err := s.db.GetCacheLimit(ctx, label)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			return s.defaultMaxSize, nil
+		}
+		return 0, errors.WithMessagef(err, "failed to get cache limit of label '%s'", label)
+	}
+	return limit.MaxSize, nil
+}
+
+// evict deletes least recently used caches of the label until the total size doesn't exceed the limit.
+func (s *Service) evict(ctx context.Context, label string) error {
+	maxSize, err := s.getMaxSize(ctx, label)
+	if err != nil || maxSize == 0 {
+		return err
+	}
+	totalSize, err := s.db.GetCachesSize(ctx, label)
+	if err != nil {
+		return errors.WithMessage(err, "failed to get size of caches")
+	}
+	if totalSize <= maxSize {
+		return nil
+	}
+	caches, err := s.db.ListCaches(ctx, &db.ListCachesOption{Label: label, OrderByLastUsed: true})
+	if err != nil {
+		return errors.WithMessage(err, "failed to list caches")
+	}
+	for _, cache := range caches {
+		if totalSize <= maxSize {
+			break
+		}
+		log.ExtractLogger(ctx).Infof("evict cache '%s' of label '%s', size: %d bytes", cache.Key, label, cache.Size)
+		if err = s.delete(ctx, cache); err != nil {
+			return err
+		}
+		totalSize -= cache.Size
+	}
+	return nil
+}
+
+func (s *Service) delete(ctx context.Context, cache *db.Cache) error {
+	if err := s.db.DeleteCache(ctx, cache.ID); err != nil {
+		return errors.WithMessagef(err, "failed to delete cache '%d'", cache.ID)
+	}
+	if err := s.storage.Delete(ctx, cache.Object); err != nil {
+		return errors.WithMessagef(err, "failed to delete archive of cache '%d'", cache.ID)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/mock"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	dbClient := mock.NewMockDB(t)
+	jobs, err := dbClient.CreateJobs(ctx, []*db.CreateJobOption{
+		{PipelineID: 1, Name: "build", RunsOn: &api.RunsOn{Label: "linux"}},
+		{PipelineID: 1, Name: "test", RunsOn: &api.RunsOn{Label: "windows"}},
+		{PipelineID: 1, Name: "lint", RunsOn: &api.RunsOn{Label: "linux && !gpu && amd64"}},
+		{PipelineID: 1, Name: "vet", RunsOn: &api.RunsOn{Label: "amd64&&linux&&!gpu"}},
+	})
+	assert.NilError(t, err)
+	executions, err := dbClient.CreateJobExecutions(ctx, []*db.CreateJobExecutionOption{
+		{JobID: jobs[0].ID, Status: api.StatusRunning},
+		{JobID: jobs[1].ID, Status: api.StatusRunning},
+		{JobID: jobs[2].ID, Status: api.StatusRunning},
+		{JobID: jobs[3].ID, Status: api.StatusRunning},
+	})
+	assert.NilError(t, err)
+	storage := NewFilesystemStorage(fs.NewDir(t, "storage").Path())
+	service := NewService(dbClient, storage, 10)
+	now := time.Now()
+	service.now = func() time.Time {
+		return now
+	}
+	linux := executions[0].ID
+	goA, err := service.Save(ctx, linux, "go-a", strings.NewReader("go-a"))
+	assert.NilError(t, err)
+	assert.Equal(t, goA.Label, "linux")
+	assert.Equal(t, goA.Size, int64(4))
+	now = now.Add(time.Minute)
+	goB, err := service.Save(ctx, linux, "go-b", strings.NewReader("go-b"))
+	assert.NilError(t, err)
+	t.Run("duplicated", func(t *testing.T) {
+		_, err := service.Save(ctx, linux, "go-a", strings.NewReader("go-a"))
+		assert.ErrorIs(t, err, ErrExists)
+	})
+	t.Run("get", func(t *testing.T) {
+		cache, err := service.Get(ctx, linux, "go-a", []string{"go-"})
+		assert.NilError(t, err)
+		assert.Equal(t, cache.ID, goA.ID)
+		cache, err = service.Get(ctx, linux, "go-c", []string{"npm-", "go-"})
+		assert.NilError(t, err)
+		assert.Equal(t, cache.ID, goB.ID)
+		cache, err = service.Get(ctx, linux, "go-c", []string{"npm-"})
+		assert.NilError(t, err)
+		assert.Assert(t, cache == nil)
+		// Caches are isolated by label.
+		cache, err = service.Get(ctx, executions[1].ID, "go-a", nil)
+		assert.NilError(t, err)
+		assert.Assert(t, cache == nil)
+	})
+	t.Run("open", func(t *testing.T) {
+		_, content, err := service.Open(ctx, goB.ID)
+		assert.NilError(t, err)
+		defer content.Close()
+		bs, err := io.ReadAll(content)
+		assert.NilError(t, err)
+		assert.Equal(t, string(bs), "go-b")
+	})
+	t.Run("evict", func(t *testing.T) {
+		// go-a is used later than go-b by Get, so go-b is evicted.
+		now = now.Add(time.Minute)
+		_, err := service.Get(ctx, linux, "go-a", nil)
+		assert.NilError(t, err)
+		now = now.Add(time.Minute)
+		npm, err := service.Save(ctx, linux, "npm-a", strings.NewReader("npm-a"))
+		assert.NilError(t, err)
+		caches, totalSize, maxSize, err := service.List(ctx, "linux", "")
+		assert.NilError(t, err)
+		assert.Equal(t, len(caches), 2)
+		assert.Equal(t, caches[0].ID, goA.ID)
+		assert.Equal(t, caches[1].ID, npm.ID)
+		assert.Equal(t, totalSize, int64(9))
+		assert.Equal(t, maxSize, int64(10))
+		_, err = storage.Open(ctx, goB.Object)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+	t.Run("set_limit", func(t *testing.T) {
+		assert.NilError(t, service.SetLimit(ctx, "linux", 5))
+		caches, totalSize, maxSize, err := service.List(ctx, "linux", "")
+		assert.NilError(t, err)
+		assert.Equal(t, len(caches), 1)
+		assert.Equal(t, caches[0].Key, "npm-a")
+		assert.Equal(t, totalSize, int64(5))
+		assert.Equal(t, maxSize, int64(5))
+		assert.ErrorContains(t, service.SetLimit(ctx, "linux", -1), "invalid max size")
+	})
+	t.Run("label_expression", func(t *testing.T) {
+		// Equivalent label expressions share caches.
+		cache, err := service.Save(ctx, executions[2].ID, "lint", strings.NewReader("lint"))
+		assert.NilError(t, err)
+		assert.Equal(t, cache.Label, "amd64 && linux && !gpu")
+		got, err := service.Get(ctx, executions[3].ID, "lint", nil)
+		assert.NilError(t, err)
+		assert.Equal(t, got.ID, cache.ID)
+		assert.NilError(t, service.SetLimit(ctx, "linux&&amd64 && !gpu", 3))
+		caches, _, maxSize, err := service.List(ctx, "!gpu && linux && amd64", "")
+		assert.NilError(t, err)
+		assert.Equal(t, len(caches), 0)
+		assert.Equal(t, maxSize, int64(3))
+		_, _, _, err = service.List(ctx, "linux || amd64", "")
+		assert.ErrorContains(t, err, "invalid label")
+	})
+	t.Run("delete", func(t *testing.T) {
+		caches, _, _, err := service.List(ctx, "linux", "npm-")
+		assert.NilError(t, err)
+		assert.NilError(t, service.Delete(ctx, caches[0].ID))
+		_, _, err = service.Open(ctx, caches[0].ID)
+		assert.Assert(t, db.IsRecordNotFoundError(err))
+	})
+}
@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awss3 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/external/s3"
+	"github.com/cox96de/runner/util"
+)
+
+// ErrNotFound is returned when the archive is not found in the storage.
+const ErrNotFound = util.StringError("not found")
+
+// Storage stores archives of caches.
+type Storage interface {
+	Open(ctx context.Context, name string) (io.ReadCloser, error)
+	Save(ctx context.Context, name string, r io.ReadSeeker) error
+	// Delete deletes the archive, it's not an error if the archive doesn't exist.
+	Delete(ctx context.Context, name string) error
+}
+
+// FilesystemStorage stores archives in a directory.
+type FilesystemStorage struct {
+	baseDir string
+}
+
+func NewFilesystemStorage(baseDir string) *FilesystemStorage {
+	return &FilesystemStorage{baseDir: baseDir}
+}
+
+func (s *FilesystemStorage) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	file, err := os.Open(filepath.Join(s.baseDir, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.WithMessage(err, "failed to open file")
+	}
+	return file, nil
+}
+
+func (s *FilesystemStorage) Save(ctx context.Context, name string, r io.ReadSeeker) error {
+	fp := filepath.Join(s.baseDir, name)
+	if err := os.MkdirAll(filepath.Dir(fp), 0o755); err != nil {
+		return errors.WithMessage(err, "failed to create directory")
+	}
+	file, err := os.Create(fp)
+	if err != nil {
+		return errors.WithMessage(err, "failed to create file")
+	}
+	defer file.Close()
+	_, err = io.Copy(file, r)
+	return err
+}
+
+func (s *FilesystemStorage) Delete(ctx context.Context, name string) error {
+	err := os.Remove(filepath.Join(s.baseDir, name))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.WithMessage(err, "failed to remove file")
+	}
+	return nil
+}
+
+// S3Storage stores archives in a bucket of S3.
+type S3Storage struct {
+	client *s3.Client
+	bucket string
+}
+
+func NewS3Storage(bucket string, client *s3.Client) *S3Storage {
+	return &S3Storage{bucket: bucket, client: client}
+}
+
+func (s *S3Storage) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	output, err := s.client.GetObjectWithContext(ctx, &awss3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(name),
+	})
+	if err != nil {
+		var awsErr interface{ Code() string }
+		if errors.As(err, &awsErr) && awsErr.Code() == awss3.ErrCodeNoSuchKey {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return output.Body, nil
+}
+
+func (s *S3Storage) Save(ctx context.Context, name string, r io.ReadSeeker) error {
+	_, err := s.client.PutObjectWithContext(ctx, &awss3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(name),
+		Body:   r,
+	})
+	return err
+}
+
+func (s *S3Storage) Delete(ctx context.Context, name string) error {
+	_, err := s.client.DeleteObjectWithContext(ctx, &awss3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(name),
+	})
+	return err
+}
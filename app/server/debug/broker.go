@@ -0,0 +1,175 @@
+package debug
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/util"
+	"github.com/google/uuid"
+)
+
+const (
+	// ErrSessionNotFound is returned when the session is closed or doesn't exist.
+	ErrSessionNotFound = util.StringError("debug session not found")
+	// ErrSessionClosed is returned when the other side of the session is gone.
+	ErrSessionClosed = util.StringError("debug session is closed")
+)
+
+// Broker pairs debug sessions opened by users with agents running the job executions.
+// Sessions are kept in memory, so the user and the agent of a session must connect to the same server.
+type Broker struct {
+	lock     sync.Mutex
+	sessions map[string]*Session
+	// pending are ids of sessions not announced to agents yet, grouped by job execution id.
+	pending map[int64][]string
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		sessions: map[string]*Session{},
+		pending:  map[int64][]string{},
+	}
+}
+
+// Open opens a session for the job execution. The caller should close the session after use.
+func (b *Broker) Open(jobExecutionID int64) *Session {
+	s := &Session{
+		ID:             uuid.NewString(),
+		JobExecutionID: jobExecutionID,
+		broker:         b,
+		requests:       make(chan *api.DebugRequest),
+		responses:      make(chan *api.DebugResponse),
+		accepted:       make(chan struct{}),
+		done:           make(chan struct{}),
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.sessions[s.ID] = s
+	b.pending[jobExecutionID] = append(b.pending[jobExecutionID], s.ID)
+	return s
+}
+
+// Pending returns ids of sessions of the job execution waiting for the agent. Each session is returned once.
+func (b *Broker) Pending(jobExecutionID int64) []string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	ids := b.pending[jobExecutionID]
+	delete(b.pending, jobExecutionID)
+	return ids
+}
+
+// Accept marks the session is served by the agent.
+func (b *Broker) Accept(id string) (*Session, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	s, ok := b.sessions[id]
+	if !ok {
+		return nil, errors.WithMessagef(ErrSessionNotFound, "session '%s'", id)
+	}
+	select {
+	case <-s.accepted:
+		return nil, errors.Errorf("session '%s' is already accepted", id)
+	default:
+		close(s.accepted)
+	}
+	return s, nil
+}
+
+func (b *Broker) remove(s *Session) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	delete(b.sessions, s.ID)
+	pending := b.pending[s.JobExecutionID]
+	for i, id := range pending {
+		if id == s.ID {
+			b.pending[s.JobExecutionID] = append(pending[:i:i], pending[i+1:]...)
+			break
+		}
+	}
+	if len(b.pending[s.JobExecutionID]) == 0 {
+		delete(b.pending, s.JobExecutionID)
+	}
+}
+
+// Session relays requests from the user to the agent, and responses from the agent to the user.
+type Session struct {
+	ID             string
+	JobExecutionID int64
+	broker         *Broker
+	requests       chan *api.DebugRequest
+	responses      chan *api.DebugResponse
+	// accepted is closed when the agent accepts the session.
+	accepted  chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// WaitAccepted waits for the agent to accept the session.
+func (s *Session) WaitAccepted(ctx context.Context) error {
+	select {
+	case <-s.accepted:
+		return nil
+	case <-s.done:
+		return ErrSessionClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SendRequest sends a request of the user to the agent.
+func (s *Session) SendRequest(ctx context.Context, request *api.DebugRequest) error {
+	select {
+	case s.requests <- request:
+		return nil
+	case <-s.done:
+		return ErrSessionClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RecvRequest receives a request of the user, it's called by the agent side.
+func (s *Session) RecvRequest(ctx context.Context) (*api.DebugRequest, error) {
+	select {
+	case request := <-s.requests:
+		return request, nil
+	case <-s.done:
+		return nil, ErrSessionClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// SendResponse sends a response of the agent to the user.
+func (s *Session) SendResponse(ctx context.Context, response *api.DebugResponse) error {
+	select {
+	case s.responses <- response:
+		return nil
+	case <-s.done:
+		return ErrSessionClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RecvResponse receives a response of the agent, it's called by the user side.
+func (s *Session) RecvResponse(ctx context.Context) (*api.DebugResponse, error) {
+	select {
+	case response := <-s.responses:
+		return response, nil
+	case <-s.done:
+		return nil, ErrSessionClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Close closes the session, both sides are notified. It's safe to call it multiple times.
+func (s *Session) Close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.broker.remove(s)
+	})
+}
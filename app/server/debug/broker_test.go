@@ -0,0 +1,53 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"gotest.tools/v3/assert"
+)
+
+func TestBroker(t *testing.T) {
+	ctx := context.Background()
+	broker := NewBroker()
+	t.Run("relay", func(t *testing.T) {
+		s := broker.Open(1)
+		defer s.Close()
+		assert.DeepEqual(t, broker.Pending(1), []string{s.ID})
+		assert.Equal(t, len(broker.Pending(1)), 0)
+		agentSide, err := broker.Accept(s.ID)
+		assert.NilError(t, err)
+		_, err = broker.Accept(s.ID)
+		assert.ErrorContains(t, err, "already accepted")
+		assert.NilError(t, s.WaitAccepted(ctx))
+		go func() {
+			_ = s.SendRequest(ctx, &api.DebugRequest{Stdin: []byte("ls\n")})
+		}()
+		request, err := agentSide.RecvRequest(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, string(request.Stdin), "ls\n")
+		go func() {
+			_ = agentSide.SendResponse(ctx, &api.DebugResponse{Output: []byte("a.txt\n")})
+		}()
+		response, err := s.RecvResponse(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, string(response.Output), "a.txt\n")
+		agentSide.Close()
+		_, err = s.RecvResponse(ctx)
+		assert.Assert(t, errors.Is(err, ErrSessionClosed))
+		_, err = broker.Accept(s.ID)
+		assert.Assert(t, errors.Is(err, ErrSessionNotFound))
+	})
+	t.Run("not_accepted", func(t *testing.T) {
+		s := broker.Open(2)
+		timeoutCtx, cancel := context.WithTimeout(ctx, time.Millisecond*10)
+		defer cancel()
+		assert.Assert(t, errors.Is(s.WaitAccepted(timeoutCtx), context.DeadlineExceeded))
+		s.Close()
+		// Closed sessions are not announced.
+		assert.Equal(t, len(broker.Pending(2)), 0)
+	})
+}
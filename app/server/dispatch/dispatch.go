@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/cox96de/runner/app/server/eventhook"
+	"github.com/cox96de/runner/app/server/notify"
 
 	"github.com/cox96de/runner/telemetry/trace"
 
@@ -19,38 +22,73 @@ import (
 type Service struct {
 	dbClient  *db.Client
 	eventhook *eventhook.Service
+	// notifier notifies agents waiting for jobs when jobs are queued.
+	notifier notify.Notifier
 }
 
-func NewService(dbClient *db.Client, eventhook *eventhook.Service) *Service {
-	return &Service{dbClient: dbClient, eventhook: eventhook}
+func NewService(dbClient *db.Client, eventhook *eventhook.Service, notifier notify.Notifier) *Service {
+	return &Service{dbClient: dbClient, eventhook: eventhook, notifier: notifier}
 }
 
-// Dispatch dispatches the jobs and updates the job executions.
+// Dispatch dispatches jobs of the pipeline and updates the job executions.
 // It pushes the jobs to the queue if all the dependencies are completed and success.
-// TODO: proceed dispatch if one job is failed, all the dependent jobs should be skipped.
-func (s *Service) Dispatch(ctx context.Context, jobs []*db.Job, executions []*db.JobExecution) error {
+// Jobs whose dependencies are not succeeded are skipped.
+// The pipeline is locked, and jobs are dispatched by their latest job executions reloaded under the lock, so it
+// doesn't race with dispatching when a job execution is completed.
+func (s *Service) Dispatch(ctx context.Context, pipelineID int64) error {
 	ctx, span := trace.Start(ctx, "dispatch.dispatch")
 	defer span.End()
+	var updatedJobExecutions []*db.JobExecution
+	err := s.dbClient.Transaction(func(client *db.Client) error {
+		if err := client.LockPipeline(ctx, pipelineID); err != nil {
+			return errors.WithMessagef(err, "failed to lock pipeline '%d'", pipelineID)
+		}
+		var err error
+		updatedJobExecutions, err = s.dispatchPipeline(ctx, client, pipelineID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	s.notifyQueuedJobs(ctx, updatedJobExecutions)
+	return s.sendJobExecutionEvents(ctx, updatedJobExecutions)
+}
+
+// dispatchPipeline dispatches all jobs in the pipeline by their latest job executions.
+func (s *Service) dispatchPipeline(ctx context.Context, client *db.Client, pipelineID int64) ([]*db.JobExecution, error) {
+	jobs, err := client.GetJobsByPipelineID(ctx, pipelineID)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to get jobs of pipeline '%d'", pipelineID)
+	}
+	latestJobExecutions, err := client.GetLatestJobExecutions(ctx, lo.Map(jobs, func(job *db.Job, _ int) int64 {
+		return job.ID
+	}))
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get latest job executions")
+	}
+	return s.dispatch(ctx, client, jobs, lo.Values(latestJobExecutions))
+}
+
+func (s *Service) dispatch(ctx context.Context, client *db.Client, jobs []*db.Job,
+	executions []*db.JobExecution,
+) ([]*db.JobExecution, error) {
 	d := &dispatcher{
 		jobs: make(map[string]*dispatchJob),
 	}
 	updateJobExecutionOptions, err := d.Dispatch(jobs, executions)
 	if err != nil {
-		return errors.WithMessage(err, "failed to calculate jobs")
+		return nil, errors.WithMessage(err, "failed to calculate jobs")
 	}
-	err = s.dbClient.Transaction(func(client *db.Client) error {
-		// TODO: batch update.
-		for _, option := range updateJobExecutionOptions {
-			if err := s.UpdateJobExecution(ctx, client, option); err != nil {
-				return errors.WithMessagef(err, "failed to update job execution '%d'", option.ID)
-			}
+	updatedJobExecutions := make([]*db.JobExecution, 0, len(updateJobExecutionOptions))
+	// TODO: batch update.
+	for _, option := range updateJobExecutionOptions {
+		updatedJobExecution, err := s.updateJobExecution(ctx, client, option)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to update job execution '%d'", option.ID)
 		}
-		return nil
-	})
-	if err != nil {
-		return err
+		updatedJobExecutions = append(updatedJobExecutions, updatedJobExecution)
 	}
-	return nil
+	return updatedJobExecutions, nil
 }
 
 // dispatcher is a helper to dispatch the jobs.
@@ -63,50 +101,91 @@ type dispatcher struct {
 type dispatchJob struct {
 	job       *db.Job
 	execution *db.JobExecution
+	// status is the status of the execution after dispatching.
+	status api.Status
+	// matrix is the combination the job is expanded from, it's nil if the job isn't expanded from a matrix.
+	matrix *api.JobMatrix
 }
 
+// Dispatch calculates the status of created job executions.
+// Skipping a job might lead to skip jobs depend on it, so it repeats until no more job execution is changed.
 func (d *dispatcher) Dispatch(jobs []*db.Job, executions []*db.JobExecution) ([]*db.UpdateJobExecutionOption, error) {
 	executionsMapByJobID := lo.SliceToMap(executions, func(item *db.JobExecution) (int64, *db.JobExecution) {
 		return item.JobID, item
 	})
+	// Sort jobs to make the result stable.
+	jobs = append([]*db.Job(nil), jobs...)
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].ID < jobs[j].ID
+	})
+	dispatchJobs := make([]*dispatchJob, 0, len(jobs))
 	for _, job := range jobs {
 		execution, ok := executionsMapByJobID[job.ID]
 		if !ok {
 			return nil, errors.Errorf("job %s has no execution", job.Name)
 		}
-		d.jobs[job.Name] = &dispatchJob{
+		matrix, err := db.UnmarshalJobMatrix(job.Matrix)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "invalid job %s", job.Name)
+		}
+		dj := &dispatchJob{
 			job:       job,
 			execution: execution,
+			status:    execution.Status,
+			matrix:    matrix,
 		}
+		d.jobs[job.Name] = dj
+		dispatchJobs = append(dispatchJobs, dj)
 	}
 	var result []*db.UpdateJobExecutionOption
-	for _, job := range d.jobs {
-		if job.execution.Status.IsCompleted() {
-			continue
-		}
-		updateJobExecutionOption, err := d.isAllPreCompleted(job)
-		if err != nil {
-			updateJobExecutionOption = &db.UpdateJobExecutionOption{
-				ID:     job.execution.ID,
-				Status: lo.ToPtr(api.StatusFailed),
-				Reason: &api.Reason{
-					Reason:  api.FailedReasonInternalError,
-					Message: fmt.Sprintf("failed to dispatch job execution: %v", err),
-				},
-				StartedAt:   nil,
-				CompletedAt: nil,
+	for changed := true; changed; {
+		changed = false
+		for _, job := range dispatchJobs {
+			// Only the created job executions need to be dispatched.
+			if job.status != api.StatusCreated {
+				continue
+			}
+			updateJobExecutionOption, err := d.isAllPreCompleted(job)
+			if err != nil {
+				updateJobExecutionOption = &db.UpdateJobExecutionOption{
+					ID:     job.execution.ID,
+					Status: lo.ToPtr(api.StatusFailed),
+					Reason: &api.Reason{
+						Reason:  api.FailedReasonInternalError,
+						Message: fmt.Sprintf("failed to dispatch job execution: %v", err),
+					},
+					StartedAt:   nil,
+					CompletedAt: lo.ToPtr(time.Now()),
+				}
+			}
+			if updateJobExecutionOption == nil {
+				continue
+			}
+			// The job stays created until other expansions of the matrix are completed.
+			if *updateJobExecutionOption.Status == api.StatusQueued && d.isMatrixFull(job) {
+				continue
 			}
+			job.status = *updateJobExecutionOption.Status
+			changed = true
 			result = append(result, updateJobExecutionOption)
-			continue
 		}
-		if updateJobExecutionOption == nil {
-			continue
-		}
-		result = append(result, updateJobExecutionOption)
 	}
 	return result, nil
 }
 
+// isMatrixFull returns true if the job is expanded from a matrix, and `max_parallel` expansions of the matrix are
+// queued or running.
+func (d *dispatcher) isMatrixFull(job *dispatchJob) bool {
+	if job.matrix == nil || job.matrix.MaxParallel <= 0 {
+		return false
+	}
+	active := lo.CountBy(lo.Values(d.jobs), func(item *dispatchJob) bool {
+		return item.matrix != nil && item.matrix.Name == job.matrix.Name && item.status != api.StatusCreated &&
+			!item.status.IsCompleted()
+	})
+	return active >= int(job.matrix.MaxParallel)
+}
+
 func (d *dispatcher) isAllPreCompleted(job *dispatchJob) (*db.UpdateJobExecutionOption, error) {
 	if len(job.job.DependsOn) == 0 {
 		return &db.UpdateJobExecutionOption{
@@ -119,23 +198,38 @@ func (d *dispatcher) isAllPreCompleted(job *dispatchJob) (*db.UpdateJobExecution
 	if err != nil {
 		return nil, errors.WithMessage(err, "failed to unmarshal depends on")
 	}
+	dependencyOutputs := make(map[string]map[string]string, len(dependsOn))
 	for _, depend := range dependsOn {
 		depJob, ok := d.jobs[depend]
 		if !ok {
 			return nil, errors.Errorf("job %s depends on %s, but not found", job.job.Name, depend)
 		}
-		if !depJob.execution.Status.IsCompleted() {
+		if !depJob.status.IsCompleted() {
 			return nil, nil
 		}
-		if depJob.execution.Status != api.StatusSucceeded {
+		if depJob.status != api.StatusSucceeded {
 			return &db.UpdateJobExecutionOption{
 				ID:     job.execution.ID,
 				Status: lo.ToPtr(api.StatusSkipped),
+				Reason: &api.Reason{
+					Reason:  api.FailedReasonDependencyFailed,
+					Message: fmt.Sprintf("dependency '%s' is %s", depend, depJob.status.ToString()),
+				},
+				CompletedAt: lo.ToPtr(time.Now()),
 			}, nil
 		}
+		outputs := map[string]string{}
+		if len(depJob.execution.Outputs) > 0 {
+			if err = json.Unmarshal(depJob.execution.Outputs, &outputs); err != nil {
+				return nil, errors.WithMessagef(err, "failed to unmarshal outputs of '%s'", depend)
+			}
+		}
+		dependencyOutputs[depend] = outputs
 	}
+	// Outputs of dependencies are recorded in the job execution, so rerunning a dependency doesn't change them.
 	return &db.UpdateJobExecutionOption{
-		ID:     job.execution.ID,
-		Status: lo.ToPtr(api.StatusQueued),
+		ID:                job.execution.ID,
+		Status:            lo.ToPtr(api.StatusQueued),
+		DependencyOutputs: dependencyOutputs,
 	}, nil
 }
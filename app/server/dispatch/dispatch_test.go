@@ -2,9 +2,12 @@ package dispatch
 
 import (
 	"context"
+	"encoding/json"
+	"sync"
 	"testing"
 
 	"github.com/cox96de/runner/app/server/eventhook"
+	"github.com/cox96de/runner/app/server/notify"
 
 	"github.com/cox96de/runner/api"
 	"github.com/cox96de/runner/db"
@@ -32,14 +35,17 @@ func TestService_Dispatch(t *testing.T) {
 			},
 		})
 		assert.NilError(t, err)
-		s := NewService(dbClient, eventhook.NewService(eventhook.NewNopSender()))
-		err = s.Dispatch(context.Background(), createdPipeline.CreatedJobs, createdPipeline.CreatedJobExecutions)
+		s := NewService(dbClient, eventhook.NewService(eventhook.NewNopSender()), notify.NewLocalNotifier())
+		err = s.Dispatch(context.Background(), createdPipeline.CreatedPipeline.ID)
 		assert.NilError(t, err)
 		for _, jobExecution := range createdPipeline.CreatedJobExecutions {
 			execution, err := dbClient.GetJobExecution(context.Background(), jobExecution.ID)
 			assert.NilError(t, err)
 			assert.Equal(t, api.StatusQueued, execution.Status)
 		}
+		// Executions are reloaded, so the queued jobs are not dispatched again.
+		err = s.Dispatch(context.Background(), createdPipeline.CreatedPipeline.ID)
+		assert.NilError(t, err)
 	})
 	t.Run("dep", func(t *testing.T) {
 		createdPipeline, err := pipelineService.CreatePipeline(context.Background(), &api.PipelineDSL{
@@ -56,8 +62,8 @@ func TestService_Dispatch(t *testing.T) {
 			},
 		})
 		assert.NilError(t, err)
-		s := NewService(dbClient, eventhook.NewService(eventhook.NewNopSender()))
-		err = s.Dispatch(context.Background(), createdPipeline.CreatedJobs, createdPipeline.CreatedJobExecutions)
+		s := NewService(dbClient, eventhook.NewService(eventhook.NewNopSender()), notify.NewLocalNotifier())
+		err = s.Dispatch(context.Background(), createdPipeline.CreatedPipeline.ID)
 		assert.NilError(t, err)
 		jobIDNameMap := lo.SliceToMap(createdPipeline.CreatedJobs, func(item *db.Job) (int64, string) {
 			return item.ID, item.Name
@@ -84,7 +90,7 @@ func TestService_Dispatch(t *testing.T) {
 					assert.NilError(t, err)
 				}
 			}
-			err := s.Dispatch(context.Background(), createdPipeline.CreatedJobs, createdPipeline.CreatedJobExecutions)
+			err := s.Dispatch(context.Background(), createdPipeline.CreatedPipeline.ID)
 			assert.NilError(t, err)
 			for _, jobExecution := range createdPipeline.CreatedJobExecutions {
 				execution, err := dbClient.GetJobExecution(context.Background(), jobExecution.ID)
@@ -98,6 +104,23 @@ func TestService_Dispatch(t *testing.T) {
 			}
 		})
 		t.Run("dep_is_not_success", func(t *testing.T) {
+			createdPipeline, err := pipelineService.CreatePipeline(context.Background(), &api.PipelineDSL{
+				Jobs: []*api.JobDSL{
+					{
+						Name:  "job1",
+						Steps: []*api.StepDSL{{Name: "step1"}},
+					},
+					{
+						Name:      "job2",
+						Steps:     []*api.StepDSL{{Name: "step1"}},
+						DependsOn: []string{"job1"},
+					},
+				},
+			})
+			assert.NilError(t, err)
+			jobIDNameMap := lo.SliceToMap(createdPipeline.CreatedJobs, func(item *db.Job) (int64, string) {
+				return item.ID, item.Name
+			})
 			for _, execution := range createdPipeline.CreatedJobExecutions {
 				if jobIDNameMap[execution.JobID] == "job1" {
 					_, err := dbClient.UpdateJobExecution(context.Background(), &db.UpdateJobExecutionOption{
@@ -108,7 +131,7 @@ func TestService_Dispatch(t *testing.T) {
 					assert.NilError(t, err)
 				}
 			}
-			err := s.Dispatch(context.Background(), createdPipeline.CreatedJobs, createdPipeline.CreatedJobExecutions)
+			err = s.Dispatch(context.Background(), createdPipeline.CreatedPipeline.ID)
 			assert.NilError(t, err)
 			for _, jobExecution := range createdPipeline.CreatedJobExecutions {
 				execution, err := dbClient.GetJobExecution(context.Background(), jobExecution.ID)
@@ -123,3 +146,174 @@ func TestService_Dispatch(t *testing.T) {
 		})
 	})
 }
+
+func TestService_UpdateJobExecution_Dispatch(t *testing.T) {
+	dbClient := mock.NewMockDB(t)
+	pipelineService := pipeline.NewService(dbClient)
+	s := NewService(dbClient, eventhook.NewService(eventhook.NewNopSender()), notify.NewLocalNotifier())
+	createPipeline := func(t *testing.T) (map[string]*db.JobExecution, func(name string) *db.JobExecution) {
+		// job1 <- job2 <- job3
+		createdPipeline, err := pipelineService.CreatePipeline(context.Background(), &api.PipelineDSL{
+			Jobs: []*api.JobDSL{
+				{Name: "job1", Steps: []*api.StepDSL{{Name: "step1"}}},
+				{Name: "job2", Steps: []*api.StepDSL{{Name: "step1"}}, DependsOn: []string{"job1"}},
+				{Name: "job3", Steps: []*api.StepDSL{{Name: "step1"}}, DependsOn: []string{"job2"}},
+			},
+		})
+		assert.NilError(t, err)
+		err = s.Dispatch(context.Background(), createdPipeline.CreatedPipeline.ID)
+		assert.NilError(t, err)
+		jobIDNameMap := lo.SliceToMap(createdPipeline.CreatedJobs, func(item *db.Job) (int64, string) {
+			return item.ID, item.Name
+		})
+		executions := lo.SliceToMap(createdPipeline.CreatedJobExecutions, func(item *db.JobExecution) (string, *db.JobExecution) {
+			return jobIDNameMap[item.JobID], item
+		})
+		get := func(name string) *db.JobExecution {
+			execution, err := dbClient.GetJobExecution(context.Background(), executions[name].ID)
+			assert.NilError(t, err)
+			return execution
+		}
+		return executions, get
+	}
+	t.Run("succeeded", func(t *testing.T) {
+		executions, get := createPipeline(t)
+		assert.Equal(t, get("job1").Status, api.StatusQueued)
+		assert.Equal(t, get("job2").Status, api.StatusCreated)
+		err := s.UpdateJobExecution(context.Background(), dbClient, &db.UpdateJobExecutionOption{
+			ID:     executions["job1"].ID,
+			Status: lo.ToPtr(api.StatusSucceeded),
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, get("job2").Status, api.StatusQueued)
+		assert.Equal(t, get("job3").Status, api.StatusCreated)
+		err = s.UpdateJobExecution(context.Background(), dbClient, &db.UpdateJobExecutionOption{
+			ID:     executions["job2"].ID,
+			Status: lo.ToPtr(api.StatusSucceeded),
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, get("job3").Status, api.StatusQueued)
+	})
+	t.Run("outputs", func(t *testing.T) {
+		executions, get := createPipeline(t)
+		err := s.UpdateJobExecution(context.Background(), dbClient, &db.UpdateJobExecutionOption{
+			ID:      executions["job1"].ID,
+			Outputs: map[string]string{"image": "runner:v1"},
+		})
+		assert.NilError(t, err)
+		err = s.UpdateJobExecution(context.Background(), dbClient, &db.UpdateJobExecutionOption{
+			ID:     executions["job1"].ID,
+			Status: lo.ToPtr(api.StatusSucceeded),
+		})
+		assert.NilError(t, err)
+		job2, err := db.PackJobExecution(get("job2"), nil)
+		assert.NilError(t, err)
+		assert.Equal(t, job2.Status, api.StatusQueued)
+		assert.DeepEqual(t, job2.DependencyOutputs["job1"].Outputs, map[string]string{"image": "runner:v1"})
+		job1, err := db.PackJobExecution(get("job1"), nil)
+		assert.NilError(t, err)
+		assert.Equal(t, len(job1.DependencyOutputs), 0)
+	})
+	t.Run("failed", func(t *testing.T) {
+		executions, get := createPipeline(t)
+		err := s.UpdateJobExecution(context.Background(), dbClient, &db.UpdateJobExecutionOption{
+			ID:     executions["job1"].ID,
+			Status: lo.ToPtr(api.StatusFailed),
+		})
+		assert.NilError(t, err)
+		for _, name := range []string{"job2", "job3"} {
+			execution := get(name)
+			assert.Equal(t, execution.Status, api.StatusSkipped, name)
+			assert.Assert(t, execution.CompletedAt != nil, name)
+			reason := &api.Reason{}
+			assert.NilError(t, json.Unmarshal(execution.Reason, reason))
+			assert.Equal(t, reason.Reason, api.FailedReasonDependencyFailed, name)
+		}
+	})
+	t.Run("concurrent_dependencies", func(t *testing.T) {
+		// build, lint <- deploy
+		createdPipeline, err := pipelineService.CreatePipeline(context.Background(), &api.PipelineDSL{
+			Jobs: []*api.JobDSL{
+				{Name: "build", Steps: []*api.StepDSL{{Name: "step1"}}},
+				{Name: "lint", Steps: []*api.StepDSL{{Name: "step1"}}},
+				{Name: "deploy", Steps: []*api.StepDSL{{Name: "step1"}}, DependsOn: []string{"build", "lint"}},
+			},
+		})
+		assert.NilError(t, err)
+		err = s.Dispatch(context.Background(), createdPipeline.CreatedPipeline.ID)
+		assert.NilError(t, err)
+		var wg sync.WaitGroup
+		errs := make([]error, 2)
+		for i, execution := range createdPipeline.CreatedJobExecutions[:2] {
+			wg.Add(1)
+			go func(i int, execution *db.JobExecution) {
+				defer wg.Done()
+				errs[i] = s.UpdateJobExecution(context.Background(), dbClient, &db.UpdateJobExecutionOption{
+					ID:     execution.ID,
+					Status: lo.ToPtr(api.StatusSucceeded),
+				})
+			}(i, execution)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			assert.NilError(t, err)
+		}
+		deploy := createdPipeline.CreatedJobExecutions[2]
+		execution, err := dbClient.GetJobExecution(context.Background(), deploy.ID)
+		assert.NilError(t, err)
+		assert.Equal(t, execution.Status, api.StatusQueued)
+		_, err = dbClient.GetJobQueue(context.Background(), deploy.ID)
+		assert.NilError(t, err)
+	})
+}
+
+func TestService_Dispatch_Matrix(t *testing.T) {
+	dbClient := mock.NewMockDB(t)
+	pipelineService := pipeline.NewService(dbClient)
+	s := NewService(dbClient, eventhook.NewService(eventhook.NewNopSender()), notify.NewLocalNotifier())
+	createdPipeline, err := pipelineService.CreatePipeline(context.Background(), &api.PipelineDSL{
+		Jobs: []*api.JobDSL{
+			{
+				Name:  "test",
+				Steps: []*api.StepDSL{{Name: "step1"}},
+				Matrix: &api.MatrixDSL{
+					Axes:        []*api.MatrixAxis{{Name: "os", Values: []string{"linux", "macos", "windows"}}},
+					MaxParallel: 2,
+				},
+			},
+			{Name: "deploy", Steps: []*api.StepDSL{{Name: "step1"}}, DependsOn: []string{"test"}},
+		},
+	})
+	assert.NilError(t, err)
+	err = s.Dispatch(context.Background(), createdPipeline.CreatedPipeline.ID)
+	assert.NilError(t, err)
+	jobIDNameMap := lo.SliceToMap(createdPipeline.CreatedJobs, func(item *db.Job) (int64, string) {
+		return item.ID, item.Name
+	})
+	executions := lo.SliceToMap(createdPipeline.CreatedJobExecutions, func(item *db.JobExecution) (string, *db.JobExecution) {
+		return jobIDNameMap[item.JobID], item
+	})
+	get := func(name string) api.Status {
+		execution, err := dbClient.GetJobExecution(context.Background(), executions[name].ID)
+		assert.NilError(t, err)
+		return execution.Status
+	}
+	assert.Equal(t, get("test (os=linux)"), api.StatusQueued)
+	assert.Equal(t, get("test (os=macos)"), api.StatusQueued)
+	assert.Equal(t, get("test (os=windows)"), api.StatusCreated)
+	assert.Equal(t, get("deploy"), api.StatusCreated)
+	for i, name := range []string{"test (os=linux)", "test (os=macos)", "test (os=windows)"} {
+		err = s.UpdateJobExecution(context.Background(), dbClient, &db.UpdateJobExecutionOption{
+			ID:     executions[name].ID,
+			Status: lo.ToPtr(api.StatusSucceeded),
+		})
+		assert.NilError(t, err)
+		if i == 0 {
+			assert.Equal(t, get("test (os=windows)"), api.StatusQueued)
+		}
+		if i < 2 {
+			assert.Equal(t, get("deploy"), api.StatusCreated)
+		}
+	}
+	assert.Equal(t, get("deploy"), api.StatusQueued)
+}
@@ -9,11 +9,61 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/cox96de/runner/api"
 	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/log"
 )
 
 // UpdateJobExecution updates job execution status and job queue status.
 // It insert a new job queue if the job execution status is queued.
+// If the job execution is completed, other jobs in the same pipeline are dispatched in the same transaction. The
+// pipeline is locked before anything is read in the transaction, so dependencies completed concurrently are seen by
+// the latter one, and a dependent job is dispatched once.
+// Events and notifications are sent once the update is committed, so client shouldn't be in an outer transaction.
 func (s *Service) UpdateJobExecution(ctx context.Context, client *db.Client, option *db.UpdateJobExecutionOption) error {
+	var pipelineID int64
+	if option.Status != nil && option.Status.IsCompleted() {
+		jobExecution, err := client.GetJobExecution(ctx, option.ID)
+		if err != nil {
+			return errors.WithMessage(err, "failed to get job execution")
+		}
+		job, err := client.GetJobByID(ctx, jobExecution.JobID)
+		if err != nil {
+			return errors.WithMessage(err, "failed to get job")
+		}
+		pipelineID = job.PipelineID
+	}
+	var updatedJobExecutions []*db.JobExecution
+	err := client.Transaction(func(client *db.Client) error {
+		if pipelineID > 0 {
+			if err := client.LockPipeline(ctx, pipelineID); err != nil {
+				return errors.WithMessagef(err, "failed to lock pipeline '%d'", pipelineID)
+			}
+		}
+		updatedJobExecution, err := s.updateJobExecution(ctx, client, option)
+		if err != nil {
+			return err
+		}
+		updatedJobExecutions = append(updatedJobExecutions, updatedJobExecution)
+		if pipelineID == 0 {
+			return nil
+		}
+		dispatchedJobExecutions, err := s.dispatchPipeline(ctx, client, pipelineID)
+		if err != nil {
+			return errors.WithMessagef(err, "failed to dispatch jobs of pipeline '%d'", pipelineID)
+		}
+		updatedJobExecutions = append(updatedJobExecutions, dispatchedJobExecutions...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.notifyQueuedJobs(ctx, updatedJobExecutions)
+	return s.sendJobExecutionEvents(ctx, updatedJobExecutions)
+}
+
+// updateJobExecution updates job execution status and job queue status without dispatching other jobs.
+func (s *Service) updateJobExecution(ctx context.Context, client *db.Client,
+	option *db.UpdateJobExecutionOption,
+) (*db.JobExecution, error) {
 	// TODO: Add more attribute.
 	ctx, span := trace.Start(ctx, "dispatch.update_job_execution", trace.WithAttributes(
 		attribute.Int64("job_execution_id", option.ID),
@@ -63,13 +113,48 @@ func (s *Service) UpdateJobExecution(ctx context.Context, client *db.Client, opt
 		if err != nil {
 			return errors.WithMessage(err, "failed to update job execution")
 		}
-		if err != nil {
-			return errors.WithMessage(err, "failed to send job execution event")
-		}
 		return nil
 	})
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return updatedJob, nil
+}
+
+func (s *Service) sendJobExecutionEvents(ctx context.Context, jobExecutions []*db.JobExecution) error {
+	for _, jobExecution := range jobExecutions {
+		if err := s.eventhook.SendJobExecutionEvent(ctx, jobExecution); err != nil {
+			return errors.WithMessagef(err, "failed to send event of job execution '%d'", jobExecution.ID)
+		}
 	}
-	return s.eventhook.SendJobExecutionEvent(ctx, updatedJob)
+	return nil
+}
+
+// notifyQueuedJobs notifies agents waiting for jobs that jobs are queued. Failures are logged only, agents still
+// get these jobs on their next requests.
+func (s *Service) notifyQueuedJobs(ctx context.Context, jobExecutions []*db.JobExecution) {
+	logger := log.ExtractLogger(ctx)
+	for _, jobExecution := range jobExecutions {
+		if jobExecution.Status != api.StatusQueued {
+			continue
+		}
+		job, err := s.dbClient.GetJobByID(ctx, jobExecution.JobID)
+		if err != nil {
+			logger.Warningf("failed to get job of queued job execution '%d': %v", jobExecution.ID, err)
+			continue
+		}
+		runsOn, err := db.UnmarshalRunsOn(job.RunsOn)
+		if err != nil {
+			logger.Warningf("failed to unmarshal runs on of job '%d': %v", job.ID, err)
+			continue
+		}
+		if err = s.notifier.Notify(ctx, runsOn.Label); err != nil {
+			logger.Warningf("failed to notify queued job execution '%d': %v", jobExecution.ID, err)
+		}
+	}
+}
+
+// SubscribeQueuedJobs subscribes label expressions of queued jobs until ctx is done.
+func (s *Service) SubscribeQueuedJobs(ctx context.Context) (<-chan string, error) {
+	return s.notifier.Subscribe(ctx)
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/cox96de/runner/app/server/eventhook"
+	"github.com/cox96de/runner/app/server/notify"
 
 	"github.com/cox96de/runner/api"
 	"github.com/cox96de/runner/db"
@@ -15,10 +16,12 @@ import (
 
 func TestUpdateJobExecution(t *testing.T) {
 	dbClient := mock.NewMockDB(t)
-	service := NewService(dbClient, eventhook.NewService(eventhook.NewNopSender()))
+	service := NewService(dbClient, eventhook.NewService(eventhook.NewNopSender()), notify.NewLocalNotifier())
+	pipeline, err := dbClient.CreatePipeline(context.Background(), "")
+	assert.NilError(t, err)
 	jobs, err := dbClient.CreateJobs(context.Background(), []*db.CreateJobOption{
 		{
-			PipelineID: 1,
+			PipelineID: pipeline.ID,
 			Name:       t.Name(),
 		},
 	})
@@ -46,8 +46,12 @@ func NewService(client Sender) *Service {
 func (s *Service) SendStepExecutionEvent(ctx context.Context, step *db.StepExecution) error {
 	ev := cloudevents.NewEvent()
 	ev.SetType("step_execution")
+	stepExecution, err := db.PackStepExecution(step)
+	if err != nil {
+		return errors.WithMessage(err, "failed to pack step execution")
+	}
 	if err := ev.SetData(cloudevents.ApplicationJSON, api.Event{
-		StepExecution: db.PackStepExecution(step),
+		StepExecution: stepExecution,
 	}); err != nil {
 		return errors.WithMessage(err, "failed to set data")
 	}
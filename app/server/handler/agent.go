@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/log"
+)
+
+// agentOfflineTimeout is the duration without heartbeats after which an agent is considered offline.
+const agentOfflineTimeout = time.Minute
+
+func (h *Handler) RegisterAgent(ctx context.Context, request *api.RegisterAgentRequest) (*api.RegisterAgentResponse, error) {
+	if request.Name == "" {
+		return nil, &HTTPError{Code: http.StatusBadRequest, CauseError: errors.New("name is required")}
+	}
+	agent, err := h.db.RegisterAgent(ctx, &db.RegisterAgentOption{
+		Name:        request.Name,
+		Hostname:    request.Hostname,
+		Version:     request.Version,
+		Engine:      request.Engine,
+		Labels:      request.Labels,
+		Concurrency: request.Concurrency,
+	})
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to register agent '%s'", request.Name)
+	}
+	log.ExtractLogger(ctx).Infof("agent '%s' is registered as '%d' from '%s', labels: %v", agent.Name, agent.ID,
+		agent.Hostname, request.Labels)
+	packed, err := db.PackAgent(agent, agentOfflineTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return &api.RegisterAgentResponse{Agent: packed}, nil
+}
+
+func (h *Handler) AgentHeartbeat(ctx context.Context, request *api.AgentHeartbeatRequest) (*api.AgentHeartbeatResponse, error) {
+	if err := h.db.UpdateAgentHeartbeat(ctx, request.AgentID, request.RunningJobExecutionIDs); err != nil {
+		if db.IsRecordNotFoundError(err) {
+			return nil, &HTTPError{Code: http.StatusNotFound, CauseError: errors.Errorf("agent '%d' is not registered",
+				request.AgentID)}
+		}
+		return nil, errors.WithMessagef(err, "failed to update heartbeat of agent '%d'", request.AgentID)
+	}
+	return &api.AgentHeartbeatResponse{}, nil
+}
+
+func (h *Handler) ListAgents(ctx context.Context, request *api.ListAgentsRequest) (*api.ListAgentsResponse, error) {
+	var selector *api.LabelSelector
+	if request.Label != "" {
+		var err error
+		if selector, err = api.ParseLabelSelector(request.Label); err != nil {
+			return nil, &HTTPError{Code: http.StatusBadRequest, CauseError: err}
+		}
+	}
+	agents, err := h.db.ListAgents(ctx)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to list agents")
+	}
+	packedAgents := make([]*api.Agent, 0, len(agents))
+	for _, agent := range agents {
+		packed, err := db.PackAgent(agent, agentOfflineTimeout)
+		if err != nil {
+			return nil, err
+		}
+		if selector != nil && !selector.Match(packed.Labels) {
+			continue
+		}
+		if request.Status != nil && packed.Status != *request.Status {
+			continue
+		}
+		packedAgents = append(packedAgents, packed)
+	}
+	return &api.ListAgentsResponse{Agents: packedAgents}, nil
+}
+
+func (h *Handler) GetAgent(ctx context.Context, request *api.GetAgentRequest) (*api.GetAgentResponse, error) {
+	agent, err := h.db.GetAgent(ctx, request.AgentID)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			return nil, &HTTPError{Code: http.StatusNotFound, CauseError: err}
+		}
+		return nil, errors.WithMessagef(err, "failed to get agent '%d'", request.AgentID)
+	}
+	packed, err := db.PackAgent(agent, agentOfflineTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetAgentResponse{Agent: packed}, nil
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/app/server/dispatch"
+	"github.com/cox96de/runner/app/server/eventhook"
+	"github.com/cox96de/runner/app/server/notify"
+	"github.com/cox96de/runner/app/server/pipeline"
+	"github.com/cox96de/runner/mock"
+	"github.com/samber/lo"
+	"gotest.tools/v3/assert"
+)
+
+func TestHandler_Agent(t *testing.T) {
+	ctx := context.Background()
+	dbClient := mock.NewMockDB(t)
+	eventHook := eventhook.NewService(eventhook.NewNopSender())
+	handler := NewHandler(dbClient, pipeline.NewService(dbClient), dispatch.NewService(dbClient, eventHook, notify.NewLocalNotifier()),
+		mock.NewMockLocker(), nil, eventHook, nil, nil, nil, nil)
+	_, err := handler.RegisterAgent(ctx, &api.RegisterAgentRequest{})
+	assert.ErrorContains(t, err, "name is required")
+	linux, err := handler.RegisterAgent(ctx, &api.RegisterAgentRequest{
+		Name: "linux", Hostname: "host1", Engine: "shell", Labels: []string{"linux"}, Concurrency: 1,
+	})
+	assert.NilError(t, err)
+	windows, err := handler.RegisterAgent(ctx, &api.RegisterAgentRequest{
+		Name: "windows", Hostname: "host2", Engine: "vm", Labels: []string{"windows"}, Concurrency: 1,
+	})
+	assert.NilError(t, err)
+	createPipelineResponse, err := handler.CreatePipeline(ctx, &api.CreatePipelineRequest{
+		Pipeline: &api.PipelineDSL{
+			Jobs: []*api.JobDSL{{
+				Name:   "test",
+				RunsOn: &api.RunsOn{Label: "linux"},
+				Steps:  []*api.StepDSL{{Name: "test", Commands: []string{"echo test"}}},
+			}},
+		},
+	})
+	assert.NilError(t, err)
+	requestJobResponse, err := handler.RequestJob(ctx, &api.RequestJobRequest{
+		Label:   "linux",
+		AgentID: linux.Agent.ID,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, requestJobResponse.Job.Execution.AgentID, linux.Agent.ID)
+	getJobExecutionResponse, err := handler.GetJobExecution(ctx, &api.GetJobExecutionRequest{
+		JobExecutionID: createPipelineResponse.Pipeline.Jobs[0].Execution.ID,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, getJobExecutionResponse.JobExecution.AgentID, linux.Agent.ID)
+	_, err = handler.AgentHeartbeat(ctx, &api.AgentHeartbeatRequest{
+		AgentID:                linux.Agent.ID,
+		RunningJobExecutionIDs: []int64{requestJobResponse.Job.Execution.ID},
+	})
+	assert.NilError(t, err)
+	_, err = handler.AgentHeartbeat(ctx, &api.AgentHeartbeatRequest{AgentID: windows.Agent.ID + 1})
+	assert.ErrorContains(t, err, "is not registered")
+	t.Run("list", func(t *testing.T) {
+		listAgentsResponse, err := handler.ListAgents(ctx, &api.ListAgentsRequest{})
+		assert.NilError(t, err)
+		assert.Equal(t, len(listAgentsResponse.Agents), 2)
+		listAgentsResponse, err = handler.ListAgents(ctx, &api.ListAgentsRequest{Label: "windows"})
+		assert.NilError(t, err)
+		assert.Equal(t, len(listAgentsResponse.Agents), 1)
+		assert.Equal(t, listAgentsResponse.Agents[0].ID, windows.Agent.ID)
+		listAgentsResponse, err = handler.ListAgents(ctx, &api.ListAgentsRequest{
+			Status: lo.ToPtr(api.AgentStatusBusy),
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, len(listAgentsResponse.Agents), 1)
+		assert.Equal(t, listAgentsResponse.Agents[0].ID, linux.Agent.ID)
+	})
+	t.Run("get", func(t *testing.T) {
+		getAgentResponse, err := handler.GetAgent(ctx, &api.GetAgentRequest{AgentID: linux.Agent.ID})
+		assert.NilError(t, err)
+		assert.Equal(t, getAgentResponse.Agent.Status, api.AgentStatusBusy)
+		assert.DeepEqual(t, getAgentResponse.Agent.RunningJobExecutionIDs,
+			[]int64{requestJobResponse.Job.Execution.ID})
+		_, err = handler.GetAgent(ctx, &api.GetAgentRequest{AgentID: windows.Agent.ID + 1})
+		assert.ErrorContains(t, err, "not found")
+	})
+}
@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/app/server/artifact"
+	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/log"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// artifactChunkSize is the max size of data in a message of artifact download.
+const artifactChunkSize = 32 * 1024
+
+func (h *Handler) UploadArtifact(stream api.Server_UploadArtifactServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return errors.WithMessage(err, "failed to receive the first message")
+	}
+	reader := &uploadArtifactReader{stream: stream, buf: first.Data}
+	response, err := h.uploadArtifact(stream.Context(), first, reader)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(response)
+}
+
+// UploadArtifactHandler uploads an artifact, the request body is the content of the artifact.
+func (h *Handler) UploadArtifactHandler(c *gin.Context) {
+	request := &api.UploadArtifactRequest{}
+	if err := Bind(c, request); err != nil {
+		JSON(c, http.StatusBadRequest, &Message{Message: err})
+		return
+	}
+	response, err := h.uploadArtifact(c.Request.Context(), request, c.Request.Body)
+	if err != nil {
+		log.ExtractLogger(c).Errorf("failed to upload artifact: %+v", err)
+		JSON(c, http.StatusInternalServerError, &Message{Message: err})
+		return
+	}
+	JSON(c, http.StatusOK, response)
+}
+
+func (h *Handler) uploadArtifact(ctx context.Context, request *api.UploadArtifactRequest, content io.Reader,
+) (*api.UploadArtifactResponse, error) {
+	log.ExtractLogger(ctx).Infof("upload artifact '%s' of job execution '%d'", request.Name,
+		request.JobExecutionID)
+	created, err := h.artifactService.Save(ctx, &artifact.SaveOption{
+		JobExecutionID: request.JobExecutionID,
+		Name:           request.Name,
+		RetentionDays:  request.RetentionDays,
+	}, content)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to save artifact '%s'", request.Name)
+	}
+	return &api.UploadArtifactResponse{Artifact: db.PackArtifact(created)}, nil
+}
+
+func (h *Handler) ListArtifacts(ctx context.Context, request *api.ListArtifactsRequest) (*api.ListArtifactsResponse, error) {
+	artifacts, err := h.artifactService.List(ctx, request.PipelineID, request.JobName, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ListArtifactsResponse{
+		Artifacts: lo.Map(artifacts, func(item *db.Artifact, _ int) *api.Artifact {
+			return db.PackArtifact(item)
+		}),
+	}, nil
+}
+
+func (h *Handler) DownloadArtifact(request *api.DownloadArtifactRequest, stream api.Server_DownloadArtifactServer) error {
+	_, content, err := h.artifactService.Open(stream.Context(), request.ArtifactID)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+	buf := make([]byte, artifactChunkSize)
+	for {
+		n, readErr := io.ReadFull(content, buf)
+		if n > 0 {
+			if err = stream.Send(&api.DownloadArtifactResponse{Data: buf[:n]}); err != nil {
+				return errors.WithMessage(err, "failed to send artifact")
+			}
+		}
+		if readErr != nil {
+			if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+				return nil
+			}
+			return errors.WithMessage(readErr, "failed to read artifact")
+		}
+	}
+}
+
+// DownloadArtifactHandler responds the content of an artifact as a tar file.
+func (h *Handler) DownloadArtifactHandler(c *gin.Context) {
+	request := &api.DownloadArtifactRequest{}
+	if err := Bind(c, request); err != nil {
+		JSON(c, http.StatusBadRequest, &Message{Message: err})
+		return
+	}
+	a, content, err := h.artifactService.Open(c.Request.Context(), request.ArtifactID)
+	if err != nil {
+		log.ExtractLogger(c).Errorf("failed to open artifact: %+v", err)
+		statusCode := http.StatusInternalServerError
+		switch {
+		case db.IsRecordNotFoundError(err):
+			statusCode = http.StatusNotFound
+		case errors.Is(err, artifact.ErrExpired):
+			statusCode = http.StatusGone
+		}
+		JSON(c, statusCode, &Message{Message: err})
+		return
+	}
+	defer content.Close()
+	header := c.Writer.Header()
+	header.Set("Content-Type", "application/x-tar")
+	header.Set("Content-Length", strconv.FormatInt(a.Size, 10))
+	header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", a.Name+".tar"))
+	c.Status(http.StatusOK)
+	if _, err = io.Copy(c.Writer, content); err != nil {
+		log.ExtractLogger(c).Errorf("failed to write artifact: %+v", err)
+	}
+}
+
+// uploadArtifactReader reads the data of UploadArtifactRequest stream.
+type uploadArtifactReader struct {
+	stream api.Server_UploadArtifactServer
+	buf    []byte
+}
+
+func (r *uploadArtifactReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		request, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = request.Data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
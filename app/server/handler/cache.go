@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/log"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+func (h *Handler) GetCache(ctx context.Context, request *api.GetCacheRequest) (*api.GetCacheResponse, error) {
+	cache, err := h.cacheService.Get(ctx, request.JobExecutionID, request.Key, request.RestoreKeys)
+	if err != nil {
+		return nil, err
+	}
+	if cache == nil {
+		return &api.GetCacheResponse{}, nil
+	}
+	return &api.GetCacheResponse{Cache: db.PackCache(cache)}, nil
+}
+
+func (h *Handler) UploadCache(stream api.Server_UploadCacheServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return errors.WithMessage(err, "failed to receive the first message")
+	}
+	reader := &uploadCacheReader{stream: stream, buf: first.Data}
+	response, err := h.uploadCache(stream.Context(), first, reader)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(response)
+}
+
+// UploadCacheHandler uploads a cache, the request body is the content of the cache.
+func (h *Handler) UploadCacheHandler(c *gin.Context) {
+	request := &api.UploadCacheRequest{}
+	if err := Bind(c, request); err != nil {
+		JSON(c, http.StatusBadRequest, &Message{Message: err})
+		return
+	}
+	response, err := h.uploadCache(c.Request.Context(), request, c.Request.Body)
+	if err != nil {
+		log.ExtractLogger(c).Errorf("failed to upload cache: %+v", err)
+		JSON(c, http.StatusInternalServerError, &Message{Message: err})
+		return
+	}
+	JSON(c, http.StatusOK, response)
+}
+
+func (h *Handler) uploadCache(ctx context.Context, request *api.UploadCacheRequest, content io.Reader,
+) (*api.UploadCacheResponse, error) {
+	log.ExtractLogger(ctx).Infof("upload cache '%s' of job execution '%d'", request.Key, request.JobExecutionID)
+	cache, err := h.cacheService.Save(ctx, request.JobExecutionID, request.Key, content)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to save cache '%s'", request.Key)
+	}
+	return &api.UploadCacheResponse{Cache: db.PackCache(cache)}, nil
+}
+
+func (h *Handler) DownloadCache(request *api.DownloadCacheRequest, stream api.Server_DownloadCacheServer) error {
+	_, content, err := h.cacheService.Open(stream.Context(), request.CacheID)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+	buf := make([]byte, artifactChunkSize)
+	for {
+		n, readErr := io.ReadFull(content, buf)
+		if n > 0 {
+			if err = stream.Send(&api.DownloadCacheResponse{Data: buf[:n]}); err != nil {
+				return errors.WithMessage(err, "failed to send cache")
+			}
+		}
+		if readErr != nil {
+			if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+				return nil
+			}
+			return errors.WithMessage(readErr, "failed to read cache")
+		}
+	}
+}
+
+// DownloadCacheHandler responds the content of a cache as a tar file.
+func (h *Handler) DownloadCacheHandler(c *gin.Context) {
+	request := &api.DownloadCacheRequest{}
+	if err := Bind(c, request); err != nil {
+		JSON(c, http.StatusBadRequest, &Message{Message: err})
+		return
+	}
+	cache, content, err := h.cacheService.Open(c.Request.Context(), request.CacheID)
+	if err != nil {
+		log.ExtractLogger(c).Errorf("failed to open cache: %+v", err)
+		statusCode := http.StatusInternalServerError
+		if db.IsRecordNotFoundError(err) {
+			statusCode = http.StatusNotFound
+		}
+		JSON(c, statusCode, &Message{Message: err})
+		return
+	}
+	defer content.Close()
+	header := c.Writer.Header()
+	header.Set("Content-Type", "application/x-tar")
+	header.Set("Content-Length", strconv.FormatInt(cache.Size, 10))
+	header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"cache-%d.tar\"", cache.ID))
+	c.Status(http.StatusOK)
+	if _, err = io.Copy(c.Writer, content); err != nil {
+		log.ExtractLogger(c).Errorf("failed to write cache: %+v", err)
+	}
+}
+
+func (h *Handler) ListCaches(ctx context.Context, request *api.ListCachesRequest) (*api.ListCachesResponse, error) {
+	caches, totalSize, maxSize, err := h.cacheService.List(ctx, request.Label, request.KeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ListCachesResponse{
+		Caches: lo.Map(caches, func(item *db.Cache, _ int) *api.Cache {
+			return db.PackCache(item)
+		}),
+		TotalSize: totalSize,
+		MaxSize:   maxSize,
+	}, nil
+}
+
+func (h *Handler) DeleteCache(ctx context.Context, request *api.DeleteCacheRequest) (*api.DeleteCacheResponse, error) {
+	if err := h.cacheService.Delete(ctx, request.CacheID); err != nil {
+		if db.IsRecordNotFoundError(err) {
+			return nil, &HTTPError{Code: http.StatusNotFound, CauseError: err}
+		}
+		return nil, err
+	}
+	return &api.DeleteCacheResponse{}, nil
+}
+
+func (h *Handler) SetCacheLimit(ctx context.Context, request *api.SetCacheLimitRequest) (*api.SetCacheLimitResponse, error) {
+	if err := h.cacheService.SetLimit(ctx, request.Label, request.MaxSize); err != nil {
+		return nil, err
+	}
+	return &api.SetCacheLimitResponse{}, nil
+}
+
+// uploadCacheReader reads the data of UploadCacheRequest stream.
+type uploadCacheReader struct {
+	stream api.Server_UploadCacheServer
+	buf    []byte
+}
+
+func (r *uploadCacheReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		request, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = request.Data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cox96de/runner/api"
 	"github.com/cox96de/runner/app/server/dispatch"
 	"github.com/cox96de/runner/app/server/logstorage"
+	"github.com/cox96de/runner/app/server/notify"
 	"github.com/cox96de/runner/app/server/pipeline"
 	"github.com/cox96de/runner/mock"
 	"gotest.tools/v3/assert"
@@ -19,9 +20,9 @@ func TestHandler_CancelJobExecution(t *testing.T) {
 	ctx := context.Background()
 	db := mock.NewMockDB(t)
 	eventHook := eventhook.NewService(eventhook.NewNopSender())
-	handler := NewHandler(db, pipeline.NewService(db), dispatch.NewService(db, eventHook), mock.NewMockLocker(),
+	handler := NewHandler(db, pipeline.NewService(db), dispatch.NewService(db, eventHook, notify.NewLocalNotifier()), mock.NewMockLocker(),
 		logstorage.NewService(mock.NewMockRedis(t), logstorage.NewFilesystemOSS(fs.NewDir(t, "test").Path())),
-		eventHook)
+		eventHook, nil, nil, nil, nil)
 	t.Run("from_running", func(t *testing.T) {
 		job := CreateAndPushToStatus(t, handler, &api.PipelineDSL{
 			Jobs: []*api.JobDSL{{
@@ -0,0 +1,208 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/log"
+	"github.com/gin-gonic/gin"
+)
+
+// debugAcceptTimeout is the max duration to wait for the agent to serve a debug session. Sessions are announced
+// to agents by heartbeats, so it's longer than the heartbeat interval of agents.
+const debugAcceptTimeout = time.Second * 30
+
+func (h *Handler) Debug(stream api.Server_DebugServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return errors.WithMessage(err, "failed to receive the first message")
+	}
+	return h.debug(stream.Context(), first, stream.Recv, stream.Send)
+}
+
+// DebugHandler serves a debug session over a connection upgraded from http.
+// The first message carries the command, the job execution is specified by the path.
+func (h *Handler) DebugHandler(c *gin.Context) {
+	request := &api.DebugRequest{}
+	if err := Bind(c, request); err != nil {
+		JSON(c, http.StatusBadRequest, &Message{Message: err})
+		return
+	}
+	conn, err := upgrade(c)
+	if err != nil {
+		JSON(c, http.StatusBadRequest, &Message{Message: err})
+		return
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	first := &api.DebugRequest{}
+	if err = conn.recv(first); err != nil {
+		log.ExtractLogger(c).Errorf("failed to receive the first message: %+v", err)
+		return
+	}
+	first.JobExecutionID = request.JobExecutionID
+	err = h.debug(ctx, first, func() (*api.DebugRequest, error) {
+		request := &api.DebugRequest{}
+		if err := conn.recv(request); err != nil {
+			if !errors.Is(err, io.EOF) {
+				// The user is gone.
+				cancel()
+			}
+			return nil, err
+		}
+		return request, nil
+	}, func(response *api.DebugResponse) error {
+		return conn.send(response)
+	})
+	if err != nil {
+		log.ExtractLogger(c).Errorf("failed to debug: %+v", err)
+		_ = conn.sendError(err)
+		return
+	}
+	_ = conn.end()
+}
+
+// debug opens a debug session of the job execution and relays it until the command exits.
+func (h *Handler) debug(ctx context.Context, first *api.DebugRequest, recv func() (*api.DebugRequest, error),
+	send func(response *api.DebugResponse) error,
+) error {
+	if first.Start == nil {
+		return errors.New("start is required in the first message")
+	}
+	jobExecution, err := h.db.GetJobExecution(ctx, first.JobExecutionID)
+	if err != nil {
+		return errors.WithMessagef(err, "failed to get job execution '%d'", first.JobExecutionID)
+	}
+	if jobExecution.Status == api.StatusCreated || jobExecution.Status == api.StatusQueued {
+		return errors.Errorf("job execution '%d' is not started", jobExecution.ID)
+	}
+	session := h.debugBroker.Open(jobExecution.ID)
+	defer session.Close()
+	log.ExtractLogger(ctx).Infof("open debug session '%s' of job execution '%d', commands: %v", session.ID,
+		jobExecution.ID, first.Start.Commands)
+	acceptCtx, cancel := context.WithTimeout(ctx, debugAcceptTimeout)
+	err = session.WaitAccepted(acceptCtx)
+	cancel()
+	if err != nil {
+		return errors.WithMessagef(err, "runner of job execution '%d' is not available", jobExecution.ID)
+	}
+	if err = session.SendRequest(ctx, first); err != nil {
+		return errors.WithMessage(err, "failed to send to runner")
+	}
+	go func() {
+		for {
+			request, err := recv()
+			if err != nil {
+				return
+			}
+			if err = session.SendRequest(ctx, request); err != nil {
+				return
+			}
+		}
+	}()
+	for {
+		response, err := session.RecvResponse(ctx)
+		if err != nil {
+			return errors.WithMessage(err, "failed to receive from runner")
+		}
+		if err = send(response); err != nil {
+			return errors.WithMessage(err, "failed to send response")
+		}
+		if response.Exited {
+			return nil
+		}
+	}
+}
+
+func (h *Handler) ServeDebug(stream api.Server_ServeDebugServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return errors.WithMessage(err, "failed to receive the first message")
+	}
+	return h.serveDebug(stream.Context(), first.SessionID, stream.Recv, stream.Send)
+}
+
+// ServeDebugHandler serves a debug session for the agent over a connection upgraded from http.
+// The session is specified by the path.
+func (h *Handler) ServeDebugHandler(c *gin.Context) {
+	request := &api.ServeDebugRequest{}
+	if err := Bind(c, request); err != nil {
+		JSON(c, http.StatusBadRequest, &Message{Message: err})
+		return
+	}
+	conn, err := upgrade(c)
+	if err != nil {
+		JSON(c, http.StatusBadRequest, &Message{Message: err})
+		return
+	}
+	defer conn.Close()
+	err = h.serveDebug(c.Request.Context(), request.SessionID, func() (*api.ServeDebugRequest, error) {
+		request := &api.ServeDebugRequest{}
+		if err := conn.recv(request); err != nil {
+			return nil, err
+		}
+		return request, nil
+	}, func(request *api.DebugRequest) error {
+		return conn.send(request)
+	})
+	if err != nil {
+		log.ExtractLogger(c).Errorf("failed to serve debug session: %+v", err)
+		_ = conn.sendError(err)
+		return
+	}
+	_ = conn.end()
+}
+
+// serveDebug relays the debug session between the user and the agent until either of them ends.
+func (h *Handler) serveDebug(ctx context.Context, sessionID string, recv func() (*api.ServeDebugRequest, error),
+	send func(request *api.DebugRequest) error,
+) error {
+	session, err := h.debugBroker.Accept(sessionID)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+	// ctx is canceled when the agent ends the stream.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	received := make(chan error, 1)
+	go func() {
+		defer cancel()
+		for {
+			request, err := recv()
+			if err != nil {
+				received <- err
+				return
+			}
+			if request.Response == nil {
+				continue
+			}
+			if err = session.SendResponse(ctx, request.Response); err != nil {
+				return
+			}
+		}
+	}()
+	// Requests are sent in this goroutine, the stream can't be sent after returning.
+	for {
+		request, err := session.RecvRequest(ctx)
+		if err != nil {
+			break
+		}
+		if err = send(request); err != nil {
+			return errors.WithMessage(err, "failed to send to agent")
+		}
+	}
+	select {
+	case err = <-received:
+		if !errors.Is(err, io.EOF) {
+			return errors.WithMessage(err, "failed to receive from agent")
+		}
+	default:
+	}
+	return nil
+}
@@ -3,6 +3,12 @@ package handler
 import (
 	"context"
 
+	"github.com/cox96de/runner/app/server/artifact"
+	"github.com/cox96de/runner/app/server/cache"
+	"github.com/cox96de/runner/app/server/debug"
+	"github.com/cox96de/runner/app/server/schedule"
+	"github.com/cox96de/runner/app/server/secret"
+
 	"github.com/cox96de/runner/app/server/eventhook"
 
 	"github.com/cox96de/runner/app/server/logstorage"
@@ -14,6 +20,7 @@ import (
 	"github.com/cox96de/runner/lib"
 
 	"github.com/cox96de/runner/db"
+	"github.com/hashicorp/golang-lru/v2/expirable"
 )
 
 var _ api.ServerServer = (*Handler)(nil)
@@ -27,6 +34,13 @@ type Handler struct {
 	logService      *logstorage.Service
 	locker          lib.Locker
 	eventhook       *eventhook.Service
+	artifactService *artifact.Service
+	cacheService    *cache.Service
+	secretService   *secret.Service
+	scheduleService *schedule.Service
+	debugBroker     *debug.Broker
+	// maskers caches maskers of secrets in logs by job execution id.
+	maskers *expirable.LRU[int64, *lib.Masker]
 }
 
 // nolint: unused
@@ -34,11 +48,14 @@ func (h *Handler) mustEmbedUnimplementedServerServer() {
 }
 
 func NewHandler(db *db.Client, pipelineService *pipeline.Service, dispatchService *dispatch.Service,
-	locker lib.Locker, logService *logstorage.Service, eventhook *eventhook.Service,
+	locker lib.Locker, logService *logstorage.Service, eventhook *eventhook.Service, artifactService *artifact.Service,
+	cacheService *cache.Service, secretService *secret.Service, scheduleService *schedule.Service,
 ) *Handler {
 	return &Handler{
 		db: db, pipelineService: pipelineService, dispatchService: dispatchService, locker: locker,
-		logService: logService, eventhook: eventhook,
+		logService: logService, eventhook: eventhook, artifactService: artifactService, cacheService: cacheService,
+		secretService: secretService, scheduleService: scheduleService, debugBroker: debug.NewBroker(),
+		maskers: newMaskerCache(),
 	}
 }
 
@@ -24,6 +24,7 @@ func (h *Handler) Heartbeat(ctx context.Context, request *api.HeartbeatRequest)
 		return nil, errors.WithMessagef(err, "failed to touch heartbeat for job execution %d", jobExecution.ID)
 	}
 	return &api.HeartbeatResponse{
-		Status: jobExecution.Status,
+		Status:        jobExecution.Status,
+		DebugSessions: h.debugBroker.Pending(jobExecution.ID),
 	}, nil
 }
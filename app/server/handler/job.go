@@ -41,6 +41,16 @@ func (h *Handler) UpdateJobExecution(ctx context.Context, request *api.UpdateJob
 		return nil, errors.WithMessagef(err, "failed to get job execution '%d'", request.JobExecutionID)
 	}
 	targetStatus := request.Status
+	if targetStatus == nil && len(request.Outputs) > 0 {
+		logger.Infof("update outputs of job execution: %v", lo.Keys(request.Outputs))
+		jobExecution, err = h.db.UpdateJobExecution(ctx, &db.UpdateJobExecutionOption{
+			ID:      jobExecution.ID,
+			Outputs: request.Outputs,
+		})
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to update outputs of job execution '%d'", request.JobExecutionID)
+		}
+	}
 	if targetStatus != nil {
 		// If not dispatch, cancel would lead to failed.
 		if *targetStatus == api.StatusCanceling && jobExecution.Status.IsPreDispatch() {
@@ -55,9 +65,10 @@ func (h *Handler) UpdateJobExecution(ctx context.Context, request *api.UpdateJob
 
 		jobExecution.Status = *targetStatus
 		updateJobExecutionOption := &db.UpdateJobExecutionOption{
-			ID:     jobExecution.ID,
-			Status: targetStatus,
-			Reason: request.Reason,
+			ID:      jobExecution.ID,
+			Status:  targetStatus,
+			Reason:  request.Reason,
+			Outputs: request.Outputs,
 		}
 		switch {
 		case *targetStatus == api.StatusPreparing:
@@ -125,75 +136,74 @@ func (h *Handler) GetJobExecution(ctx context.Context, in *api.GetJobExecutionRe
 	return &api.GetJobExecutionResponse{JobExecution: jobExecution}, err
 }
 
-// RerunJob rerun the job by the latest job execution.
-// TODO: this implementation is not correct, rerun should create new job executions include jobs depends on it.
+// RerunJob reruns the job with a new job execution.
+// If WithDependents is set, jobs depend on it are rerun with new job executions as well.
 func (h *Handler) RerunJob(ctx context.Context, in *api.RerunJobRequest) (*api.RerunJobResponse, error) {
 	logger := log.ExtractLogger(ctx)
-	logger.Infof("rerun job: %d", in.JobID)
-	var latestJobExecution *db.JobExecution
-	jobExecutions, err := h.db.GetJobExecutionsByJobID(ctx, in.JobID)
-	if err != nil {
-		return nil, errors.WithMessagef(err, "failed to job executions '%d'", in.JobID)
-	}
-	// Get the latest job execution.
-	latestJobExecution = getLatestJobExecution(jobExecutions)
-	if !latestJobExecution.Status.IsCompleted() {
-		return nil, errors.Errorf("job '%d' is not completed", in.JobID)
-	}
-	job, err := h.db.GetJobByID(ctx, latestJobExecution.JobID)
+	logger.Infof("rerun job: %d, with dependents: %t", in.JobID, in.WithDependents)
+	job, err := h.db.GetJobByID(ctx, in.JobID)
 	if err != nil {
-		return nil, errors.WithMessagef(err, "failed to get job '%d'", latestJobExecution.JobID)
+		return nil, errors.WithMessagef(err, "failed to get job '%d'", in.JobID)
 	}
-	latestJobExecution, err = h.resetJobExecution(ctx, latestJobExecution)
+	executions, err := h.rerun(ctx, job.PipelineID, func(j *db.Job, _ *db.JobExecution) bool {
+		return j.ID == job.ID
+	}, in.WithDependents)
 	if err != nil {
-		return nil, errors.WithMessage(err, "failed to reset job execution")
+		return nil, errors.WithMessagef(err, "failed to rerun job '%d'", in.JobID)
 	}
-	// FIXME: if dispatch failed, cannot redispatch.
-	err = h.dispatchService.Dispatch(ctx, []*db.Job{job}, []*db.JobExecution{latestJobExecution})
-	if err != nil {
-		return nil, errors.WithMessage(err, "failed to dispatch job")
+	resp := &api.RerunJobResponse{}
+	for _, execution := range executions {
+		if execution.JobID == job.ID {
+			resp.JobExecution = execution
+			continue
+		}
+		resp.Dependents = append(resp.Dependents, execution)
 	}
-	execution, err := db.PackJobExecution(latestJobExecution, nil)
+	return resp, nil
+}
+
+// RerunPipeline reruns jobs of the pipeline with new job executions.
+// If FailedOnly is set, only failed jobs and jobs depend on them are rerun.
+func (h *Handler) RerunPipeline(ctx context.Context, in *api.RerunPipelineRequest) (*api.RerunPipelineResponse, error) {
+	logger := log.ExtractLogger(ctx)
+	logger.Infof("rerun pipeline: %d, failed only: %t", in.PipelineID, in.FailedOnly)
+	executions, err := h.rerun(ctx, in.PipelineID, func(_ *db.Job, latest *db.JobExecution) bool {
+		return !in.FailedOnly || latest.Status == api.StatusFailed
+	}, in.FailedOnly)
 	if err != nil {
-		return nil, errors.WithMessage(err, "failed to pack job execution")
+		return nil, errors.WithMessagef(err, "failed to rerun pipeline '%d'", in.PipelineID)
 	}
-	// FIXME: the status of job execution might be not correct because of dispatching.
-	return &api.RerunJobResponse{
-		JobExecution: execution,
-	}, nil
+	return &api.RerunPipelineResponse{JobExecutions: executions}, nil
 }
 
-func (h *Handler) resetJobExecution(ctx context.Context, latestJobExecution *db.JobExecution) (*db.JobExecution, error) {
-	err := h.db.Transaction(func(dbClient *db.Client) error {
-		stepExecutions, err := dbClient.GetStepExecutionsByJobExecutionID(ctx, latestJobExecution.ID)
-		if err != nil {
-			return errors.WithMessagef(err, "failed to get step executions for job execution '%d'", latestJobExecution.ID)
-		}
-		err = dbClient.ResetStepExecutions(ctx, lo.Map(stepExecutions, func(stepExecution *db.StepExecution, _ int) int64 {
-			return stepExecution.ID
-		}))
-		if err != nil {
-			return errors.WithMessage(err, "failed to update step executions")
-		}
-		err = dbClient.ResetJobExecution(ctx, latestJobExecution.ID)
+// rerun creates new executions for the jobs selected by filter and dispatches them.
+// It returns the packed created job executions.
+func (h *Handler) rerun(ctx context.Context, pipelineID int64, filter func(job *db.Job, latest *db.JobExecution) bool,
+	withDependents bool,
+) ([]*api.JobExecution, error) {
+	rerunResponse, err := h.pipelineService.Rerun(ctx, pipelineID, filter, withDependents)
+	if err != nil {
+		return nil, err
+	}
+	// Dispatch all jobs of the pipeline, so that the dependencies of rerun jobs are respected.
+	if err = h.dispatchService.Dispatch(ctx, pipelineID); err != nil {
+		return nil, errors.WithMessage(err, "failed to dispatch jobs")
+	}
+	stepExecutions := lo.GroupBy(rerunResponse.CreatedStepExecutions, func(item *db.StepExecution) int64 {
+		return item.JobExecutionID
+	})
+	result := make([]*api.JobExecution, 0, len(rerunResponse.CreatedJobExecutions))
+	for _, created := range rerunResponse.CreatedJobExecutions {
+		// Reload the job execution as the status might be changed by dispatching.
+		jobExecution, err := h.db.GetJobExecution(ctx, created.ID)
 		if err != nil {
-			return errors.WithMessagef(err, "failed to reset job execution '%d'", latestJobExecution.ID)
+			return nil, errors.WithMessagef(err, "failed to get job execution '%d'", created.ID)
 		}
-		latestJobExecution, err = dbClient.GetJobExecution(ctx, latestJobExecution.ID)
+		execution, err := db.PackJobExecution(jobExecution, stepExecutions[created.ID])
 		if err != nil {
-			return errors.WithMessagef(err, "failed to get job execution '%d'", latestJobExecution.ID)
-		}
-		return nil
-	})
-	return latestJobExecution, err
-}
-
-func getLatestJobExecution(jobExecutions []*db.JobExecution) *db.JobExecution {
-	var latestJobExecution *db.JobExecution
-	for _, jobExecution := range jobExecutions {
-		if latestJobExecution == nil || jobExecution.CreatedAt.After(latestJobExecution.CreatedAt) {
-			latestJobExecution = jobExecution
+			return nil, errors.WithMessage(err, "failed to pack job execution")
 		}
+		result = append(result, execution)
 	}
-	return latestJobExecution
+	return result, nil
 }
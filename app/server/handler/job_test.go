@@ -10,6 +10,7 @@ import (
 
 	"github.com/cox96de/runner/app/server/dispatch"
 	"github.com/cox96de/runner/app/server/eventhook"
+	"github.com/cox96de/runner/app/server/notify"
 
 	"github.com/cox96de/runner/api"
 	"github.com/cox96de/runner/db"
@@ -21,8 +22,8 @@ import (
 func TestHandler_UpdateJobExecution(t *testing.T) {
 	dbCli := mock.NewMockDB(t)
 	eventHook := eventhook.NewService(eventhook.NewNopSender())
-	handler := NewHandler(dbCli, nil, dispatch.NewService(dbCli, eventHook), mock.NewMockLocker(),
-		nil, eventHook)
+	handler := NewHandler(dbCli, nil, dispatch.NewService(dbCli, eventHook, notify.NewLocalNotifier()), mock.NewMockLocker(),
+		nil, eventHook, nil, nil, nil, nil)
 	jobs, err := handler.db.CreateJobs(context.Background(), []*db.CreateJobOption{
 		{
 			PipelineID: 1,
@@ -54,6 +55,15 @@ func TestHandler_UpdateJobExecution(t *testing.T) {
 		assert.NilError(t, err)
 		assert.DeepEqual(t, execution.Status, api.StatusQueued)
 	})
+	t.Run("outputs", func(t *testing.T) {
+		resp, err := handler.UpdateJobExecution(context.Background(), &api.UpdateJobExecutionRequest{
+			JobExecutionID: executions[0].ID,
+			Outputs:        map[string]string{"version": "1.0"},
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, resp.JobExecution.Outputs, map[string]string{"version": "1.0"})
+		assert.Equal(t, resp.JobExecution.Status, api.StatusQueued)
+	})
 	t.Run("ListJobExecutions", func(t *testing.T) {
 		listJobExecutionsResponse, err := handler.ListJobExecutions(context.Background(), &api.ListJobExecutionsRequest{
 			JobID: 1,
@@ -106,12 +116,15 @@ func TestHandler_UpdateJobExecution(t *testing.T) {
 }
 
 func TestHandler_RerunJob(t *testing.T) {
-	t.Run("rerun", func(t *testing.T) {
+	newHandler := func(t *testing.T) *Handler {
 		dbCli := mock.NewMockDB(t)
 		eventHook := eventhook.NewService(eventhook.NewNopSender())
 		mockLogService := logstorage.NewService(mock.NewMockRedis(t), logstorage.NewFilesystemOSS(fs.NewDir(t, "baseDir").Path()))
-		handler := NewHandler(dbCli, pipeline2.NewService(dbCli), dispatch.NewService(dbCli, eventHook),
-			mock.NewMockLocker(), mockLogService, eventHook)
+		return NewHandler(dbCli, pipeline2.NewService(dbCli), dispatch.NewService(dbCli, eventHook, notify.NewLocalNotifier()),
+			mock.NewMockLocker(), mockLogService, eventHook, nil, nil, nil, nil)
+	}
+	t.Run("rerun", func(t *testing.T) {
+		handler := newHandler(t)
 		createPipelineResp, err := handler.CreatePipeline(context.Background(), &api.CreatePipelineRequest{Pipeline: &api.PipelineDSL{Jobs: []*api.JobDSL{
 			{
 				Name: "Job",
@@ -128,8 +141,14 @@ func TestHandler_RerunJob(t *testing.T) {
 		assert.NilError(t, err)
 		job := createPipelineResp.Pipeline.Jobs[0]
 
-		jobExecutions, err := dbCli.GetJobExecutionsByJobID(context.Background(), job.ID)
+		jobExecutions, err := handler.db.GetJobExecutionsByJobID(context.Background(), job.ID)
 		jobExecution := jobExecutions[len(jobExecutions)-1]
+		t.Run("not_completed", func(t *testing.T) {
+			_, err := handler.RerunJob(context.Background(), &api.RerunJobRequest{
+				JobID: job.ID,
+			})
+			assert.ErrorContains(t, err, "not completed")
+		})
 		PushJobToStatus(t, handler, context.Background(), jobExecution.ID, jobExecution.Status, api.StatusFailed)
 		assert.NilError(t, err)
 		rerunJobResponse, err := handler.RerunJob(context.Background(), &api.RerunJobRequest{
@@ -137,9 +156,87 @@ func TestHandler_RerunJob(t *testing.T) {
 		})
 		assert.NilError(t, err)
 		assert.Assert(t, rerunJobResponse.JobExecution.JobID == job.ID)
-		assert.DeepEqual(t, rerunJobResponse.JobExecution.Status, api.StatusCreated)
+		assert.Assert(t, rerunJobResponse.JobExecution.ID != jobExecution.ID)
+		assert.DeepEqual(t, rerunJobResponse.JobExecution.Status, api.StatusQueued)
+		assert.Equal(t, len(rerunJobResponse.JobExecution.Steps), 1)
 		for _, step := range rerunJobResponse.JobExecution.Steps {
 			assert.DeepEqual(t, step.Status, api.StatusCreated)
 		}
+		// The old job execution is kept.
+		oldJobExecution, err := handler.db.GetJobExecution(context.Background(), jobExecution.ID)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, oldJobExecution.Status, api.StatusFailed)
+		jobExecutions, err = handler.db.GetJobExecutionsByJobID(context.Background(), job.ID)
+		assert.NilError(t, err)
+		assert.Equal(t, len(jobExecutions), 2)
+	})
+	t.Run("with_dependents", func(t *testing.T) {
+		handler := newHandler(t)
+		// job1 <- job2 <- job3, job4
+		createPipelineResp, err := handler.CreatePipeline(context.Background(), &api.CreatePipelineRequest{Pipeline: &api.PipelineDSL{Jobs: []*api.JobDSL{
+			{Name: "job1", RunsOn: &api.RunsOn{Label: "label"}, Steps: []*api.StepDSL{{Name: "step"}}},
+			{Name: "job2", RunsOn: &api.RunsOn{Label: "label"}, Steps: []*api.StepDSL{{Name: "step"}}, DependsOn: []string{"job1"}},
+			{Name: "job3", RunsOn: &api.RunsOn{Label: "label"}, Steps: []*api.StepDSL{{Name: "step"}}, DependsOn: []string{"job2"}},
+			{Name: "job4", RunsOn: &api.RunsOn{Label: "label"}, Steps: []*api.StepDSL{{Name: "step"}}},
+		}}})
+		assert.NilError(t, err)
+		jobs := lo.SliceToMap(createPipelineResp.Pipeline.Jobs, func(item *api.Job) (string, *api.Job) {
+			return item.Name, item
+		})
+		for _, name := range []string{"job1", "job2", "job3", "job4"} {
+			execution, err := handler.db.GetJobExecution(context.Background(), jobs[name].Execution.ID)
+			assert.NilError(t, err)
+			PushJobToStatus(t, handler, context.Background(), execution.ID, execution.Status, api.StatusSucceeded)
+		}
+		rerunJobResponse, err := handler.RerunJob(context.Background(), &api.RerunJobRequest{
+			JobID:          jobs["job1"].ID,
+			WithDependents: true,
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, rerunJobResponse.JobExecution.JobID, jobs["job1"].ID)
+		assert.DeepEqual(t, rerunJobResponse.JobExecution.Status, api.StatusQueued)
+		assert.DeepEqual(t, lo.Map(rerunJobResponse.Dependents, func(item *api.JobExecution, _ int) int64 {
+			return item.JobID
+		}), []int64{jobs["job2"].ID, jobs["job3"].ID})
+		for _, dependent := range rerunJobResponse.Dependents {
+			// Dependents wait for the new execution of job1.
+			assert.DeepEqual(t, dependent.Status, api.StatusCreated)
+		}
+	})
+	t.Run("pipeline_failed_only", func(t *testing.T) {
+		handler := newHandler(t)
+		// job1 <- job2, job3
+		createPipelineResp, err := handler.CreatePipeline(context.Background(), &api.CreatePipelineRequest{Pipeline: &api.PipelineDSL{Jobs: []*api.JobDSL{
+			{Name: "job1", RunsOn: &api.RunsOn{Label: "label"}, Steps: []*api.StepDSL{{Name: "step"}}},
+			{Name: "job2", RunsOn: &api.RunsOn{Label: "label"}, Steps: []*api.StepDSL{{Name: "step"}}, DependsOn: []string{"job1"}},
+			{Name: "job3", RunsOn: &api.RunsOn{Label: "label"}, Steps: []*api.StepDSL{{Name: "step"}}},
+		}}})
+		assert.NilError(t, err)
+		pipelineID := createPipelineResp.Pipeline.ID
+		jobs := lo.SliceToMap(createPipelineResp.Pipeline.Jobs, func(item *api.Job) (string, *api.Job) {
+			return item.Name, item
+		})
+		PushJobToStatus(t, handler, context.Background(), jobs["job1"].Execution.ID, api.StatusQueued, api.StatusFailed)
+		PushJobToStatus(t, handler, context.Background(), jobs["job3"].Execution.ID, api.StatusQueued, api.StatusSucceeded)
+		job2Execution, err := handler.db.GetJobExecution(context.Background(), jobs["job2"].Execution.ID)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, job2Execution.Status, api.StatusSkipped)
+
+		rerunPipelineResponse, err := handler.RerunPipeline(context.Background(), &api.RerunPipelineRequest{
+			PipelineID: pipelineID,
+			FailedOnly: true,
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, lo.Map(rerunPipelineResponse.JobExecutions, func(item *api.JobExecution, _ int) int64 {
+			return item.JobID
+		}), []int64{jobs["job1"].ID, jobs["job2"].ID})
+		assert.DeepEqual(t, rerunPipelineResponse.JobExecutions[0].Status, api.StatusQueued)
+		assert.DeepEqual(t, rerunPipelineResponse.JobExecutions[1].Status, api.StatusCreated)
+		t.Run("all", func(t *testing.T) {
+			_, err := handler.RerunPipeline(context.Background(), &api.RerunPipelineRequest{
+				PipelineID: pipelineID,
+			})
+			assert.ErrorContains(t, err, "not completed")
+		})
 	})
 }
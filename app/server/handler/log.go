@@ -1,20 +1,95 @@
 package handler
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/lib"
+	"github.com/cox96de/runner/log"
+	"github.com/gin-gonic/gin"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/samber/lo"
 )
 
 func (h *Handler) UploadLogLines(ctx context.Context, request *api.UpdateLogLinesRequest) (*api.UpdateLogLinesResponse, error) {
 	// TODO: check if job & executions exists
-	err := h.logService.Append(ctx, request.JobExecutionID, request.Name, request.Lines)
+	// Agents mask secrets already, it's a safety net in case some of them are missed.
+	masker, err := h.logMasker(ctx, request.JobExecutionID)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range request.Lines {
+		line.Output = masker.Mask(line.Output)
+	}
+	err = h.logService.Append(ctx, request.JobExecutionID, request.Name, request.Lines)
 	if err != nil {
 		return nil, err
 	}
 	return &api.UpdateLogLinesResponse{}, nil
 }
 
+// logMasker returns the masker of secrets referred by the job of the job execution.
+// It returns nil if the job doesn't refer to any secret, or the job execution is not found. Maskers are cached for
+// a while, as logs are uploaded frequently when steps are running.
+func (h *Handler) logMasker(ctx context.Context, jobExecutionID int64) (*lib.Masker, error) {
+	if h.secretService == nil {
+		return nil, nil
+	}
+	if masker, ok := h.maskers.Get(jobExecutionID); ok {
+		return masker, nil
+	}
+	jobExecution, err := h.db.GetJobExecution(ctx, jobExecutionID)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			log.ExtractLogger(ctx).Warnf("job execution '%d' is not found, logs are not masked", jobExecutionID)
+			return nil, nil
+		}
+		return nil, errors.WithMessagef(err, "failed to get job execution '%d'", jobExecutionID)
+	}
+	job, err := h.db.GetJobByID(ctx, jobExecution.JobID)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to get job '%d'", jobExecution.JobID)
+	}
+	var masker *lib.Masker
+	if len(job.Secrets) > 0 {
+		var secrets map[string]string
+		if err = json.Unmarshal(job.Secrets, &secrets); err != nil {
+			return nil, errors.WithMessage(err, "failed to unmarshal job.Secrets")
+		}
+		pipeline, err := h.db.GetPipeline(ctx, job.PipelineID)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to get pipeline '%d'", job.PipelineID)
+		}
+		values, err := h.secretService.Values(ctx, pipeline.Project, lo.Values(secrets))
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed to get values of secrets")
+		}
+		masker = lib.NewMasker(values)
+	}
+	h.maskers.Add(jobExecutionID, masker)
+	return masker, nil
+}
+
+const (
+	// maskerCacheTTL is the duration a masker is cached. Secrets updated in the meantime are masked after it.
+	maskerCacheTTL = time.Minute
+	// maskerCacheSize is the max number of cached maskers.
+	maskerCacheSize = 1024
+)
+
+// newMaskerCache creates a cache of maskers of job executions. Least recently used maskers are evicted when it's full.
+// A nil masker is cached for jobs without secrets.
+func newMaskerCache() *expirable.LRU[int64, *lib.Masker] {
+	return expirable.NewLRU[int64, *lib.Masker](maskerCacheSize, nil, maskerCacheTTL)
+}
+
 func (h *Handler) GetLogLines(ctx context.Context, request *api.GetLogLinesRequest) (*api.GetLogLinesResponse, error) {
 	// TODO: check if job & executions exists
 	limit := int64(-1)
@@ -30,3 +105,87 @@ func (h *Handler) GetLogLines(ctx context.Context, request *api.GetLogLinesReque
 		Lines: logLines,
 	}, nil
 }
+
+func (h *Handler) StreamLogLines(request *api.StreamLogLinesRequest, stream api.Server_StreamLogLinesServer) error {
+	return h.streamLogLines(stream.Context(), request, stream.Send)
+}
+
+const (
+	sseEventLines = "lines"
+	sseEventEnd   = "end"
+	sseEventError = "error"
+)
+
+// StreamLogLinesHandler streams log lines as server-sent events.
+// Each event carries a StreamLogLinesResponse, and its id is the offset of the next line. The stream can be resumed
+// by `Last-Event-ID` header or `offset` query.
+func (h *Handler) StreamLogLinesHandler(c *gin.Context) {
+	request := &api.StreamLogLinesRequest{}
+	if err := Bind(c, request); err != nil {
+		JSON(c, http.StatusBadRequest, &Message{Message: err})
+		return
+	}
+	if lastEventID := c.GetHeader("Last-Event-ID"); lastEventID != "" {
+		offset, err := strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil {
+			JSON(c, http.StatusBadRequest, &Message{Message: errors.WithMessage(err, "invalid Last-Event-ID")})
+			return
+		}
+		request.Offset = offset
+	}
+	header := c.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	c.Status(http.StatusOK)
+	err := h.streamLogLines(c.Request.Context(), request, func(response *api.StreamLogLinesResponse) error {
+		return writeSSEvent(c, strconv.FormatInt(response.Offset, 10), sseEventLines, response)
+	})
+	if err != nil {
+		log.ExtractLogger(c).Errorf("failed to stream log lines: %+v", err)
+		_ = writeSSEvent(c, "", sseEventError, &Message{Message: err})
+		return
+	}
+	_ = writeSSEvent(c, "", sseEventEnd, struct{}{})
+}
+
+func writeSSEvent(c *gin.Context, id string, event string, data interface{}) error {
+	bs, err := jj.Marshal(data)
+	if err != nil {
+		return err
+	}
+	buf := &bytes.Buffer{}
+	if id != "" {
+		buf.WriteString("id: " + id + "\n")
+	}
+	buf.WriteString("event: " + event + "\n")
+	buf.WriteString("data: ")
+	buf.Write(bs)
+	buf.WriteString("\n\n")
+	if _, err = c.Writer.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	c.Writer.Flush()
+	return nil
+}
+
+func (h *Handler) streamLogLines(ctx context.Context, request *api.StreamLogLinesRequest,
+	send func(response *api.StreamLogLinesResponse) error,
+) error {
+	logger := log.ExtractLogger(ctx)
+	logger.Infof("stream log lines of job execution '%d', name: %s, offset: %d", request.JobExecutionID,
+		request.Name, request.Offset)
+	if _, err := h.db.GetJobExecution(ctx, request.JobExecutionID); err != nil {
+		return errors.WithMessagef(err, "failed to get job execution '%d'", request.JobExecutionID)
+	}
+	return h.logService.StreamLogLines(ctx, request.JobExecutionID, request.Name, request.Offset,
+		func(ctx context.Context) (bool, error) {
+			jobExecution, err := h.db.GetJobExecution(ctx, request.JobExecutionID)
+			if err != nil {
+				return false, errors.WithMessagef(err, "failed to get job execution '%d'", request.JobExecutionID)
+			}
+			return jobExecution.Status.IsCompleted(), nil
+		}, func(lines []*api.LogLine, offset int64) error {
+			return send(&api.StreamLogLinesResponse{Lines: lines, Offset: offset})
+		})
+}
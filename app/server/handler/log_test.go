@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/app/server/dispatch"
+	"github.com/cox96de/runner/app/server/eventhook"
+	"github.com/cox96de/runner/app/server/logstorage"
+	"github.com/cox96de/runner/app/server/notify"
+	"github.com/cox96de/runner/app/server/pipeline"
+	"github.com/cox96de/runner/app/server/secret"
+	"github.com/cox96de/runner/mock"
+	"github.com/gin-gonic/gin"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+)
+
+func TestHandler_StreamLogLinesHandler(t *testing.T) {
+	dbClient := mock.NewMockDB(t)
+	eventHook := eventhook.NewService(eventhook.NewNopSender())
+	logService := logstorage.NewService(mock.NewMockRedis(t), logstorage.NewFilesystemOSS(fs.NewDir(t, "baseDir").Path()))
+	handler := NewHandler(dbClient, pipeline.NewService(dbClient), dispatch.NewService(dbClient, eventHook, notify.NewLocalNotifier()),
+		mock.NewMockLocker(), logService, eventHook, nil, nil, nil, nil)
+	engine := gin.New()
+	handler.RegisterRouter(engine.Group(""))
+	server := httptest.NewServer(engine)
+	defer server.Close()
+	createPipelineResponse, err := handler.CreatePipeline(context.Background(), &api.CreatePipelineRequest{
+		Pipeline: &api.PipelineDSL{
+			Jobs: []*api.JobDSL{
+				{Name: "job1", RunsOn: &api.RunsOn{Label: "label"}, Steps: []*api.StepDSL{{Name: "step1"}}},
+			},
+		},
+	})
+	assert.NilError(t, err)
+	jobExecutionID := createPipelineResponse.Pipeline.Jobs[0].Execution.ID
+	_, err = handler.UploadLogLines(context.Background(), &api.UpdateLogLinesRequest{
+		JobExecutionID: jobExecutionID,
+		Name:           "step1",
+		Lines:          []*api.LogLine{{Number: 0, Output: "hello"}, {Number: 1, Output: "world"}},
+	})
+	assert.NilError(t, err)
+	PushJobToStatus(t, handler, context.Background(), jobExecutionID, api.StatusQueued, api.StatusSucceeded)
+	get := func(t *testing.T, path string, header http.Header) string {
+		req, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		assert.NilError(t, err)
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		resp, err := http.DefaultClient.Do(req)
+		assert.NilError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, resp.StatusCode, http.StatusOK)
+		assert.Equal(t, resp.Header.Get("Content-Type"), "text/event-stream")
+		body, err := io.ReadAll(resp.Body)
+		assert.NilError(t, err)
+		return string(body)
+	}
+	t.Run("archived", func(t *testing.T) {
+		body := get(t, fmt.Sprintf("/api/v1/job_executions/%d/logs/step1/stream", jobExecutionID), nil)
+		assert.Assert(t, strings.Contains(body, "id: 2\nevent: lines\n"), body)
+		assert.Assert(t, strings.Contains(body, "hello"), body)
+		assert.Assert(t, strings.HasSuffix(body, "event: end\ndata: {}\n\n"), body)
+	})
+	t.Run("resume", func(t *testing.T) {
+		body := get(t, fmt.Sprintf("/api/v1/job_executions/%d/logs/step1/stream", jobExecutionID), http.Header{"Last-Event-ID": {"1"}})
+		assert.Assert(t, !strings.Contains(body, "hello"), body)
+		assert.Assert(t, strings.Contains(body, "world"), body)
+	})
+	t.Run("not_found", func(t *testing.T) {
+		body := get(t, fmt.Sprintf("/api/v1/job_executions/%d/logs/step1/stream", jobExecutionID+100), nil)
+		assert.Assert(t, strings.HasPrefix(body, "event: error\n"), body)
+	})
+}
+
+func TestHandler_UploadLogLines(t *testing.T) {
+	dbClient := mock.NewMockDB(t)
+	eventHook := eventhook.NewService(eventhook.NewNopSender())
+	logService := logstorage.NewService(mock.NewMockRedis(t), logstorage.NewFilesystemOSS(fs.NewDir(t, "baseDir").Path()))
+	secretCipher, err := secret.NewCipher(bytes.Repeat([]byte("k"), 32))
+	assert.NilError(t, err)
+	handler := NewHandler(dbClient, pipeline.NewService(dbClient), dispatch.NewService(dbClient, eventHook, notify.NewLocalNotifier()),
+		mock.NewMockLocker(), logService, eventHook, nil, nil, secret.NewService(dbClient, secretCipher), nil)
+	_, err = handler.CreateSecret(context.Background(), &api.CreateSecretRequest{Name: "token", Value: "password"})
+	assert.NilError(t, err)
+	createPipelineResponse, err := handler.CreatePipeline(context.Background(), &api.CreatePipelineRequest{
+		Pipeline: &api.PipelineDSL{
+			Jobs: []*api.JobDSL{{
+				Name:    "job1",
+				RunsOn:  &api.RunsOn{Label: "label"},
+				Secrets: map[string]string{"TOKEN": "token"},
+				Steps:   []*api.StepDSL{{Name: "step1"}},
+			}},
+		},
+	})
+	assert.NilError(t, err)
+	jobExecutionID := createPipelineResponse.Pipeline.Jobs[0].Execution.ID
+	_, err = handler.UploadLogLines(context.Background(), &api.UpdateLogLinesRequest{
+		JobExecutionID: jobExecutionID,
+		Name:           "step1",
+		Lines:          []*api.LogLine{{Number: 0, Output: "token is password"}},
+	})
+	assert.NilError(t, err)
+	getLogLinesResponse, err := handler.GetLogLines(context.Background(), &api.GetLogLinesRequest{
+		JobExecutionID: jobExecutionID,
+		Name:           "step1",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, len(getLogLinesResponse.Lines), 1)
+	assert.Equal(t, getLogLinesResponse.Lines[0].Output, "token is ***")
+	t.Run("cached", func(t *testing.T) {
+		masker, ok := handler.maskers.Get(jobExecutionID)
+		assert.Assert(t, ok)
+		assert.Equal(t, masker.Mask("password"), "***")
+	})
+	t.Run("job_execution_not_found", func(t *testing.T) {
+		_, err := handler.UploadLogLines(context.Background(), &api.UpdateLogLinesRequest{
+			JobExecutionID: jobExecutionID + 1000,
+			Name:           "step1",
+			Lines:          []*api.LogLine{{Number: 0, Output: "line"}},
+		})
+		assert.NilError(t, err)
+	})
+}
+
+func TestMaskerCache(t *testing.T) {
+	c := newMaskerCache()
+	_, ok := c.Get(1)
+	assert.Assert(t, !ok)
+	c.Add(1, nil)
+	masker, ok := c.Get(1)
+	assert.Assert(t, ok)
+	assert.Assert(t, masker == nil)
+	for i := int64(2); i <= maskerCacheSize; i++ {
+		c.Add(i, nil)
+	}
+	// 1 is used recently, so 2 is evicted when the cache is full.
+	_, ok = c.Get(1)
+	assert.Assert(t, ok)
+	c.Add(maskerCacheSize+1, nil)
+	assert.Equal(t, c.Len(), maskerCacheSize)
+	assert.Assert(t, c.Contains(1))
+	assert.Assert(t, !c.Contains(2))
+	assert.Assert(t, c.Contains(maskerCacheSize+1))
+}
@@ -2,6 +2,9 @@ package handler
 
 import (
 	"context"
+	"time"
+
+	"github.com/samber/lo"
 
 	"github.com/cox96de/runner/telemetry/trace"
 
@@ -20,11 +23,15 @@ func (h *Handler) CreatePipeline(ctx context.Context, request *api.CreatePipelin
 	if err := api.ValidateDSL(request.Pipeline); err != nil {
 		return nil, errors.WithMessage(err, "failed to validate pipeline DSL")
 	}
+	// Parameters are known at creation time, so they are rendered before the pipeline is stored.
+	if err := api.InterpolateParameters(request.Pipeline); err != nil {
+		return nil, errors.WithMessage(err, "failed to interpolate parameters")
+	}
 	response, err := h.pipelineService.CreatePipeline(ctx, request.Pipeline)
 	if err != nil {
 		return nil, errors.WithMessage(err, "failed to create pipeline")
 	}
-	if err = h.dispatchService.Dispatch(ctx, response.CreatedJobs, response.CreatedJobExecutions); err != nil {
+	if err = h.dispatchService.Dispatch(ctx, response.CreatedPipeline.ID); err != nil {
 		logger.Warnf("failed to dispatch job: %+v", err)
 	}
 	p, err := packPipeline(response.CreatedPipeline, response.CreatedJobs, response.CreatedJobExecutions,
@@ -32,16 +39,186 @@ func (h *Handler) CreatePipeline(ctx context.Context, request *api.CreatePipelin
 	if err != nil {
 		return nil, errors.WithMessage(err, "failed to pack pipeline")
 	}
+	p.Execution, err = db.PackPipelineExecution(response.CreatedPipelineExecution, response.CreatedJobExecutions)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to pack pipeline execution")
+	}
 	return &api.CreatePipelineResponse{
 		Pipeline: p,
 	}, nil
 }
 
+func (h *Handler) GetPipeline(ctx context.Context, request *api.GetPipelineRequest) (*api.GetPipelineResponse, error) {
+	logger := log.ExtractLogger(ctx)
+	logger.Infof("get pipeline: %d", request.PipelineID)
+	pipeline, err := h.db.GetPipeline(ctx, request.PipelineID)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to get pipeline '%d'", request.PipelineID)
+	}
+	jobs, err := h.db.GetJobsByPipelineID(ctx, pipeline.ID)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to get jobs of pipeline '%d'", pipeline.ID)
+	}
+	latestJobExecutionMap, err := h.pipelineService.GetLatestJobExecutions(ctx, jobs)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get latest job executions")
+	}
+	latestJobExecutions := lo.Map(jobs, func(job *db.Job, _ int) *db.JobExecution {
+		return latestJobExecutionMap[job.ID]
+	})
+	steps, err := h.db.GetStepsByPipelineID(ctx, pipeline.ID)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to get steps of pipeline '%d'", pipeline.ID)
+	}
+	stepExecutionMap, err := h.db.GetStepExecutionsByJobExecutionIDs(ctx, lo.Map(latestJobExecutions,
+		func(item *db.JobExecution, _ int) int64 {
+			return item.ID
+		}))
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get step executions")
+	}
+	pipelineExecutions, err := h.db.GetPipelineExecutionsByPipelineIDs(ctx, []int64{pipeline.ID})
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get pipeline executions")
+	}
+	p, err := packPipeline(pipeline, jobs, latestJobExecutions, steps, lo.Flatten(lo.Values(stepExecutionMap)))
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to pack pipeline")
+	}
+	p.Execution, err = db.PackPipelineExecution(getLatestPipelineExecution(pipelineExecutions[pipeline.ID]),
+		latestJobExecutions)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to pack pipeline execution")
+	}
+	return &api.GetPipelineResponse{Pipeline: p}, nil
+}
+
+const (
+	defaultListPipelinesLimit = 20
+	maxListPipelinesLimit     = 100
+	// maxListPipelinesScanned is the max number of pipelines scanned by a request filtering by status.
+	maxListPipelinesScanned = 1000
+)
+
+func (h *Handler) ListPipelines(ctx context.Context, request *api.ListPipelinesRequest) (*api.ListPipelinesResponse, error) {
+	logger := log.ExtractLogger(ctx)
+	logger.Infof("list pipelines, cursor: %d, limit: %d", request.Cursor, request.Limit)
+	limit := defaultListPipelinesLimit
+	if request.Limit > 0 {
+		limit = min(int(request.Limit), maxListPipelinesLimit)
+	}
+	option := &db.ListPipelinesOption{
+		Cursor: request.Cursor,
+		// One more pipeline is listed to know whether there is another page.
+		Limit: limit + 1,
+	}
+	if request.CreatedAfter != nil {
+		option.CreatedAfter = lo.ToPtr(time.Unix(*request.CreatedAfter, 0))
+	}
+	if request.CreatedBefore != nil {
+		option.CreatedBefore = lo.ToPtr(time.Unix(*request.CreatedBefore, 0))
+	}
+	resp := &api.ListPipelinesResponse{}
+	// The status is aggregated from job executions, so pipelines are filtered by status page by page.
+	// The scan stops at maxListPipelinesScanned, the page might be less than limit then.
+	for scanned := 0; ; {
+		pipelines, err := h.db.ListPipelines(ctx, option)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed to list pipelines")
+		}
+		hasMore := len(pipelines) > limit
+		pipelines = pipelines[:min(len(pipelines), limit)]
+		packed, err := h.packPipelineSummaries(ctx, pipelines)
+		if err != nil {
+			return nil, err
+		}
+		for i, p := range packed {
+			option.Cursor = p.ID
+			scanned++
+			if request.Status != nil && p.Execution.Status != *request.Status {
+				continue
+			}
+			resp.Pipelines = append(resp.Pipelines, p)
+			if len(resp.Pipelines) == limit {
+				if hasMore || i < len(packed)-1 {
+					resp.NextCursor = p.ID
+				}
+				return resp, nil
+			}
+		}
+		if !hasMore {
+			return resp, nil
+		}
+		if scanned >= maxListPipelinesScanned {
+			resp.NextCursor = option.Cursor
+			return resp, nil
+		}
+	}
+}
+
+// packPipelineSummaries packs pipelines with jobs and the latest executions, steps are not included.
+func (h *Handler) packPipelineSummaries(ctx context.Context, pipelines []*db.Pipeline) ([]*api.Pipeline, error) {
+	if len(pipelines) == 0 {
+		return nil, nil
+	}
+	pipelineIDs := lo.Map(pipelines, func(item *db.Pipeline, _ int) int64 {
+		return item.ID
+	})
+	jobs, err := h.db.GetJobsByPipelineIDs(ctx, pipelineIDs)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get jobs")
+	}
+	latestJobExecutionMap, err := h.pipelineService.GetLatestJobExecutions(ctx, jobs)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get latest job executions")
+	}
+	pipelineExecutions, err := h.db.GetPipelineExecutionsByPipelineIDs(ctx, pipelineIDs)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get pipeline executions")
+	}
+	jobsByPipelineID := lo.GroupBy(jobs, func(item *db.Job) int64 {
+		return item.PipelineID
+	})
+	result := make([]*api.Pipeline, 0, len(pipelines))
+	for _, pipeline := range pipelines {
+		p := &api.Pipeline{
+			ID:        pipeline.ID,
+			Project:   pipeline.Project,
+			CreatedAt: api.ConvertTime(pipeline.CreatedAt),
+			UpdatedAt: api.ConvertTime(pipeline.UpdatedAt),
+		}
+		var latestJobExecutions []*db.JobExecution
+		for _, job := range jobsByPipelineID[pipeline.ID] {
+			latest := latestJobExecutionMap[job.ID]
+			j, err := db.PackJob(job, latest, nil, nil, nil)
+			if err != nil {
+				return nil, errors.WithMessagef(err, "failed to pack job %d", job.ID)
+			}
+			p.Jobs = append(p.Jobs, j)
+			latestJobExecutions = append(latestJobExecutions, latest)
+		}
+		p.Execution, err = db.PackPipelineExecution(getLatestPipelineExecution(pipelineExecutions[pipeline.ID]),
+			latestJobExecutions)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to pack execution of pipeline %d", pipeline.ID)
+		}
+		result = append(result, p)
+	}
+	return result, nil
+}
+
+func getLatestPipelineExecution(executions []*db.PipelineExecution) *db.PipelineExecution {
+	return lo.MaxBy(executions, func(a, b *db.PipelineExecution) bool {
+		return a.ID > b.ID
+	})
+}
+
 func packPipeline(p *db.Pipeline, jobs []*db.Job, jobExecutions []*db.JobExecution, steps []*db.Step,
 	stepExecutions []*db.StepExecution,
 ) (*api.Pipeline, error) {
 	pipeline := &api.Pipeline{
 		ID:        p.ID,
+		Project:   p.Project,
 		CreatedAt: api.ConvertTime(p.CreatedAt),
 		UpdatedAt: api.ConvertTime(p.UpdatedAt),
 	}
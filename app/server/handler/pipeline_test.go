@@ -3,13 +3,19 @@ package handler
 import (
 	"context"
 	"testing"
+	"time"
+
+	"github.com/samber/lo"
 
 	"github.com/cox96de/runner/app/server/eventhook"
 
 	"github.com/cox96de/runner/api"
 
 	"github.com/cox96de/runner/app/server/dispatch"
+	"github.com/cox96de/runner/app/server/logstorage"
+	"github.com/cox96de/runner/app/server/notify"
 	"github.com/cox96de/runner/app/server/pipeline"
+	"gotest.tools/v3/fs"
 
 	"github.com/cox96de/runner/mock"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -19,8 +25,8 @@ import (
 func TestHandler_createPipeline(t *testing.T) {
 	dbClient := mock.NewMockDB(t)
 	eventHook := eventhook.NewService(eventhook.NewNopSender())
-	handler := NewHandler(dbClient, pipeline.NewService(dbClient), dispatch.NewService(dbClient, eventHook), nil,
-		nil, eventHook)
+	handler := NewHandler(dbClient, pipeline.NewService(dbClient), dispatch.NewService(dbClient, eventHook, notify.NewLocalNotifier()), nil,
+		nil, eventHook, nil, nil, nil, nil)
 	t.Run("normal", func(t *testing.T) {
 		p, err := handler.CreatePipeline(context.Background(), &api.CreatePipelineRequest{
 			Pipeline: &api.PipelineDSL{
@@ -55,7 +61,8 @@ func TestHandler_createPipeline(t *testing.T) {
 						Status: api.StatusCreated,
 						Steps: []*api.StepExecution{
 							{
-								Status: api.StatusCreated,
+								Status:  api.StatusCreated,
+								Attempt: 1,
 							},
 						},
 					},
@@ -66,18 +73,31 @@ func TestHandler_createPipeline(t *testing.T) {
 							Commands: []string{"echo hello"},
 							Executions: []*api.StepExecution{
 								{
-									Status: api.StatusCreated,
+									Status:  api.StatusCreated,
+									Attempt: 1,
 								},
 							},
 							Execution: &api.StepExecution{
-								Status: api.StatusCreated,
+								Status:  api.StatusCreated,
+								Attempt: 1,
 							},
 						},
 					},
 				},
 			},
-		}, cmpopts.IgnoreUnexported(api.Pipeline{}, api.Job{}, api.JobExecution{}, api.Step{}, api.StepExecution{}, api.RunsOn{}),
+			Execution: &api.PipelineExecution{
+				Status: api.StatusCreated,
+				Jobs: []*api.JobExecution{
+					{
+						Status: api.StatusCreated,
+						Steps:  []*api.StepExecution{},
+					},
+				},
+			},
+		}, cmpopts.IgnoreUnexported(api.Pipeline{}, api.PipelineExecution{}, api.Job{}, api.JobExecution{}, api.Step{},
+			api.StepExecution{}, api.RunsOn{}),
 			cmpopts.IgnoreFields(api.Pipeline{}, "ID", "CreatedAt", "UpdatedAt"),
+			cmpopts.IgnoreFields(api.PipelineExecution{}, "ID"),
 			cmpopts.IgnoreFields(api.Job{}, "PipelineID", "ID", "CreatedAt", "UpdatedAt"),
 			cmpopts.IgnoreFields(api.JobExecution{}, "JobID", "ID", "CreatedAt", "UpdatedAt", "StartedAt", "CompletedAt"),
 			cmpopts.IgnoreFields(api.Step{}, "PipelineID", "JobID", "ID", "CreatedAt", "UpdatedAt"),
@@ -126,3 +146,235 @@ func TestHandler_createPipeline(t *testing.T) {
 		assert.ErrorContains(t, err, "required")
 	})
 }
+
+func TestHandler_GetPipeline(t *testing.T) {
+	dbClient := mock.NewMockDB(t)
+	eventHook := eventhook.NewService(eventhook.NewNopSender())
+	handler := NewHandler(dbClient, pipeline.NewService(dbClient), dispatch.NewService(dbClient, eventHook, notify.NewLocalNotifier()),
+		mock.NewMockLocker(), nil, eventHook, nil, nil, nil, nil)
+	createPipelineResponse, err := handler.CreatePipeline(context.Background(), &api.CreatePipelineRequest{
+		Pipeline: &api.PipelineDSL{
+			Jobs: []*api.JobDSL{
+				{
+					Name:   "job1",
+					RunsOn: &api.RunsOn{Label: "label1"},
+					Steps:  []*api.StepDSL{{Name: "step1"}, {Name: "step2"}},
+				},
+				{
+					Name:      "job2",
+					RunsOn:    &api.RunsOn{Label: "label1"},
+					DependsOn: []string{"job1"},
+					Steps:     []*api.StepDSL{{Name: "step1"}},
+				},
+			},
+		},
+	})
+	assert.NilError(t, err)
+	pipelineID := createPipelineResponse.Pipeline.ID
+	t.Run("not_found", func(t *testing.T) {
+		_, err := handler.GetPipeline(context.Background(), &api.GetPipelineRequest{PipelineID: pipelineID + 1})
+		assert.ErrorContains(t, err, "failed to get pipeline")
+	})
+	getPipelineResponse, err := handler.GetPipeline(context.Background(), &api.GetPipelineRequest{PipelineID: pipelineID})
+	assert.NilError(t, err)
+	p := getPipelineResponse.Pipeline
+	assert.Equal(t, p.ID, pipelineID)
+	assert.Equal(t, p.Execution.ID, createPipelineResponse.Pipeline.Execution.ID)
+	assert.Equal(t, p.Execution.Status, api.StatusQueued)
+	assert.Equal(t, len(p.Execution.Jobs), 2)
+	assert.Equal(t, len(p.Jobs), 2)
+	assert.Equal(t, p.Jobs[0].Execution.Status, api.StatusQueued)
+	assert.Equal(t, len(p.Jobs[0].Steps), 2)
+	assert.Equal(t, len(p.Jobs[0].Execution.Steps), 2)
+	assert.Equal(t, p.Jobs[1].Execution.Status, api.StatusCreated)
+	assert.Equal(t, len(p.Jobs[1].Steps), 1)
+
+	PushJobToStatus(t, handler, context.Background(), p.Jobs[0].Execution.ID, api.StatusQueued, api.StatusRunning)
+	getPipelineResponse, err = handler.GetPipeline(context.Background(), &api.GetPipelineRequest{PipelineID: pipelineID})
+	assert.NilError(t, err)
+	assert.Equal(t, getPipelineResponse.Pipeline.Execution.Status, api.StatusRunning)
+}
+
+func TestHandler_ListPipelines(t *testing.T) {
+	dbClient := mock.NewMockDB(t)
+	eventHook := eventhook.NewService(eventhook.NewNopSender())
+	logService := logstorage.NewService(mock.NewMockRedis(t), logstorage.NewFilesystemOSS(fs.NewDir(t, "baseDir").Path()))
+	handler := NewHandler(dbClient, pipeline.NewService(dbClient), dispatch.NewService(dbClient, eventHook, notify.NewLocalNotifier()),
+		mock.NewMockLocker(), logService, eventHook, nil, nil, nil, nil)
+	var pipelineIDs []int64
+	for i := 0; i < 5; i++ {
+		createPipelineResponse, err := handler.CreatePipeline(context.Background(), &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{
+					{
+						Name:   "job1",
+						RunsOn: &api.RunsOn{Label: "label1"},
+						Steps:  []*api.StepDSL{{Name: "step1"}},
+					},
+				},
+			},
+		})
+		assert.NilError(t, err)
+		pipelineIDs = append(pipelineIDs, createPipelineResponse.Pipeline.ID)
+		if i%2 == 0 {
+			PushJobToStatus(t, handler, context.Background(), createPipelineResponse.Pipeline.Jobs[0].Execution.ID,
+				api.StatusQueued, api.StatusFailed)
+		}
+	}
+	reversedIDs := lo.Reverse(append([]int64{}, pipelineIDs...))
+	getIDs := func(pipelines []*api.Pipeline) []int64 {
+		return lo.Map(pipelines, func(item *api.Pipeline, _ int) int64 {
+			return item.ID
+		})
+	}
+	t.Run("all", func(t *testing.T) {
+		listPipelinesResponse, err := handler.ListPipelines(context.Background(), &api.ListPipelinesRequest{})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, getIDs(listPipelinesResponse.Pipelines), reversedIDs)
+		assert.Equal(t, listPipelinesResponse.NextCursor, int64(0))
+		assert.Equal(t, len(listPipelinesResponse.Pipelines[0].Jobs), 1)
+		assert.Equal(t, listPipelinesResponse.Pipelines[0].Execution.Status, api.StatusFailed)
+	})
+	t.Run("pagination", func(t *testing.T) {
+		var (
+			ids    []int64
+			cursor int64
+		)
+		for {
+			listPipelinesResponse, err := handler.ListPipelines(context.Background(), &api.ListPipelinesRequest{
+				Cursor: cursor,
+				Limit:  2,
+			})
+			assert.NilError(t, err)
+			assert.Assert(t, len(listPipelinesResponse.Pipelines) <= 2)
+			ids = append(ids, getIDs(listPipelinesResponse.Pipelines)...)
+			if listPipelinesResponse.NextCursor == 0 {
+				break
+			}
+			cursor = listPipelinesResponse.NextCursor
+		}
+		assert.DeepEqual(t, ids, reversedIDs)
+
+		listPipelinesResponse, err := handler.ListPipelines(context.Background(), &api.ListPipelinesRequest{
+			Cursor: pipelineIDs[2],
+			Limit:  2,
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, getIDs(listPipelinesResponse.Pipelines), []int64{pipelineIDs[1], pipelineIDs[0]})
+		assert.Equal(t, listPipelinesResponse.NextCursor, int64(0))
+	})
+	t.Run("status", func(t *testing.T) {
+		listPipelinesResponse, err := handler.ListPipelines(context.Background(), &api.ListPipelinesRequest{
+			Status: lo.ToPtr(api.StatusFailed),
+			Limit:  2,
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, getIDs(listPipelinesResponse.Pipelines), []int64{pipelineIDs[4], pipelineIDs[2]})
+		assert.Equal(t, listPipelinesResponse.NextCursor, pipelineIDs[2])
+		listPipelinesResponse, err = handler.ListPipelines(context.Background(), &api.ListPipelinesRequest{
+			Status: lo.ToPtr(api.StatusFailed),
+			Cursor: listPipelinesResponse.NextCursor,
+			Limit:  2,
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, getIDs(listPipelinesResponse.Pipelines), []int64{pipelineIDs[0]})
+		assert.Equal(t, listPipelinesResponse.NextCursor, int64(0))
+	})
+	t.Run("created_time", func(t *testing.T) {
+		listPipelinesResponse, err := handler.ListPipelines(context.Background(), &api.ListPipelinesRequest{
+			CreatedAfter: lo.ToPtr(time.Now().Add(time.Hour).Unix()),
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, len(listPipelinesResponse.Pipelines), 0)
+		listPipelinesResponse, err = handler.ListPipelines(context.Background(), &api.ListPipelinesRequest{
+			CreatedAfter:  lo.ToPtr(time.Now().Add(-time.Hour).Unix()),
+			CreatedBefore: lo.ToPtr(time.Now().Add(time.Hour).Unix()),
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, len(listPipelinesResponse.Pipelines), 5)
+	})
+}
+
+func TestHandler_ListPipelines_ScanLimit(t *testing.T) {
+	dbClient := mock.NewMockDB(t)
+	eventHook := eventhook.NewService(eventhook.NewNopSender())
+	logService := logstorage.NewService(mock.NewMockRedis(t), logstorage.NewFilesystemOSS(fs.NewDir(t, "baseDir").Path()))
+	handler := NewHandler(dbClient, pipeline.NewService(dbClient), dispatch.NewService(dbClient, eventHook, notify.NewLocalNotifier()),
+		mock.NewMockLocker(), logService, eventHook, nil, nil, nil, nil)
+	createPipelineResponse, err := handler.CreatePipeline(context.Background(), &api.CreatePipelineRequest{
+		Pipeline: &api.PipelineDSL{
+			Jobs: []*api.JobDSL{
+				{
+					Name:   "job1",
+					RunsOn: &api.RunsOn{Label: "label1"},
+					Steps:  []*api.StepDSL{{Name: "step1"}},
+				},
+			},
+		},
+	})
+	assert.NilError(t, err)
+	PushJobToStatus(t, handler, context.Background(), createPipelineResponse.Pipeline.Jobs[0].Execution.ID,
+		api.StatusQueued, api.StatusFailed)
+	// Pipelines created later than the failed one don't match the filter.
+	var unmatchedIDs []int64
+	for i := 0; i < maxListPipelinesScanned+100; i++ {
+		p, err := dbClient.CreatePipeline(context.Background(), "")
+		assert.NilError(t, err)
+		unmatchedIDs = append(unmatchedIDs, p.ID)
+	}
+	listPipelinesResponse, err := handler.ListPipelines(context.Background(), &api.ListPipelinesRequest{
+		Status: lo.ToPtr(api.StatusFailed),
+	})
+	assert.NilError(t, err)
+	// The scan stops with an empty page, the cursor points to the last scanned pipeline.
+	assert.Equal(t, len(listPipelinesResponse.Pipelines), 0)
+	assert.Equal(t, listPipelinesResponse.NextCursor, unmatchedIDs[100])
+	listPipelinesResponse, err = handler.ListPipelines(context.Background(), &api.ListPipelinesRequest{
+		Status: lo.ToPtr(api.StatusFailed),
+		Cursor: listPipelinesResponse.NextCursor,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, len(listPipelinesResponse.Pipelines), 1)
+	assert.Equal(t, listPipelinesResponse.Pipelines[0].ID, createPipelineResponse.Pipeline.ID)
+	assert.Equal(t, listPipelinesResponse.NextCursor, int64(0))
+}
+
+func TestHandler_RunPipeline(t *testing.T) {
+	dbClient := mock.NewMockDB(t)
+	eventHook := eventhook.NewService(eventhook.NewNopSender())
+	logService := logstorage.NewService(mock.NewMockRedis(t), logstorage.NewFilesystemOSS(fs.NewDir(t, "baseDir").Path()))
+	handler := NewHandler(dbClient, pipeline.NewService(dbClient), dispatch.NewService(dbClient, eventHook, notify.NewLocalNotifier()),
+		mock.NewMockLocker(), logService, eventHook, nil, nil, nil, nil)
+	runsOn := &api.RunsOn{Label: "label"}
+	// build <- test, lint <- deploy
+	createPipelineResponse, err := handler.CreatePipeline(context.Background(), &api.CreatePipelineRequest{
+		Pipeline: &api.PipelineDSL{
+			Jobs: []*api.JobDSL{
+				{Name: "build", RunsOn: runsOn, Steps: []*api.StepDSL{{Name: "step1"}}},
+				{Name: "test", RunsOn: runsOn, Steps: []*api.StepDSL{{Name: "step1"}}, DependsOn: []string{"build"}},
+				{Name: "lint", RunsOn: runsOn, Steps: []*api.StepDSL{{Name: "step1"}}, DependsOn: []string{"build"}},
+				{Name: "deploy", RunsOn: runsOn, Steps: []*api.StepDSL{{Name: "step1"}}, DependsOn: []string{"test", "lint"}},
+			},
+		},
+	})
+	assert.NilError(t, err)
+	var executed []string
+	for {
+		requestJobResponse, err := handler.RequestJob(context.Background(), &api.RequestJobRequest{Label: "label"})
+		assert.NilError(t, err)
+		if requestJobResponse.Job == nil {
+			break
+		}
+		executed = append(executed, requestJobResponse.Job.Name)
+		PushJobToStatus(t, handler, context.Background(), requestJobResponse.Job.Execution.ID, api.StatusQueued,
+			api.StatusSucceeded)
+	}
+	assert.Equal(t, len(executed), 4)
+	assert.Equal(t, executed[0], "build")
+	assert.Equal(t, executed[3], "deploy")
+	getPipelineResponse, err := handler.GetPipeline(context.Background(), &api.GetPipelineRequest{
+		PipelineID: createPipelineResponse.Pipeline.ID,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, getPipelineResponse.Pipeline.Execution.Status, api.StatusSucceeded)
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -13,8 +14,8 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/cox96de/runner/db"
-	"github.com/cox96de/runner/lib"
 	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
 )
 
 func (h *Handler) RequestJobHandler(c *gin.Context) {
@@ -24,81 +25,167 @@ func (h *Handler) RequestJobHandler(c *gin.Context) {
 		return
 	}
 	logger := log.ExtractLogger(c)
-	logger.Debugf("handle job request for label: %s", request.Label)
-	response, err := h.RequestJob(c, request)
+	logger.Debugf("handle job request for labels: %v", getRequestLabels(request))
+	response, err := h.RequestJob(c.Request.Context(), request)
 	if err != nil {
 		log.Errorf("failed to request job: %v", err)
 		c.JSON(http.StatusInternalServerError, &Message{Message: err})
 		return
 	}
-	if response.Job != nil {
+	// The response of waiting requests is always returned, so that agents know the server supports long polling.
+	if response.Job != nil || request.WaitTimeout > 0 {
 		JSON(c, http.StatusOK, response)
 		return
 	}
 	JSON(c, http.StatusNoContent, response)
 }
 
+// maxRequestJobWaitTimeout is the max duration to wait for a job in a job request.
+const maxRequestJobWaitTimeout = time.Minute
+
+// RequestJob dispatches a queued job to the agent. If there is no job, it waits for a job matching labels of the
+// agent to be queued, until the wait timeout of the request.
 func (h *Handler) RequestJob(ctx context.Context, request *api.RequestJobRequest) (*api.RequestJobResponse, error) {
-	// TODO: the limit should be configurable.
-	logger := log.ExtractLogger(ctx)
+	labels := getRequestLabels(request)
 	ctx, span := trace.Start(ctx, "handler.request_job",
-		trace.WithAttributes(attribute.String("label", request.Label)))
+		trace.WithAttributes(attribute.StringSlice("labels", labels)))
 	defer span.End()
-	jobQueues, err := h.db.GetQueuedJobExecutionIDs(ctx, request.Label, 100)
-	if err != nil {
-		return nil, errors.WithMessage(err, "failed to get queued job executions")
+	var (
+		notifications <-chan string
+		timeout       <-chan time.Time
+	)
+	if request.WaitTimeout > 0 {
+		// Subscribe before fetching jobs, so jobs queued between fetching and waiting are not missed.
+		subscribeCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		var err error
+		notifications, err = h.dispatchService.SubscribeQueuedJobs(subscribeCtx)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed to subscribe queued jobs")
+		}
+		timer := time.NewTimer(min(time.Duration(request.WaitTimeout)*time.Second, maxRequestJobWaitTimeout))
+		defer timer.Stop()
+		timeout = timer.C
 	}
-	for _, jobQueue := range jobQueues {
-		logger.Debugf("get job execution: %d", jobQueue.JobExecutionID)
-		job, ok := h.tryLockJobExecution(ctx, jobQueue.JobExecutionID)
-		if !ok {
-			logger.Debugf("failed to lock job execution: %d", jobQueue.JobExecutionID)
-			continue
+	for {
+		job, err := h.fetchJob(ctx, labels, request.AgentID)
+		if err != nil {
+			return nil, err
 		}
-		logger.Infof("dispatch job: %v, job execution: %d", job.ID, jobQueue.JobExecutionID)
-		span.AddEvent("Dispatch job", trace.WithAttributes(attribute.Int64("job_execution_id", jobQueue.JobExecutionID)))
-		return &api.RequestJobResponse{
-			Job: job,
-		}, nil
-	}
-	span.AddEvent("No job to dispatch")
-	return &api.RequestJobResponse{
-		Job: nil,
-	}, nil
+		if job != nil {
+			span.AddEvent("Dispatch job", trace.WithAttributes(attribute.Int64("job_execution_id", job.Execution.ID)))
+			return &api.RequestJobResponse{Job: job, LongPolling: true}, nil
+		}
+		if notifications == nil || !waitQueuedJob(ctx, labels, notifications, timeout) {
+			span.AddEvent("No job to dispatch")
+			return &api.RequestJobResponse{LongPolling: true}, nil
+		}
+	}
 }
 
-func (h *Handler) tryLockJobExecution(ctx context.Context, jobExecutionID int64) (job *api.Job, ok bool) {
+// fetchJob claims a queued job matching labels for the agent. It returns nil if there is no job.
+// Jobs whose secrets can't be resolved are failed, and the next queued job is claimed.
+func (h *Handler) fetchJob(ctx context.Context, labels []string, agentID int64) (*api.Job, error) {
 	logger := log.ExtractLogger(ctx)
-	lockKey := lib.BuildJobRequestLockKey(jobExecutionID)
-	lock, err := h.locker.Lock(ctx, lockKey, "request_job",
-		time.Second*10)
-	if err != nil {
-		logger.Warnf("failed to lock job execution: %v", err)
-		return nil, false
-	}
-	if !lock {
-		return nil, false
-	}
-	defer func() {
-		// If success to fetch the job, don't need to unlock it.
-		if !ok {
-			_, err := h.locker.Unlock(ctx, lockKey)
-			if err != nil {
-				logger.Warnf("failed to unlock job execution: %v", err)
+	for {
+		jobQueue, err := h.db.ClaimJobQueue(ctx, labels, agentID)
+		if err != nil {
+			if db.IsRecordNotFoundError(err) {
+				return nil, nil
 			}
+			return nil, errors.WithMessage(err, "failed to claim queued job execution")
 		}
-	}()
+		jobExecution, job, err := h.prepareJob(ctx, jobQueue.JobExecutionID, agentID)
+		if err != nil {
+			// Release the claimed job, otherwise it's orphaned until the heartbeat times out.
+			if releaseErr := h.db.ReleaseJobQueue(ctx, jobQueue.JobExecutionID); releaseErr != nil {
+				logger.Errorf("failed to release job execution '%d': %v", jobQueue.JobExecutionID, releaseErr)
+			}
+			return nil, err
+		}
+		if err = h.resolveSecrets(ctx, job, labels); err != nil {
+			logger.Warnf("failed to resolve secrets of job execution '%d': %v", jobExecution.ID, err)
+			if err = h.dispatchService.UpdateJobExecution(ctx, h.db, &db.UpdateJobExecutionOption{
+				ID:     jobExecution.ID,
+				Status: lo.ToPtr(api.StatusFailed),
+				Reason: &api.Reason{
+					Reason:  api.FailedReasonInternalError,
+					Message: fmt.Sprintf("failed to resolve secrets: %v", err),
+				},
+				CompletedAt: lo.ToPtr(time.Now()),
+			}); err != nil {
+				return nil, errors.WithMessagef(err, "failed to fail job execution '%d'", jobExecution.ID)
+			}
+			continue
+		}
+		logger.Infof("dispatch job: %v, job execution: %d", job.ID, jobExecution.ID)
+		return job, nil
+	}
+}
+
+// prepareJob assigns the claimed job execution to the agent and packs the job to dispatch.
+func (h *Handler) prepareJob(ctx context.Context, jobExecutionID int64, agentID int64) (*db.JobExecution, *api.Job, error) {
 	jobExecution, err := h.db.GetJobExecution(ctx, jobExecutionID)
 	if err != nil {
-		log.Errorf("failed to get job execution: %v", err)
-		return nil, false
+		return nil, nil, errors.WithMessagef(err, "failed to get job execution '%d'", jobExecutionID)
 	}
-	job, err = h.packJob(ctx, jobExecution)
+	if agentID != 0 {
+		if err = h.db.SetJobExecutionAgent(ctx, jobExecution.ID, agentID); err != nil {
+			return nil, nil, errors.WithMessagef(err, "failed to set agent of job execution '%d'", jobExecution.ID)
+		}
+		jobExecution.AgentID = agentID
+	}
+	job, err := h.packJob(ctx, jobExecution)
 	if err != nil {
-		log.Errorf("failed to pack job: %v", err)
-		return nil, false
+		return nil, nil, err
+	}
+	return jobExecution, job, nil
+}
+
+// resolveSecrets resolves secrets referred by the job into job.SecretEnv. Secrets are resolved only when the job is
+// dispatched, so their values are never stored with the job.
+func (h *Handler) resolveSecrets(ctx context.Context, job *api.Job, labels []string) error {
+	if len(job.Secrets) == 0 {
+		return nil
+	}
+	pipeline, err := h.db.GetPipeline(ctx, job.PipelineID)
+	if err != nil {
+		return errors.WithMessagef(err, "failed to get pipeline '%d'", job.PipelineID)
+	}
+	job.SecretEnv, err = h.secretService.Resolve(ctx, pipeline.Project, labels, job.Secrets)
+	return err
+}
+
+// waitQueuedJob waits for a job matching labels to be queued. It returns false if ctx is done or timeout.
+func waitQueuedJob(ctx context.Context, labels []string, notifications <-chan string, timeout <-chan time.Time) bool {
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timeout:
+			return false
+		case label, ok := <-notifications:
+			if !ok {
+				return false
+			}
+			// A job without label can run on any agent.
+			if label == "" {
+				return true
+			}
+			selector, err := api.ParseLabelSelector(label)
+			if err == nil && selector.Match(labels) {
+				return true
+			}
+		}
+	}
+}
+
+// getRequestLabels returns labels of the agent requesting jobs, the deprecated label is included.
+func getRequestLabels(request *api.RequestJobRequest) []string {
+	if request.Label == "" || lo.Contains(request.Labels, request.Label) {
+		return request.Labels
 	}
-	return job, true
+	return append([]string{request.Label}, request.Labels...)
 }
 
 func (h *Handler) packJob(ctx context.Context, jobExecution *db.JobExecution) (*api.Job, error) {
@@ -1,15 +1,20 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	"github.com/cox96de/runner/app/server/eventhook"
 
 	"github.com/cox96de/runner/api"
 
 	"github.com/cox96de/runner/app/server/dispatch"
+	"github.com/cox96de/runner/app/server/notify"
 	"github.com/cox96de/runner/app/server/pipeline"
+	"github.com/cox96de/runner/app/server/secret"
+	"github.com/cox96de/runner/db"
 	"github.com/cox96de/runner/mock"
 	"gotest.tools/v3/assert"
 )
@@ -17,8 +22,8 @@ import (
 func TestHandler_requestJobHandler(t *testing.T) {
 	dbClient := mock.NewMockDB(t)
 	eventHook := eventhook.NewService(eventhook.NewNopSender())
-	handler := NewHandler(dbClient, pipeline.NewService(dbClient), dispatch.NewService(dbClient, eventHook), mock.NewMockLocker(),
-		nil, eventHook)
+	handler := NewHandler(dbClient, pipeline.NewService(dbClient),
+		dispatch.NewService(dbClient, eventHook, notify.NewLocalNotifier()), mock.NewMockLocker(), nil, eventHook, nil, nil, nil, nil)
 	createPipelineResponse, err := handler.CreatePipeline(context.Background(), &api.CreatePipelineRequest{
 		Pipeline: &api.PipelineDSL{
 			Jobs: []*api.JobDSL{
@@ -50,4 +55,144 @@ func TestHandler_requestJobHandler(t *testing.T) {
 		assert.NilError(t, err)
 		assert.Assert(t, requestJobResponse.Job == nil)
 	})
+	t.Run("release_on_failure", func(t *testing.T) {
+		label := t.Name()
+		_, err := dbClient.CreateJobQueues(context.Background(), []*db.CreateJobQueueOption{
+			{JobExecutionID: 10000, Label: label, Status: api.StatusQueued},
+		})
+		assert.NilError(t, err)
+		// The job execution doesn't exist, so the claimed job can't be dispatched.
+		_, err = handler.RequestJob(context.Background(), &api.RequestJobRequest{Labels: []string{label}, AgentID: 1})
+		assert.ErrorContains(t, err, "failed to get job execution")
+		jobQueue, err := dbClient.GetJobQueue(context.Background(), 10000)
+		assert.NilError(t, err)
+		assert.Equal(t, jobQueue.Status, api.StatusQueued)
+		assert.Equal(t, jobQueue.AgentID, int64(0))
+	})
+	t.Run("label_expression", func(t *testing.T) {
+		_, err := handler.CreatePipeline(context.Background(), &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{{
+					Name:   "test",
+					RunsOn: &api.RunsOn{Label: "linux && !gpu=true"},
+					Steps:  []*api.StepDSL{{Name: "test", Commands: []string{"echo test"}}},
+				}},
+			},
+		})
+		assert.NilError(t, err)
+		requestJobResponse, err := handler.RequestJob(context.Background(), &api.RequestJobRequest{
+			Labels: []string{"linux", "gpu=true"},
+		})
+		assert.NilError(t, err)
+		assert.Assert(t, requestJobResponse.Job == nil)
+		requestJobResponse, err = handler.RequestJob(context.Background(), &api.RequestJobRequest{
+			Label:  "linux",
+			Labels: []string{"amd64"},
+		})
+		assert.NilError(t, err)
+		assert.Assert(t, requestJobResponse.Job != nil)
+	})
+	t.Run("invalid_label_expression", func(t *testing.T) {
+		_, err := handler.CreatePipeline(context.Background(), &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{{
+					Name:   "test",
+					RunsOn: &api.RunsOn{Label: "linux || windows"},
+					Steps:  []*api.StepDSL{{Name: "test", Commands: []string{"echo test"}}},
+				}},
+			},
+		})
+		assert.ErrorContains(t, err, "invalid label")
+	})
+	t.Run("wait_timeout", func(t *testing.T) {
+		start := time.Now()
+		requestJobResponse, err := handler.RequestJob(context.Background(), &api.RequestJobRequest{
+			Labels:      []string{"freebsd"},
+			WaitTimeout: 1,
+		})
+		assert.NilError(t, err)
+		assert.Assert(t, requestJobResponse.Job == nil)
+		assert.Assert(t, requestJobResponse.LongPolling)
+		assert.Assert(t, time.Since(start) >= time.Second)
+	})
+	t.Run("wait_for_queued_job", func(t *testing.T) {
+		responses := make(chan *api.RequestJobResponse)
+		go func() {
+			requestJobResponse, err := handler.RequestJob(context.Background(), &api.RequestJobRequest{
+				Labels:      []string{"macos"},
+				WaitTimeout: 30,
+			})
+			assert.Check(t, err)
+			responses <- requestJobResponse
+		}()
+		// Make sure the request is waiting.
+		time.Sleep(time.Millisecond * 100)
+		createPipelineResponse, err := handler.CreatePipeline(context.Background(), &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Jobs: []*api.JobDSL{{
+					Name:   "test",
+					RunsOn: &api.RunsOn{Label: "macos"},
+					Steps:  []*api.StepDSL{{Name: "test", Commands: []string{"echo test"}}},
+				}},
+			},
+		})
+		assert.NilError(t, err)
+		select {
+		case requestJobResponse := <-responses:
+			assert.Assert(t, requestJobResponse.Job != nil)
+			assert.Equal(t, requestJobResponse.Job.ID, createPipelineResponse.Pipeline.Jobs[0].ID)
+		case <-time.After(time.Second * 10):
+			t.Fatal("waiting request is not woken up")
+		}
+	})
+}
+
+func TestHandler_requestJobSecrets(t *testing.T) {
+	dbClient := mock.NewMockDB(t)
+	eventHook := eventhook.NewService(eventhook.NewNopSender())
+	secretCipher, err := secret.NewCipher(bytes.Repeat([]byte("k"), 32))
+	assert.NilError(t, err)
+	handler := NewHandler(dbClient, pipeline.NewService(dbClient),
+		dispatch.NewService(dbClient, eventHook, notify.NewLocalNotifier()), mock.NewMockLocker(), nil, eventHook, nil, nil,
+		secret.NewService(dbClient, secretCipher), nil)
+	_, err = handler.CreateSecret(context.Background(), &api.CreateSecretRequest{
+		Name: "token", Project: "runner", Value: "password",
+	})
+	assert.NilError(t, err)
+	label := t.Name()
+	createPipeline := func(project string) *api.Pipeline {
+		createPipelineResponse, err := handler.CreatePipeline(context.Background(), &api.CreatePipelineRequest{
+			Pipeline: &api.PipelineDSL{
+				Project: project,
+				Jobs: []*api.JobDSL{{
+					Name:    "test",
+					RunsOn:  &api.RunsOn{Label: label},
+					Secrets: map[string]string{"TOKEN": "token"},
+					Steps:   []*api.StepDSL{{Name: "test", Commands: []string{"echo test"}}},
+				}},
+			},
+		})
+		assert.NilError(t, err)
+		return createPipelineResponse.Pipeline
+	}
+	t.Run("resolved", func(t *testing.T) {
+		created := createPipeline("runner")
+		response, err := handler.RequestJob(context.Background(), &api.RequestJobRequest{Label: label})
+		assert.NilError(t, err)
+		assert.Equal(t, response.Job.ID, created.Jobs[0].ID)
+		assert.DeepEqual(t, response.Job.SecretEnv, map[string]string{"TOKEN": "password"})
+		getPipelineResponse, err := handler.GetPipeline(context.Background(), &api.GetPipelineRequest{PipelineID: created.ID})
+		assert.NilError(t, err)
+		assert.Assert(t, getPipelineResponse.Pipeline.Jobs[0].SecretEnv == nil)
+		assert.DeepEqual(t, getPipelineResponse.Pipeline.Jobs[0].Secrets, map[string]string{"TOKEN": "token"})
+	})
+	t.Run("not_found", func(t *testing.T) {
+		created := createPipeline("other")
+		response, err := handler.RequestJob(context.Background(), &api.RequestJobRequest{Label: label})
+		assert.NilError(t, err)
+		assert.Assert(t, response.Job == nil)
+		execution, err := dbClient.GetJobExecution(context.Background(), created.Jobs[0].Execution.ID)
+		assert.NilError(t, err)
+		assert.Equal(t, execution.Status, api.StatusFailed)
+	})
 }
@@ -8,6 +8,10 @@ func (h *Handler) RegisterRouter(g *gin.RouterGroup) {
 	g = g.Group("/api/v1")
 	g.Any("/ping", getGinHandler(h.Ping))
 	g.POST("/pipelines", getGinHandler(h.CreatePipeline))
+	g.GET("/pipelines", getGinHandler(h.ListPipelines))
+	g.GET("/pipelines/:pipeline_id", getGinHandler(h.GetPipeline))
+	g.POST("/pipelines/:pipeline_id/rerun", getGinHandler(h.RerunPipeline))
+	g.GET("/pipelines/:pipeline_id/artifacts", getGinHandler(h.ListArtifacts))
 	g.POST("/jobs/request", h.RequestJobHandler)
 	g.GET("/jobs/:job_id/executions/", getGinHandler(h.ListJobExecutions))
 	g.POST("/jobs/:job_id/rerun", getGinHandler(h.RerunJob))
@@ -17,6 +21,32 @@ func (h *Handler) RegisterRouter(g *gin.RouterGroup) {
 	g.GET("/job_executions/:job_execution_id", getGinHandler(h.GetJobExecution))
 	g.POST("/job_executions/:job_execution_id/logs", getGinHandler(h.UploadLogLines))
 	g.GET("/job_executions/:job_execution_id/logs/:name", getGinHandler(h.GetLogLines))
+	g.GET("/job_executions/:job_execution_id/logs/:name/stream", h.StreamLogLinesHandler)
+	g.POST("/job_executions/:job_execution_id/artifacts", h.UploadArtifactHandler)
+	g.GET("/job_executions/:job_execution_id/cache", getGinHandler(h.GetCache))
+	g.POST("/job_executions/:job_execution_id/caches", h.UploadCacheHandler)
+	g.GET("/job_executions/:job_execution_id/debug", h.DebugHandler)
 	g.GET("/step_executions/:step_execution_id", getGinHandler(h.GetStepExecution))
 	g.POST("/step_executions/:step_execution_id", getGinHandler(h.UpdateStepExecution))
+	g.POST("/step_executions/:step_execution_id/retry", getGinHandler(h.RetryStepExecution))
+	g.GET("/artifacts/:artifact_id/download", h.DownloadArtifactHandler)
+	g.GET("/caches", getGinHandler(h.ListCaches))
+	g.DELETE("/caches/:cache_id", getGinHandler(h.DeleteCache))
+	g.GET("/caches/:cache_id/download", h.DownloadCacheHandler)
+	g.PUT("/cache_limits", getGinHandler(h.SetCacheLimit))
+	g.GET("/debug_sessions/:session_id/serve", h.ServeDebugHandler)
+	g.POST("/agents", getGinHandler(h.RegisterAgent))
+	g.GET("/agents", getGinHandler(h.ListAgents))
+	g.GET("/agents/:agent_id", getGinHandler(h.GetAgent))
+	g.POST("/agents/:agent_id/heartbeat", getGinHandler(h.AgentHeartbeat))
+	g.POST("/secrets", getGinHandler(h.CreateSecret))
+	g.GET("/secrets", getGinHandler(h.ListSecrets))
+	g.GET("/secrets/:secret_id", getGinHandler(h.GetSecret))
+	g.PUT("/secrets/:secret_id", getGinHandler(h.UpdateSecret))
+	g.DELETE("/secrets/:secret_id", getGinHandler(h.DeleteSecret))
+	g.POST("/schedules", getGinHandler(h.CreateSchedule))
+	g.GET("/schedules", getGinHandler(h.ListSchedules))
+	g.GET("/schedules/:schedule_id", getGinHandler(h.GetSchedule))
+	g.PUT("/schedules/:schedule_id", getGinHandler(h.UpdateSchedule))
+	g.DELETE("/schedules/:schedule_id", getGinHandler(h.DeleteSchedule))
 }
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/app/server/schedule"
+	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/log"
+)
+
+func (h *Handler) CreateSchedule(ctx context.Context, request *api.CreateScheduleRequest) (*api.CreateScheduleResponse, error) {
+	created, err := h.scheduleService.Create(ctx, &db.CreateScheduleOption{
+		Name:          request.Name,
+		Cron:          request.Cron,
+		Timezone:      request.Timezone,
+		Pipeline:      request.Pipeline,
+		Enabled:       request.Enabled == nil || *request.Enabled,
+		CatchUpPolicy: request.CatchUpPolicy,
+	})
+	if err != nil {
+		return nil, scheduleError(err, "failed to create schedule '%s'", request.Name)
+	}
+	log.ExtractLogger(ctx).Infof("schedule '%s' is created as '%d', cron: '%s', timezone: '%s'", created.Name,
+		created.ID, created.Cron, created.Timezone)
+	packed, err := db.PackSchedule(created)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to pack schedule '%d'", created.ID)
+	}
+	return &api.CreateScheduleResponse{Schedule: packed}, nil
+}
+
+func (h *Handler) UpdateSchedule(ctx context.Context, request *api.UpdateScheduleRequest) (*api.UpdateScheduleResponse, error) {
+	updated, err := h.scheduleService.Update(ctx, &db.UpdateScheduleOption{
+		ID:            request.ScheduleID,
+		Name:          request.Name,
+		Cron:          request.Cron,
+		Timezone:      request.Timezone,
+		Pipeline:      request.Pipeline,
+		Enabled:       request.Enabled,
+		CatchUpPolicy: request.CatchUpPolicy,
+	})
+	if err != nil {
+		return nil, scheduleError(err, "failed to update schedule '%d'", request.ScheduleID)
+	}
+	log.ExtractLogger(ctx).Infof("schedule '%d' is updated", updated.ID)
+	packed, err := db.PackSchedule(updated)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to pack schedule '%d'", updated.ID)
+	}
+	return &api.UpdateScheduleResponse{Schedule: packed}, nil
+}
+
+func (h *Handler) DeleteSchedule(ctx context.Context, request *api.DeleteScheduleRequest) (*api.DeleteScheduleResponse, error) {
+	if err := h.db.DeleteSchedule(ctx, request.ScheduleID); err != nil {
+		return nil, scheduleError(err, "failed to delete schedule '%d'", request.ScheduleID)
+	}
+	log.ExtractLogger(ctx).Infof("schedule '%d' is deleted", request.ScheduleID)
+	return &api.DeleteScheduleResponse{}, nil
+}
+
+func (h *Handler) ListSchedules(ctx context.Context, _ *api.ListSchedulesRequest) (*api.ListSchedulesResponse, error) {
+	schedules, err := h.db.ListSchedules(ctx)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to list schedules")
+	}
+	packed := make([]*api.Schedule, 0, len(schedules))
+	for _, s := range schedules {
+		p, err := db.PackSchedule(s)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to pack schedule '%d'", s.ID)
+		}
+		packed = append(packed, p)
+	}
+	return &api.ListSchedulesResponse{Schedules: packed}, nil
+}
+
+func (h *Handler) GetSchedule(ctx context.Context, request *api.GetScheduleRequest) (*api.GetScheduleResponse, error) {
+	s, err := h.db.GetSchedule(ctx, request.ScheduleID)
+	if err != nil {
+		return nil, scheduleError(err, "failed to get schedule '%d'", request.ScheduleID)
+	}
+	packed, err := db.PackSchedule(s)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to pack schedule '%d'", s.ID)
+	}
+	return &api.GetScheduleResponse{Schedule: packed}, nil
+}
+
+// scheduleError converts known errors of schedules to http errors.
+func scheduleError(err error, format string, args ...interface{}) error {
+	switch {
+	case db.IsRecordNotFoundError(err):
+		return &HTTPError{Code: http.StatusNotFound, CauseError: err}
+	case errors.Is(err, schedule.ErrInvalid):
+		return &HTTPError{Code: http.StatusBadRequest, CauseError: err}
+	default:
+		return errors.WithMessagef(err, format, args...)
+	}
+}
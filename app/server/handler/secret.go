@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/app/server/secret"
+	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/log"
+	"github.com/samber/lo"
+)
+
+func (h *Handler) CreateSecret(ctx context.Context, request *api.CreateSecretRequest) (*api.CreateSecretResponse, error) {
+	if !api.SecretNamePattern.MatchString(request.Name) {
+		return nil, &HTTPError{Code: http.StatusBadRequest, CauseError: errors.Errorf(
+			"invalid secret name '%s', it should match %s", request.Name, api.SecretNamePattern.String())}
+	}
+	created, err := h.secretService.Create(ctx, request.Name, request.Project, request.Label, request.Value)
+	if err != nil {
+		return nil, secretError(err, "failed to create secret '%s'", request.Name)
+	}
+	log.ExtractLogger(ctx).Infof("secret '%s' is created as '%d', project: '%s', label: '%s'", created.Name,
+		created.ID, created.Project, created.Label)
+	return &api.CreateSecretResponse{Secret: db.PackSecret(created)}, nil
+}
+
+func (h *Handler) UpdateSecret(ctx context.Context, request *api.UpdateSecretRequest) (*api.UpdateSecretResponse, error) {
+	updated, err := h.secretService.Update(ctx, request.SecretID, request.Value)
+	if err != nil {
+		return nil, secretError(err, "failed to update secret '%d'", request.SecretID)
+	}
+	log.ExtractLogger(ctx).Infof("secret '%d' is updated", updated.ID)
+	return &api.UpdateSecretResponse{Secret: db.PackSecret(updated)}, nil
+}
+
+func (h *Handler) DeleteSecret(ctx context.Context, request *api.DeleteSecretRequest) (*api.DeleteSecretResponse, error) {
+	if err := h.db.DeleteSecret(ctx, request.SecretID); err != nil {
+		return nil, secretError(err, "failed to delete secret '%d'", request.SecretID)
+	}
+	log.ExtractLogger(ctx).Infof("secret '%d' is deleted", request.SecretID)
+	return &api.DeleteSecretResponse{}, nil
+}
+
+func (h *Handler) ListSecrets(ctx context.Context, request *api.ListSecretsRequest) (*api.ListSecretsResponse, error) {
+	secrets, err := h.db.ListSecrets(ctx, &db.ListSecretsOption{Project: request.Project, Label: request.Label})
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to list secrets")
+	}
+	return &api.ListSecretsResponse{Secrets: lo.Map(secrets, func(item *db.Secret, _ int) *api.Secret {
+		return db.PackSecret(item)
+	})}, nil
+}
+
+func (h *Handler) GetSecret(ctx context.Context, request *api.GetSecretRequest) (*api.GetSecretResponse, error) {
+	s, err := h.db.GetSecret(ctx, request.SecretID)
+	if err != nil {
+		return nil, secretError(err, "failed to get secret '%d'", request.SecretID)
+	}
+	return &api.GetSecretResponse{Secret: db.PackSecret(s)}, nil
+}
+
+// secretError converts known errors of secrets to http errors.
+func secretError(err error, format string, args ...interface{}) error {
+	switch {
+	case db.IsRecordNotFoundError(err):
+		return &HTTPError{Code: http.StatusNotFound, CauseError: err}
+	case errors.Is(err, secret.ErrExists):
+		return &HTTPError{Code: http.StatusConflict, CauseError: err}
+	case errors.Is(err, secret.ErrDisabled):
+		return &HTTPError{Code: http.StatusBadRequest, CauseError: err}
+	default:
+		return errors.WithMessagef(err, format, args...)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cox96de/runner/api"
 	"github.com/cox96de/runner/app/server/dispatch"
 	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/lib"
 	"github.com/cox96de/runner/log"
 	"github.com/samber/lo"
 )
@@ -30,6 +31,7 @@ func (h *Handler) UpdateStepExecution(ctx context.Context, request *api.UpdateSt
 			ID:       stepExecution.ID,
 			Status:   request.Status,
 			ExitCode: request.ExitCode,
+			Reason:   request.Reason,
 		}
 		switch {
 		case *request.Status == api.StatusPreparing:
@@ -46,9 +48,14 @@ func (h *Handler) UpdateStepExecution(ctx context.Context, request *api.UpdateSt
 		if err = h.eventhook.SendStepExecutionEvent(ctx, updatedStep); err != nil {
 			return nil, errors.WithMessagef(err, "failed to send step execution event '%d'", request.StepExecutionID)
 		}
+		stepExecution = updatedStep
+	}
+	packedStepExecution, err := db.PackStepExecution(stepExecution)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to pack step execution '%d'", request.StepExecutionID)
 	}
 	return &api.UpdateStepExecutionResponse{
-		StepExecution: db.PackStepExecution(stepExecution),
+		StepExecution: packedStepExecution,
 	}, nil
 }
 
@@ -57,7 +64,84 @@ func (h *Handler) GetStepExecution(ctx context.Context, request *api.GetStepExec
 	if err != nil {
 		return nil, errors.WithMessagef(err, "failed to get step execution '%d'", request.StepExecutionID)
 	}
+	packedStepExecution, err := db.PackStepExecution(stepExecution)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to pack step execution '%d'", request.StepExecutionID)
+	}
 	return &api.GetStepExecutionResponse{
-		StepExecution: db.PackStepExecution(stepExecution),
+		StepExecution: packedStepExecution,
+	}, nil
+}
+
+// RetryStepExecution creates a new step execution as the next attempt of a failed step execution.
+// The step execution must be the latest attempt of the step, and the step must have attempts left.
+func (h *Handler) RetryStepExecution(ctx context.Context, request *api.RetryStepExecutionRequest) (*api.RetryStepExecutionResponse, error) {
+	stepExecution, err := h.db.GetStepExecution(ctx, request.StepExecutionID)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to get step execution '%d'", request.StepExecutionID)
+	}
+	if stepExecution.Status != api.StatusFailed {
+		return nil, errors.Errorf("step execution '%d' is not failed, status: %s", stepExecution.ID, stepExecution.Status)
+	}
+	// Lock the job execution, so that concurrent retries never create the same attempt.
+	lockKey := lib.BuildJobExecutionLockKey(stepExecution.JobExecutionID)
+	lock, err := h.locker.Lock(ctx, lockKey, "retry_step_execution", time.Second)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to lock job execution '%d'", stepExecution.JobExecutionID)
+	}
+	if !lock {
+		return nil, errors.Errorf("job execution '%d' is locked", stepExecution.JobExecutionID)
+	}
+	defer func() {
+		_, _ = h.locker.Unlock(ctx, lockKey)
+	}()
+	jobExecution, err := h.db.GetJobExecution(ctx, stepExecution.JobExecutionID)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to get job execution '%d'", stepExecution.JobExecutionID)
+	}
+	if !jobExecution.Status.IsRunning() {
+		return nil, errors.Errorf("job execution '%d' is not running, status: %s", jobExecution.ID, jobExecution.Status)
+	}
+	step, err := h.db.GetStepByID(ctx, stepExecution.StepID)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to get step '%d'", stepExecution.StepID)
+	}
+	stepExecutions, err := h.db.GetStepExecutionsByJobExecutionID(ctx, jobExecution.ID)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to get step executions of job execution '%d'", jobExecution.ID)
+	}
+	for _, e := range stepExecutions {
+		if e.StepID == step.ID && e.ID > stepExecution.ID {
+			return nil, errors.Errorf("step execution '%d' is not the latest attempt of step '%s'", stepExecution.ID, step.Name)
+		}
+	}
+	packedStep, err := db.PackStep(step, []*db.StepExecution{stepExecution})
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to pack step '%d'", step.ID)
+	}
+	attempt := max(stepExecution.Attempt, 1)
+	if attempt >= packedStep.Retry.GetMaxAttempts() {
+		return nil, errors.Errorf("step '%s' has no attempts left, max attempts: %d", step.Name,
+			packedStep.Retry.GetMaxAttempts())
+	}
+	createdStepExecutions, err := h.db.CreateStepExecutions(ctx, []*db.CreateStepExecutionOption{{
+		JobExecutionID: stepExecution.JobExecutionID,
+		StepID:         stepExecution.StepID,
+		Status:         api.StatusCreated,
+		Attempt:        attempt + 1,
+	}})
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to create step execution for step '%s'", step.Name)
+	}
+	createdStepExecution := createdStepExecutions[0]
+	if err = h.eventhook.SendStepExecutionEvent(ctx, createdStepExecution); err != nil {
+		return nil, errors.WithMessagef(err, "failed to send step execution event '%d'", createdStepExecution.ID)
+	}
+	packedStepExecution, err := db.PackStepExecution(createdStepExecution)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to pack step execution '%d'", createdStepExecution.ID)
+	}
+	return &api.RetryStepExecutionResponse{
+		StepExecution: packedStepExecution,
 	}, nil
 }
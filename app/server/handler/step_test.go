@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	"github.com/cox96de/runner/app/server/eventhook"
@@ -15,7 +16,7 @@ import (
 
 func TestHandler_UpdateStepExecution(t *testing.T) {
 	handler := NewHandler(mock.NewMockDB(t), nil, nil, nil, nil,
-		eventhook.NewService(eventhook.NewNopSender()))
+		eventhook.NewService(eventhook.NewNopSender()), nil, nil, nil, nil)
 	executions, err := handler.db.CreateStepExecutions(context.Background(), []*db.CreateStepExecutionOption{
 		{
 			JobExecutionID: 1,
@@ -38,3 +39,76 @@ func TestHandler_UpdateStepExecution(t *testing.T) {
 		assert.DeepEqual(t, getStepExecutionResponse.StepExecution.Status, api.StatusRunning)
 	})
 }
+
+func TestHandler_RetryStepExecution(t *testing.T) {
+	ctx := context.Background()
+	handler := NewHandler(mock.NewMockDB(t), nil, nil, mock.NewMockLocker(), nil,
+		eventhook.NewService(eventhook.NewNopSender()), nil, nil, nil, nil)
+	jobExecutions, err := handler.db.CreateJobExecutions(ctx, []*db.CreateJobExecutionOption{
+		{JobID: 1, Status: api.StatusRunning},
+	})
+	assert.NilError(t, err)
+	steps, err := handler.db.CreateSteps(ctx, []*db.CreateStepOption{
+		{JobID: 1, Name: "test", Retry: &api.Retry{MaxAttempts: 2}},
+	})
+	assert.NilError(t, err)
+	stepExecutions, err := handler.db.CreateStepExecutions(ctx, []*db.CreateStepExecutionOption{
+		{JobExecutionID: jobExecutions[0].ID, StepID: steps[0].ID, Status: api.StatusCreated, Attempt: 1},
+	})
+	assert.NilError(t, err)
+	t.Run("not_failed", func(t *testing.T) {
+		_, err := handler.RetryStepExecution(ctx, &api.RetryStepExecutionRequest{StepExecutionID: stepExecutions[0].ID})
+		assert.ErrorContains(t, err, "is not failed")
+	})
+	_, err = handler.db.UpdateStepExecution(ctx, &db.UpdateStepExecutionOption{
+		ID:     stepExecutions[0].ID,
+		Status: lo.ToPtr(api.StatusFailed),
+	})
+	assert.NilError(t, err)
+	retryStepExecutionResponse, err := handler.RetryStepExecution(ctx, &api.RetryStepExecutionRequest{
+		StepExecutionID: stepExecutions[0].ID,
+	})
+	assert.NilError(t, err)
+	retried := retryStepExecutionResponse.StepExecution
+	assert.Equal(t, retried.Attempt, int32(2))
+	assert.Equal(t, retried.Status, api.StatusCreated)
+	assert.Equal(t, retried.StepID, steps[0].ID)
+	t.Run("not_latest", func(t *testing.T) {
+		_, err := handler.RetryStepExecution(ctx, &api.RetryStepExecutionRequest{StepExecutionID: stepExecutions[0].ID})
+		assert.ErrorContains(t, err, "is not the latest attempt")
+	})
+	t.Run("no_attempts_left", func(t *testing.T) {
+		_, err := handler.db.UpdateStepExecution(ctx, &db.UpdateStepExecutionOption{
+			ID:     retried.ID,
+			Status: lo.ToPtr(api.StatusFailed),
+		})
+		assert.NilError(t, err)
+		_, err = handler.RetryStepExecution(ctx, &api.RetryStepExecutionRequest{StepExecutionID: retried.ID})
+		assert.ErrorContains(t, err, "has no attempts left")
+	})
+	t.Run("concurrent", func(t *testing.T) {
+		steps, err := handler.db.CreateSteps(ctx, []*db.CreateStepOption{
+			{JobID: 1, Name: "concurrent", Retry: &api.Retry{MaxAttempts: 10}},
+		})
+		assert.NilError(t, err)
+		stepExecutions, err := handler.db.CreateStepExecutions(ctx, []*db.CreateStepExecutionOption{
+			{JobExecutionID: jobExecutions[0].ID, StepID: steps[0].ID, Status: api.StatusFailed, Attempt: 1},
+		})
+		assert.NilError(t, err)
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _ = handler.RetryStepExecution(ctx, &api.RetryStepExecutionRequest{StepExecutionID: stepExecutions[0].ID})
+			}()
+		}
+		wg.Wait()
+		created, err := handler.db.GetStepExecutionsByJobExecutionID(ctx, jobExecutions[0].ID)
+		assert.NilError(t, err)
+		attempts := lo.FilterMap(created, func(item *db.StepExecution, _ int) (int32, bool) {
+			return item.Attempt, item.StepID == steps[0].ID
+		})
+		assert.DeepEqual(t, attempts, []int32{1, 2})
+	})
+}
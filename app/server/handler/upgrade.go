@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// upgradeProtocol is the protocol of connections upgraded from http for bidirectional streams.
+const upgradeProtocol = "runner-stream"
+
+const (
+	frameEventMessage = "message"
+	// frameEventEnd means the sender won't send messages anymore.
+	frameEventEnd   = "end"
+	frameEventError = "error"
+)
+
+// streamFrame is a frame of an upgraded connection. Frames are sent as json lines.
+type streamFrame struct {
+	Event string          `json:"event"`
+	Data  json.RawMessage `json:"data,omitempty"`
+}
+
+// upgradedConn is a connection upgraded from http. It carries a bidirectional stream of messages.
+type upgradedConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	// writeLock protects writing, messages might be sent concurrently.
+	writeLock sync.Mutex
+}
+
+// upgrade switches the protocol of the connection of the request to upgradeProtocol.
+func upgrade(c *gin.Context) (*upgradedConn, error) {
+	if !strings.EqualFold(c.GetHeader("Upgrade"), upgradeProtocol) {
+		return nil, errors.Errorf("upgrade to '%s' is required", upgradeProtocol)
+	}
+	conn, rw, err := c.Writer.Hijack()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to hijack connection")
+	}
+	_, err = rw.WriteString("HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: " +
+		upgradeProtocol + "\r\n\r\n")
+	if err == nil {
+		err = rw.Flush()
+	}
+	if err != nil {
+		_ = conn.Close()
+		return nil, errors.WithMessage(err, "failed to switch protocol")
+	}
+	return &upgradedConn{conn: conn, reader: rw.Reader}, nil
+}
+
+// recv receives a message. It returns io.EOF if the peer ends the stream.
+func (u *upgradedConn) recv(m interface{}) error {
+	line, err := u.reader.ReadBytes('\n')
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	frame := &streamFrame{}
+	if err = jj.Unmarshal(line, frame); err != nil {
+		return errors.WithMessage(err, "failed to unmarshal frame")
+	}
+	switch frame.Event {
+	case frameEventMessage:
+		return jj.Unmarshal(frame.Data, m)
+	case frameEventEnd:
+		return io.EOF
+	default:
+		return errors.Errorf("unexpected event '%s'", frame.Event)
+	}
+}
+
+func (u *upgradedConn) send(m interface{}) error {
+	return u.writeFrame(frameEventMessage, m)
+}
+
+// end tells the peer no more messages will be sent.
+func (u *upgradedConn) end() error {
+	return u.writeFrame(frameEventEnd, nil)
+}
+
+// sendError sends the error to the peer, the stream is ended by it.
+func (u *upgradedConn) sendError(err error) error {
+	return u.writeFrame(frameEventError, &Message{Message: err})
+}
+
+func (u *upgradedConn) writeFrame(event string, data interface{}) error {
+	frame := &streamFrame{Event: event}
+	if data != nil {
+		bs, err := jj.Marshal(data)
+		if err != nil {
+			return err
+		}
+		frame.Data = bs
+	}
+	bs, err := jj.Marshal(frame)
+	if err != nil {
+		return err
+	}
+	u.writeLock.Lock()
+	defer u.writeLock.Unlock()
+	_, err = u.conn.Write(append(bs, '\n'))
+	return err
+}
+
+func (u *upgradedConn) Close() error {
+	return u.conn.Close()
+}
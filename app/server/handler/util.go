@@ -23,7 +23,7 @@ func getGinHandler[R any, P any](f func(ctx context.Context, request *R) (*P, er
 			JSON(c, http.StatusBadRequest, &Message{Message: err})
 			return
 		}
-		response, err := f(c.Copy(), &request)
+		response, err := f(c.Request.Context(), &request)
 		if err != nil {
 			log.ExtractLogger(c).Errorf("failed to handle request: %+v", err)
 			statusCode := http.StatusInternalServerError
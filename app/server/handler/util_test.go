@@ -66,7 +66,8 @@ func CreateAndPushToStatus(t *testing.T, h *Handler, pipeline *api.PipelineDSL,
 	createPipelineResponse, err := h.CreatePipeline(ctx, &api.CreatePipelineRequest{Pipeline: pipeline})
 	assert.NilError(t, err)
 	jobExecutionID := createPipelineResponse.Pipeline.Jobs[0].Execution.ID
-	PushJobToStatus(t, h, ctx, jobExecutionID, api.StatusCreated, targetStatus)
+	// The job is queued by CreatePipeline.
+	PushJobToStatus(t, h, ctx, jobExecutionID, api.StatusQueued, targetStatus)
 	return createPipelineResponse.Pipeline.Jobs[0]
 }
 
@@ -42,7 +42,11 @@ func (s *Service) getLogsFromOSS(ctx context.Context, jobExecutionID int64, logN
 	if start > int64(len(archive.Logs)) {
 		return nil, nil
 	}
-	return archive.Logs[start:min(start+limit, int64(len(archive.Logs)))], nil
+	end := int64(len(archive.Logs))
+	if limit >= 0 {
+		end = min(start+limit, end)
+	}
+	return archive.Logs[start:end], nil
 }
 
 // Archive archives logs to S3.
@@ -35,4 +35,10 @@ func TestService_Archive(t *testing.T) {
 			assert.Equal(t, logs[i].Output, "line "+strconv.Itoa(i+1))
 		}
 	})
+	t.Run("no_limit", func(t *testing.T) {
+		logs, err := service.GetLogLines(context.Background(), 1, "test", 10, -1)
+		assert.NilError(t, err)
+		assert.Equal(t, len(logs), 90)
+		assert.Equal(t, logs[0].Number, int64(10))
+	})
 }
@@ -38,3 +38,8 @@ func buildLogRedisKey(jobExecutionID int64, logName string) string {
 func buildLogSetRedisKey(jobExecutionID int64) string {
 	return "log_set:" + strconv.FormatInt(jobExecutionID, 10)
 }
+
+// buildLogNotifyChannel returns the channel to publish notification when log lines are appended.
+func buildLogNotifyChannel(jobExecutionID int64, logName string) string {
+	return "log_notify:" + strconv.FormatInt(jobExecutionID, 10) + ":" + logName
+}
@@ -52,6 +52,10 @@ func (s *Service) Append(ctx context.Context, jobExecutionID int64, logName stri
 	if err != nil {
 		return errors.WithMessage(err, "failed to set expire time for cache")
 	}
+	_, err = s.redis.Publish(ctx, buildLogNotifyChannel(jobExecutionID, logName), len(lines)).Result()
+	if err != nil {
+		return errors.WithMessage(err, "failed to notify log lines appended")
+	}
 	// TODO: check count
 	return nil
 }
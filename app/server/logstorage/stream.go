@@ -0,0 +1,63 @@
+package logstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+)
+
+const (
+	// streamPollInterval is the max interval to check new log lines if no notification is received.
+	streamPollInterval = time.Second
+	streamBatchSize    = 1000
+)
+
+// StreamLogLines sends log lines start from offset to send as they are appended.
+// Logs are read from cache while the job execution is running, and from OSS once they are archived.
+// completed reports whether the job execution is completed. StreamLogLines returns when the job execution is completed
+// and all log lines are sent, or ctx is done.
+// send is invoked with log lines and the offset of the next line.
+func (s *Service) StreamLogLines(ctx context.Context, jobExecutionID int64, logName string, offset int64,
+	completed func(ctx context.Context) (bool, error), send func(lines []*api.LogLine, offset int64) error,
+) error {
+	subscriber := s.redis.Subscribe(ctx, buildLogNotifyChannel(jobExecutionID, logName))
+	defer subscriber.Close()
+	notify := subscriber.Channel()
+	for {
+		// Check completion before reading logs, so that no log line appended before completion is missed.
+		done, err := completed(ctx)
+		if err != nil {
+			return errors.WithMessage(err, "failed to check completion of job execution")
+		}
+		for {
+			var lines []*api.LogLine
+			if done {
+				// Logs might be archived to OSS.
+				lines, err = s.GetLogLines(ctx, jobExecutionID, logName, offset, streamBatchSize)
+			} else {
+				lines, err = s.getLogsFromRedis(ctx, jobExecutionID, logName, offset, streamBatchSize)
+			}
+			if err != nil {
+				return errors.WithMessage(err, "failed to get log lines")
+			}
+			if len(lines) == 0 {
+				break
+			}
+			offset += int64(len(lines))
+			if err = send(lines, offset); err != nil {
+				return errors.WithMessage(err, "failed to send log lines")
+			}
+		}
+		if done {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-notify:
+		case <-time.After(streamPollInterval):
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package logstorage
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/mock"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/fs"
+)
+
+func TestService_StreamLogLines(t *testing.T) {
+	service := NewService(mock.NewMockRedis(t), NewFilesystemOSS(fs.NewDir(t, "baseDir").Path()))
+	logs := generateTestLog(300)
+	err := service.Append(context.Background(), 1, "test", logs[:100])
+	assert.NilError(t, err)
+	var completed atomic.Bool
+	isCompleted := func(ctx context.Context) (bool, error) {
+		return completed.Load(), nil
+	}
+	received := make(chan []*api.LogLine, 10)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- service.StreamLogLines(context.Background(), 1, "test", 0, isCompleted,
+			func(lines []*api.LogLine, offset int64) error {
+				received <- lines
+				return nil
+			})
+	}()
+	var got []*api.LogLine
+	receive := func(t *testing.T, count int) {
+		t.Helper()
+		for len(got) < count {
+			select {
+			case lines := <-received:
+				got = append(got, lines...)
+			case <-time.After(time.Second * 5):
+				t.Fatalf("timeout to receive log lines, got %d", len(got))
+			}
+		}
+	}
+	receive(t, 100)
+	err = service.Append(context.Background(), 1, "test", logs[100:200])
+	assert.NilError(t, err)
+	receive(t, 200)
+	// Logs appended before completion are sent from archive.
+	err = service.Append(context.Background(), 1, "test", logs[200:])
+	assert.NilError(t, err)
+	completed.Store(true)
+	err = service.Archive(context.Background(), 1)
+	assert.NilError(t, err)
+	receive(t, 300)
+	select {
+	case err = <-errCh:
+		assert.NilError(t, err)
+	case <-time.After(time.Second * 5):
+		t.Fatal("stream is not ended")
+	}
+	for i, line := range got {
+		assert.Equal(t, line.Number, int64(i))
+	}
+	t.Run("resume", func(t *testing.T) {
+		var resumed []*api.LogLine
+		var lastOffset int64
+		err := service.StreamLogLines(context.Background(), 1, "test", 250, isCompleted,
+			func(lines []*api.LogLine, offset int64) error {
+				resumed = append(resumed, lines...)
+				lastOffset = offset
+				return nil
+			})
+		assert.NilError(t, err)
+		assert.Equal(t, len(resumed), 50)
+		assert.Equal(t, resumed[0].Number, int64(250))
+		assert.Equal(t, lastOffset, int64(300))
+	})
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+		defer cancel()
+		err := service.StreamLogLines(ctx, 2, "test", 0, func(ctx context.Context) (bool, error) {
+			return false, nil
+		}, func(lines []*api.LogLine, offset int64) error {
+			return nil
+		})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
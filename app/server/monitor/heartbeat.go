@@ -39,19 +39,31 @@ func (s *Service) recycleHeartbeatTimeoutJob(ctx context.Context, jobExecutionID
 		trace.WithAttributes(attribute.Int64("job_execution_id", jobExecutionID)))
 	defer span.End()
 	span.AddEvent("Recycle heartbeat timeout job")
+	var updatedStepExecutions []*db.StepExecution
 	err := s.db.Transaction(func(client *db.Client) error {
-		if err := s.completedUnfinishedSteps(ctx, client, jobExecutionID); err != nil {
-			return errors.WithMessagef(err, "failed to complete unfinished steps of job execution %d", jobExecutionID)
+		var err error
+		updatedStepExecutions, err = completeUnfinishedSteps(ctx, client, jobExecutionID)
+		return err
+	})
+	if err != nil {
+		return errors.WithMessagef(err, "failed to complete unfinished steps of job execution %d", jobExecutionID)
+	}
+	// Events are sent after the transaction is committed, so they never announce a state which isn't committed.
+	for _, stepExecution := range updatedStepExecutions {
+		if err = s.eventhook.SendStepExecutionEvent(ctx, stepExecution); err != nil {
+			return errors.WithMessagef(err, "failed to send step execution event %d", stepExecution.ID)
 		}
-		return s.dispatchService.UpdateJobExecution(ctx, client, &db.UpdateJobExecutionOption{
-			ID:     jobExecutionID,
-			Status: lo.ToPtr(api.StatusFailed),
-			Reason: &api.Reason{
-				Reason:  api.FailedReasonHeartbeatTimeout,
-				Message: "",
-			},
-			CompletedAt: lo.ToPtr(time.Now()),
-		})
+	}
+	// The job execution is updated out of the transaction above, as events of it and dispatched jobs are sent once
+	// UpdateJobExecution commits. If it fails, the job execution is recycled again as the job queue is still there.
+	err = s.dispatchService.UpdateJobExecution(ctx, s.db, &db.UpdateJobExecutionOption{
+		ID:     jobExecutionID,
+		Status: lo.ToPtr(api.StatusFailed),
+		Reason: &api.Reason{
+			Reason:  api.FailedReasonHeartbeatTimeout,
+			Message: "",
+		},
+		CompletedAt: lo.ToPtr(time.Now()),
 	})
 	if err != nil {
 		return errors.WithMessage(err, "failed to recycle heartbeat timeout job")
@@ -59,11 +71,13 @@ func (s *Service) recycleHeartbeatTimeoutJob(ctx context.Context, jobExecutionID
 	return s.logstorageService.Archive(ctx, jobExecutionID)
 }
 
-func (s *Service) completedUnfinishedSteps(ctx context.Context, dbCli *db.Client, jobExecutionID int64) error {
+// completeUnfinishedSteps skips unfinished steps of the job execution. It returns the updated step executions.
+func completeUnfinishedSteps(ctx context.Context, dbCli *db.Client, jobExecutionID int64) ([]*db.StepExecution, error) {
 	steps, err := dbCli.GetStepExecutionsByJobExecutionID(ctx, jobExecutionID)
 	if err != nil {
-		return errors.WithMessage(err, "failed to get step executions")
+		return nil, errors.WithMessage(err, "failed to get step executions")
 	}
+	var updatedStepExecutions []*db.StepExecution
 	for _, step := range steps {
 		if step.Status.IsCompleted() {
 			continue
@@ -73,11 +87,9 @@ func (s *Service) completedUnfinishedSteps(ctx context.Context, dbCli *db.Client
 			Status: lo.ToPtr(api.StatusSkipped),
 		})
 		if err != nil {
-			return errors.WithMessagef(err, "failed to update step execution %d", step.ID)
-		}
-		if err = s.eventhook.SendStepExecutionEvent(ctx, updatedStepExecution); err != nil {
-			return errors.WithMessagef(err, "failed to send step execution event %d", step.ID)
+			return nil, errors.WithMessagef(err, "failed to update step execution %d", step.ID)
 		}
+		updatedStepExecutions = append(updatedStepExecutions, updatedStepExecution)
 	}
-	return nil
+	return updatedStepExecutions, nil
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/cox96de/runner/api"
 	"github.com/cox96de/runner/app/server/dispatch"
+	"github.com/cox96de/runner/app/server/notify"
 	"github.com/cox96de/runner/app/server/pipeline"
 	"github.com/cox96de/runner/db"
 	"github.com/cox96de/runner/mock"
@@ -23,7 +24,7 @@ import (
 func TestService_RecycleHeartbeatTimeoutJobs(t *testing.T) {
 	dbCli := mock.NewMockDB(t)
 	eventHook := eventhook.NewService(eventhook.NewNopSender())
-	service := dispatch.NewService(dbCli, eventHook)
+	service := dispatch.NewService(dbCli, eventHook, notify.NewLocalNotifier())
 	pipelineService := pipeline.NewService(dbCli)
 	createPipelineResponse, err := pipelineService.CreatePipeline(context.Background(), &api.PipelineDSL{Jobs: []*api.JobDSL{
 		{
@@ -60,7 +61,7 @@ func TestService_RecycleHeartbeatTimeoutJobs(t *testing.T) {
 	err = dbCli.TouchHeartbeat(context.Background(), jobExecution.ID)
 	assert.NilError(t, err)
 	s := NewService(dbCli, logstorage.NewService(mock.NewMockRedis(t), logstorage.NewFilesystemOSS(fs.NewDir(t, "baseDir").Path())),
-		eventHook, dispatch.NewService(dbCli, eventHook))
+		eventHook, dispatch.NewService(dbCli, eventHook, notify.NewLocalNotifier()))
 	t.Run("not_timeout", func(t *testing.T) {
 		err = s.RecycleHeartbeatTimeoutJobs(context.Background(), time.Second)
 		assert.NilError(t, err)
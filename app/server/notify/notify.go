@@ -0,0 +1,117 @@
+// Package notify broadcasts notifications of queued jobs to agents waiting for jobs.
+package notify
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/log"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// subscriberBufferSize is the buffer size of a subscription, notifications are dropped if the buffer is full.
+const subscriberBufferSize = 64
+
+// Notifier notifies subscribers that a job is queued. The payload of a notification is the label expression of
+// the queued job.
+type Notifier interface {
+	// Notify notifies all subscribers that a job with the label expression is queued.
+	Notify(ctx context.Context, label string) error
+	// Subscribe subscribes notifications until ctx is done, the returned channel is closed then.
+	Subscribe(ctx context.Context) (<-chan string, error)
+}
+
+// LocalNotifier notifies subscribers in the same process.
+type LocalNotifier struct {
+	lock        sync.Mutex
+	subscribers map[chan string]struct{}
+}
+
+func NewLocalNotifier() *LocalNotifier {
+	return &LocalNotifier{subscribers: map[chan string]struct{}{}}
+}
+
+// Notify sends the label to all subscribers. It never blocks, the notification is dropped for subscribers
+// which are busy.
+func (n *LocalNotifier) Notify(_ context.Context, label string) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for subscriber := range n.subscribers {
+		select {
+		case subscriber <- label:
+		default:
+		}
+	}
+	return nil
+}
+
+func (n *LocalNotifier) Subscribe(ctx context.Context) (<-chan string, error) {
+	subscriber := make(chan string, subscriberBufferSize)
+	n.lock.Lock()
+	n.subscribers[subscriber] = struct{}{}
+	n.lock.Unlock()
+	go func() {
+		<-ctx.Done()
+		n.lock.Lock()
+		delete(n.subscribers, subscriber)
+		n.lock.Unlock()
+		close(subscriber)
+	}()
+	return subscriber, nil
+}
+
+// RedisNotifier notifies subscribers in all processes through redis pub/sub. It's used when there are several
+// replicas of the server.
+type RedisNotifier struct {
+	client  *goredis.Client
+	channel string
+	local   *LocalNotifier
+	lock    sync.Mutex
+	// subscribed is true if the redis channel is subscribed. The redis channel is subscribed once and
+	// notifications are dispatched to local subscribers.
+	subscribed bool
+}
+
+func NewRedisNotifier(client *goredis.Client, channel string) *RedisNotifier {
+	return &RedisNotifier{client: client, channel: channel, local: NewLocalNotifier()}
+}
+
+func (n *RedisNotifier) Notify(ctx context.Context, label string) error {
+	if err := n.client.Publish(ctx, n.channel, label).Err(); err != nil {
+		return errors.WithMessagef(err, "failed to publish to channel '%s'", n.channel)
+	}
+	return nil
+}
+
+func (n *RedisNotifier) Subscribe(ctx context.Context) (<-chan string, error) {
+	if err := n.subscribe(ctx); err != nil {
+		return nil, err
+	}
+	return n.local.Subscribe(ctx)
+}
+
+// subscribe subscribes the redis channel if it's not subscribed yet.
+func (n *RedisNotifier) subscribe(ctx context.Context) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.subscribed {
+		return nil
+	}
+	// The subscription lives as long as the notifier, so it's not bound to ctx.
+	pubsub := n.client.Subscribe(context.Background(), n.channel)
+	// Wait for the confirmation, so notifications published after Subscribe returns are not missed.
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return errors.WithMessagef(err, "failed to subscribe channel '%s'", n.channel)
+	}
+	n.subscribed = true
+	go func() {
+		for message := range pubsub.Channel() {
+			if err := n.local.Notify(context.Background(), message.Payload); err != nil {
+				log.Warningf("failed to notify local subscribers: %v", err)
+			}
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,54 @@
+package notify
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cox96de/runner/mock"
+	"gotest.tools/v3/assert"
+)
+
+func testNotifier(t *testing.T, publisher Notifier, subscriber Notifier) {
+	ctx := context.Background()
+	subscribeCtx, cancel := context.WithCancel(ctx)
+	a, err := subscriber.Subscribe(subscribeCtx)
+	assert.NilError(t, err)
+	b, err := subscriber.Subscribe(ctx)
+	assert.NilError(t, err)
+	assert.NilError(t, publisher.Notify(ctx, "linux"))
+	for _, c := range []<-chan string{a, b} {
+		select {
+		case label := <-c:
+			assert.Equal(t, label, "linux")
+		case <-time.After(time.Second * 5):
+			t.Fatal("notification is not received")
+		}
+	}
+	cancel()
+	select {
+	case _, ok := <-a:
+		assert.Assert(t, !ok)
+	case <-time.After(time.Second * 5):
+		t.Fatal("subscription is not closed")
+	}
+}
+
+func TestLocalNotifier(t *testing.T) {
+	notifier := NewLocalNotifier()
+	testNotifier(t, notifier, notifier)
+	t.Run("drop", func(t *testing.T) {
+		c, err := notifier.Subscribe(context.Background())
+		assert.NilError(t, err)
+		for i := 0; i < subscriberBufferSize+1; i++ {
+			assert.NilError(t, notifier.Notify(context.Background(), "linux"))
+		}
+		assert.Equal(t, len(c), subscriberBufferSize)
+	})
+}
+
+func TestRedisNotifier(t *testing.T) {
+	conn := mock.NewMockRedisConn(t)
+	// Notifiers share the same redis as server replicas.
+	testNotifier(t, NewRedisNotifier(conn, "job_queued"), NewRedisNotifier(conn, "job_queued"))
+}
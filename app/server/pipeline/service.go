@@ -2,6 +2,7 @@ package pipeline
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/cockroachdb/errors"
 	"github.com/cox96de/runner/api"
@@ -18,51 +19,70 @@ func NewService(dbClient *db.Client) *Service {
 }
 
 type CreatePipelineResponse struct {
-	CreatedPipeline       *db.Pipeline
-	CreatedJobs           []*db.Job
-	CreatedJobExecutions  []*db.JobExecution
-	CreatedSteps          []*db.Step
-	CreatedStepExecutions []*db.StepExecution
+	CreatedPipeline          *db.Pipeline
+	CreatedPipelineExecution *db.PipelineExecution
+	CreatedJobs              []*db.Job
+	CreatedJobExecutions     []*db.JobExecution
+	CreatedSteps             []*db.Step
+	CreatedStepExecutions    []*db.StepExecution
 }
 
 // CreatePipeline creates a new pipeline and inserts into db.
+// Jobs with matrix are expanded into a job for each combination.
 func (s *Service) CreatePipeline(ctx context.Context, pipeline *api.PipelineDSL) (*CreatePipelineResponse, error) {
-	createJobOpts := make([]*db.CreateJobOption, 0, len(pipeline.Jobs))
-	createJobExecutionOpts := make([]*db.CreateJobExecutionOption, 0, len(pipeline.Jobs))
+	jobs, matrices, err := api.ExpandMatrices(pipeline.Jobs)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to expand matrices")
+	}
+	createJobOpts := make([]*db.CreateJobOption, 0, len(jobs))
+	createJobExecutionOpts := make([]*db.CreateJobExecutionOption, 0, len(jobs))
 	createStepOptMap := make(map[string][]*db.CreateStepOption)
 	createStepExecutionOpts := make([]*db.CreateStepExecutionOption, 0)
-	for _, job := range pipeline.Jobs {
+	for i, job := range jobs {
 		createJobOpts = append(createJobOpts, &db.CreateJobOption{
-			Name:             job.Name,
-			RunsOn:           job.RunsOn,
-			WorkingDirectory: job.WorkingDirectory,
-			EnvVar:           job.EnvVar,
-			DependsOn:        job.DependsOn,
-			Timeout:          job.Timeout,
+			Name:               job.Name,
+			RunsOn:             job.RunsOn,
+			WorkingDirectory:   job.WorkingDirectory,
+			EnvVar:             job.EnvVar,
+			Secrets:            job.Secrets,
+			DependsOn:          job.DependsOn,
+			Timeout:            job.Timeout,
+			MaxStepParallelism: job.MaxStepParallelism,
+			Artifacts:          job.Artifacts,
+			DownloadArtifacts:  job.DownloadArtifacts,
+			Cache:              job.Cache,
+			KeepAliveOnFailure: job.KeepAliveOnFailure,
+			Matrix:             matrices[i],
 		})
 		var stepOpts []*db.CreateStepOption
 		for _, step := range job.Steps {
 			stepOpts = append(stepOpts, &db.CreateStepOption{
 				Name:             step.Name,
 				User:             step.User,
+				Container:        getStepContainer(job, step),
 				WorkingDirectory: step.WorkingDirectory,
 				EnvVar:           step.EnvVar,
 				DependsOn:        step.DependsOn,
 				Commands:         step.Commands,
 				Script:           step.Script,
+				If:               step.If,
+				Timeout:          step.Timeout,
+				Retry:            step.Retry,
+				Artifacts:        step.Artifacts,
 			})
 		}
 		createStepOptMap[job.Name] = stepOpts
 	}
-	var (
-		r   = &CreatePipelineResponse{}
-		err error
-	)
+	r := &CreatePipelineResponse{}
 	err = s.dbClient.Transaction(func(client *db.Client) error {
-		r.CreatedPipeline, err = client.CreatePipeline(ctx)
+		r.CreatedPipeline, err = client.CreatePipeline(ctx, pipeline.Project)
 		if err != nil {
 			return errors.WithMessage(err, "failed to create pipeline")
 		}
+		r.CreatedPipelineExecution, err = client.CreatePipelineExecution(ctx, r.CreatedPipeline.ID)
+		if err != nil {
+			return errors.WithMessage(err, "failed to create pipeline execution")
+		}
 		lo.ForEach(createJobOpts, func(opt *db.CreateJobOption, i int) {
 			opt.PipelineID = r.CreatedPipeline.ID
 		})
@@ -103,12 +123,116 @@ func (s *Service) CreatePipeline(ctx context.Context, pipeline *api.PipelineDSL)
 				JobExecutionID: jobExecutionByJobIDMap[step.JobID].ID,
 				StepID:         step.ID,
 				Status:         api.StatusCreated,
+				Attempt:        1,
+			})
+		}
+		r.CreatedStepExecutions, err = client.CreateStepExecutions(ctx, createStepExecutionOpts)
+		if err != nil {
+			return errors.WithMessage(err, "failed to create step executions")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// getStepContainer returns the container which the step runs in.
+// If the step doesn't specify a container, the default container of the job is used.
+func getStepContainer(job *api.JobDSL, step *api.StepDSL) string {
+	if step.Container != "" {
+		return step.Container
+	}
+	if job.RunsOn != nil && job.RunsOn.Docker != nil {
+		return job.RunsOn.Docker.DefaultContainer
+	}
+	return ""
+}
+
+type RerunResponse struct {
+	// Jobs are all jobs of the pipeline.
+	Jobs []*db.Job
+	// LatestJobExecutions are the latest job executions of all jobs, include the created ones.
+	LatestJobExecutions []*db.JobExecution
+	// CreatedJobExecutions are the new job executions of rerun jobs.
+	CreatedJobExecutions  []*db.JobExecution
+	CreatedStepExecutions []*db.StepExecution
+}
+
+// Rerun creates new job executions and step executions for jobs of the pipeline.
+// filter selects the jobs to rerun by the job and its latest execution.
+// If withDependents is true, jobs depend on the selected jobs directly or indirectly are rerun as well.
+// All rerun jobs must be completed. The pipeline is locked while the jobs are checked and the executions are created.
+func (s *Service) Rerun(ctx context.Context, pipelineID int64, filter func(job *db.Job, latest *db.JobExecution) bool,
+	withDependents bool,
+) (*RerunResponse, error) {
+	var (
+		r   = &RerunResponse{}
+		err error
+	)
+	err = s.dbClient.Transaction(func(client *db.Client) error {
+		// Lock the pipeline before checking the jobs, so that concurrent reruns never create executions for a job twice.
+		if err = client.LockPipeline(ctx, pipelineID); err != nil {
+			return errors.WithMessagef(err, "failed to lock pipeline '%d'", pipelineID)
+		}
+		r.Jobs, err = client.GetJobsByPipelineID(ctx, pipelineID)
+		if err != nil {
+			return errors.WithMessagef(err, "failed to get jobs of pipeline '%d'", pipelineID)
+		}
+		latestByJobID, err := getLatestJobExecutions(ctx, client, r.Jobs)
+		if err != nil {
+			return err
+		}
+		rerunJobs := lo.Filter(r.Jobs, func(job *db.Job, _ int) bool {
+			return filter(job, latestByJobID[job.ID])
+		})
+		if withDependents {
+			rerunJobs, err = withDependentJobs(r.Jobs, rerunJobs)
+			if err != nil {
+				return err
+			}
+		}
+		if len(rerunJobs) == 0 {
+			return errors.New("no job to rerun")
+		}
+		createJobExecutionOpts := make([]*db.CreateJobExecutionOption, 0, len(rerunJobs))
+		for _, job := range rerunJobs {
+			if status := latestByJobID[job.ID].Status; !status.IsCompleted() {
+				return errors.Errorf("job '%s' is not completed, status: %s", job.Name, status)
+			}
+			createJobExecutionOpts = append(createJobExecutionOpts, &db.CreateJobExecutionOption{
+				JobID:  job.ID,
+				Status: api.StatusCreated,
 			})
 		}
+		r.CreatedJobExecutions, err = client.CreateJobExecutions(ctx, createJobExecutionOpts)
+		if err != nil {
+			return errors.WithMessage(err, "failed to create job executions")
+		}
+		createStepExecutionOpts := make([]*db.CreateStepExecutionOption, 0)
+		for _, execution := range r.CreatedJobExecutions {
+			latestByJobID[execution.JobID] = execution
+			steps, err := client.GetStepsByJobID(ctx, execution.JobID)
+			if err != nil {
+				return errors.WithMessagef(err, "failed to get steps of job '%d'", execution.JobID)
+			}
+			for _, step := range steps {
+				createStepExecutionOpts = append(createStepExecutionOpts, &db.CreateStepExecutionOption{
+					JobExecutionID: execution.ID,
+					StepID:         step.ID,
+					Status:         api.StatusCreated,
+					Attempt:        1,
+				})
+			}
+		}
 		r.CreatedStepExecutions, err = client.CreateStepExecutions(ctx, createStepExecutionOpts)
 		if err != nil {
 			return errors.WithMessage(err, "failed to create step executions")
 		}
+		r.LatestJobExecutions = lo.Map(r.Jobs, func(job *db.Job, _ int) *db.JobExecution {
+			return latestByJobID[job.ID]
+		})
 		return nil
 	})
 	if err != nil {
@@ -116,3 +240,56 @@ func (s *Service) CreatePipeline(ctx context.Context, pipeline *api.PipelineDSL)
 	}
 	return r, nil
 }
+
+// withDependentJobs returns the selected jobs and all jobs depend on them directly or indirectly.
+func withDependentJobs(jobs []*db.Job, selected []*db.Job) ([]*db.Job, error) {
+	// job name -> names of jobs depend on it.
+	dependents := make(map[string][]string)
+	for _, job := range jobs {
+		var dependsOn []string
+		if len(job.DependsOn) > 0 {
+			if err := json.Unmarshal(job.DependsOn, &dependsOn); err != nil {
+				return nil, errors.WithMessagef(err, "failed to unmarshal depends on of job '%s'", job.Name)
+			}
+		}
+		for _, dep := range dependsOn {
+			dependents[dep] = append(dependents[dep], job.Name)
+		}
+	}
+	visited := make(map[string]bool)
+	queue := lo.Map(selected, func(job *db.Job, _ int) string {
+		return job.Name
+	})
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if visited[name] {
+			continue
+		}
+		visited[name] = true
+		queue = append(queue, dependents[name]...)
+	}
+	return lo.Filter(jobs, func(job *db.Job, _ int) bool {
+		return visited[job.Name]
+	}), nil
+}
+
+// GetLatestJobExecutions returns the latest job executions of jobs. The result is keyed by job id.
+func (s *Service) GetLatestJobExecutions(ctx context.Context, jobs []*db.Job) (map[int64]*db.JobExecution, error) {
+	return getLatestJobExecutions(ctx, s.dbClient, jobs)
+}
+
+func getLatestJobExecutions(ctx context.Context, client *db.Client, jobs []*db.Job) (map[int64]*db.JobExecution, error) {
+	latestByJobID, err := client.GetLatestJobExecutions(ctx, lo.Map(jobs, func(job *db.Job, _ int) int64 {
+		return job.ID
+	}))
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get job executions")
+	}
+	for _, job := range jobs {
+		if _, ok := latestByJobID[job.ID]; !ok {
+			return nil, errors.Errorf("job '%s' has no execution", job.Name)
+		}
+	}
+	return latestByJobID, nil
+}
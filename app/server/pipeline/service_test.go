@@ -2,10 +2,14 @@ package pipeline
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/db"
 	"github.com/cox96de/runner/mock"
+	"github.com/samber/lo"
 	"gotest.tools/v3/assert"
 )
 
@@ -26,3 +30,128 @@ func TestService_CreatePipeline(t *testing.T) {
 	})
 	assert.NilError(t, err)
 }
+
+func TestService_CreatePipeline_Container(t *testing.T) {
+	service := NewService(mock.NewMockDB(t))
+	response, err := service.CreatePipeline(context.Background(), &api.PipelineDSL{
+		Jobs: []*api.JobDSL{
+			{
+				Name: "job1",
+				RunsOn: &api.RunsOn{
+					Docker: &api.Docker{
+						Containers:       []*api.Container{{Name: "golang"}, {Name: "node"}},
+						DefaultContainer: "golang",
+					},
+				},
+				Steps: []*api.StepDSL{
+					{
+						Name:      "step1",
+						Container: "node",
+					},
+					{
+						Name: "step2",
+					},
+				},
+			},
+		},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, response.CreatedSteps[0].Container, "node")
+	assert.Equal(t, response.CreatedSteps[1].Container, "golang")
+}
+
+func TestService_Rerun(t *testing.T) {
+	dbClient := mock.NewMockDB(t)
+	service := NewService(dbClient)
+	// job1 <- job2 <- job3, job4
+	response, err := service.CreatePipeline(context.Background(), &api.PipelineDSL{
+		Jobs: []*api.JobDSL{
+			{Name: "job1", Steps: []*api.StepDSL{{Name: "step1"}}},
+			{Name: "job2", Steps: []*api.StepDSL{{Name: "step1"}, {Name: "step2"}}, DependsOn: []string{"job1"}},
+			{Name: "job3", Steps: []*api.StepDSL{{Name: "step1"}}, DependsOn: []string{"job2"}},
+			{Name: "job4", Steps: []*api.StepDSL{{Name: "step1"}}},
+		},
+	})
+	assert.NilError(t, err)
+	pipelineID := response.CreatedPipeline.ID
+	jobIDNameMap := lo.SliceToMap(response.CreatedJobs, func(item *db.Job) (int64, string) {
+		return item.ID, item.Name
+	})
+	selectJob := func(name string) func(job *db.Job, latest *db.JobExecution) bool {
+		return func(job *db.Job, latest *db.JobExecution) bool {
+			return job.Name == name
+		}
+	}
+	t.Run("not_completed", func(t *testing.T) {
+		_, err := service.Rerun(context.Background(), pipelineID, selectJob("job1"), false)
+		assert.ErrorContains(t, err, "not completed")
+	})
+	for _, execution := range response.CreatedJobExecutions {
+		_, err := dbClient.UpdateJobExecution(context.Background(), &db.UpdateJobExecutionOption{
+			ID:     execution.ID,
+			Status: lo.ToPtr(api.StatusSucceeded),
+		})
+		assert.NilError(t, err)
+	}
+	t.Run("no_job", func(t *testing.T) {
+		_, err := service.Rerun(context.Background(), pipelineID, selectJob("not_exist"), true)
+		assert.ErrorContains(t, err, "no job to rerun")
+	})
+	t.Run("without_dependents", func(t *testing.T) {
+		rerunResponse, err := service.Rerun(context.Background(), pipelineID, selectJob("job2"), false)
+		assert.NilError(t, err)
+		assert.Equal(t, len(rerunResponse.Jobs), 4)
+		assert.Equal(t, len(rerunResponse.CreatedJobExecutions), 1)
+		created := rerunResponse.CreatedJobExecutions[0]
+		assert.Equal(t, jobIDNameMap[created.JobID], "job2")
+		assert.Equal(t, created.Status, api.StatusCreated)
+		assert.Equal(t, len(rerunResponse.CreatedStepExecutions), 2)
+		for _, stepExecution := range rerunResponse.CreatedStepExecutions {
+			assert.Equal(t, stepExecution.JobExecutionID, created.ID)
+			assert.Equal(t, stepExecution.Status, api.StatusCreated)
+		}
+		for _, latest := range rerunResponse.LatestJobExecutions {
+			if latest.JobID == created.JobID {
+				assert.Equal(t, latest.ID, created.ID)
+			} else {
+				assert.Equal(t, latest.Status, api.StatusSucceeded)
+			}
+		}
+		t.Run("rerun_not_completed", func(t *testing.T) {
+			_, err := service.Rerun(context.Background(), pipelineID, selectJob("job1"), true)
+			assert.ErrorContains(t, err, "job 'job2' is not completed")
+		})
+		_, err = dbClient.UpdateJobExecution(context.Background(), &db.UpdateJobExecutionOption{
+			ID:     created.ID,
+			Status: lo.ToPtr(api.StatusFailed),
+		})
+		assert.NilError(t, err)
+	})
+	t.Run("with_dependents", func(t *testing.T) {
+		rerunResponse, err := service.Rerun(context.Background(), pipelineID, selectJob("job1"), true)
+		assert.NilError(t, err)
+		names := lo.Map(rerunResponse.CreatedJobExecutions, func(item *db.JobExecution, _ int) string {
+			return jobIDNameMap[item.JobID]
+		})
+		assert.DeepEqual(t, names, []string{"job1", "job2", "job3"})
+		assert.Equal(t, len(rerunResponse.CreatedStepExecutions), 4)
+	})
+	t.Run("concurrent", func(t *testing.T) {
+		var (
+			wg      sync.WaitGroup
+			created atomic.Int32
+		)
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := service.Rerun(context.Background(), pipelineID, selectJob("job4"), false); err == nil {
+					created.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+		// The first rerun creates a job execution, others find job4 is not completed.
+		assert.Equal(t, created.Load(), int32(1))
+	})
+}
@@ -0,0 +1,124 @@
+// Package schedule manages schedules and creates pipelines from them periodically.
+package schedule
+
+import (
+	"context"
+	"time"
+	// Timezones of schedules are available even if the system has no zoneinfo, e.g. in a minimal image.
+	_ "time/tzdata"
+
+	"github.com/andeya/goutil/calendar/cron"
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/app/server/dispatch"
+	"github.com/cox96de/runner/app/server/pipeline"
+	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/lib"
+	"github.com/cox96de/runner/util"
+	"github.com/samber/lo"
+)
+
+// ErrInvalid marks errors caused by invalid fields of a schedule.
+const ErrInvalid = util.StringError("invalid schedule")
+
+// Service manages schedules and triggers their runs.
+type Service struct {
+	db              *db.Client
+	pipelineService *pipeline.Service
+	dispatchService *dispatch.Service
+	locker          lib.Locker
+}
+
+func NewService(db *db.Client, pipelineService *pipeline.Service, dispatchService *dispatch.Service,
+	locker lib.Locker,
+) *Service {
+	return &Service{db: db, pipelineService: pipelineService, dispatchService: dispatchService, locker: locker}
+}
+
+// Create validates and creates a schedule, the next run is calculated from now.
+func (s *Service) Create(ctx context.Context, option *db.CreateScheduleOption) (*db.Schedule, error) {
+	spec, location, err := validate(option.Name, option.Cron, option.Timezone, option.Pipeline, option.CatchUpPolicy)
+	if err != nil {
+		return nil, err
+	}
+	option.NextRunAt, err = nextRun(spec, location, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return s.db.CreateSchedule(ctx, option)
+}
+
+// Update validates and updates fields of a schedule which are set. The next run is recalculated from now if the
+// cron expression, the timezone or the enabled flag is set, so runs missed while a schedule is disabled are
+// never fired.
+func (s *Service) Update(ctx context.Context, option *db.UpdateScheduleOption) (*db.Schedule, error) {
+	schedule, err := s.db.GetSchedule(ctx, option.ID)
+	if err != nil {
+		return nil, err
+	}
+	dsl := option.Pipeline
+	if dsl == nil {
+		dsl, err = db.UnmarshalSchedulePipeline(schedule.Pipeline)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed to unmarshal pipeline")
+		}
+	}
+	spec, location, err := validate(lo.FromPtrOr(option.Name, schedule.Name),
+		lo.FromPtrOr(option.Cron, schedule.Cron), lo.FromPtrOr(option.Timezone, schedule.Timezone), dsl,
+		lo.FromPtrOr(option.CatchUpPolicy, schedule.CatchUpPolicy))
+	if err != nil {
+		return nil, err
+	}
+	if option.Cron != nil || option.Timezone != nil || option.Enabled != nil {
+		next, err := nextRun(spec, location, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		option.NextRunAt = &next
+	}
+	return s.db.UpdateSchedule(ctx, option)
+}
+
+// validate validates fields of a schedule, it returns the parsed cron expression and timezone.
+func validate(name string, expression string, timezone string, dsl *api.PipelineDSL, policy api.CatchUpPolicy,
+) (cron.Schedule, *time.Location, error) {
+	if name == "" {
+		return nil, nil, errors.Mark(errors.New("name is required"), ErrInvalid)
+	}
+	if _, ok := api.CatchUpPolicy_name[int32(policy)]; !ok {
+		return nil, nil, errors.Mark(errors.Errorf("unknown catch up policy '%d'", policy), ErrInvalid)
+	}
+	if dsl == nil {
+		return nil, nil, errors.Mark(errors.New("pipeline is required"), ErrInvalid)
+	}
+	if err := api.ValidateDSL(dsl); err != nil {
+		return nil, nil, errors.Mark(errors.WithMessage(err, "failed to validate pipeline DSL"), ErrInvalid)
+	}
+	spec, location, err := parse(expression, timezone)
+	if err != nil {
+		return nil, nil, errors.Mark(err, ErrInvalid)
+	}
+	return spec, location, nil
+}
+
+// parse parses the cron expression and the timezone of a schedule. Empty timezone means UTC.
+func parse(expression string, timezone string) (cron.Schedule, *time.Location, error) {
+	spec, err := cron.ParseStandard(expression)
+	if err != nil {
+		return nil, nil, errors.WithMessagef(err, "invalid cron expression '%s'", expression)
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, nil, errors.WithMessagef(err, "invalid timezone '%s'", timezone)
+	}
+	return spec, location, nil
+}
+
+// nextRun returns the first run after the time in UTC, the cron expression is evaluated in the location.
+func nextRun(spec cron.Schedule, location *time.Location, after time.Time) (time.Time, error) {
+	next := spec.Next(after.In(location))
+	if next.IsZero() {
+		return time.Time{}, errors.Mark(errors.New("cron expression never matches"), ErrInvalid)
+	}
+	return next.UTC(), nil
+}
@@ -0,0 +1,103 @@
+package schedule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/app/server/dispatch"
+	"github.com/cox96de/runner/app/server/eventhook"
+	"github.com/cox96de/runner/app/server/notify"
+	"github.com/cox96de/runner/app/server/pipeline"
+	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/mock"
+	"github.com/samber/lo"
+	"gotest.tools/v3/assert"
+)
+
+func newService(t *testing.T) (*Service, *db.Client) {
+	dbClient := mock.NewMockDB(t)
+	dispatchService := dispatch.NewService(dbClient, eventhook.NewService(eventhook.NewNopSender()),
+		notify.NewLocalNotifier())
+	return NewService(dbClient, pipeline.NewService(dbClient), dispatchService, mock.NewMockLocker()), dbClient
+}
+
+func getDSL() *api.PipelineDSL {
+	return &api.PipelineDSL{
+		Parameters: map[string]string{"branch": "main"},
+		Jobs: []*api.JobDSL{
+			{
+				Name:   "nightly",
+				RunsOn: &api.RunsOn{Label: "linux"},
+				Steps:  []*api.StepDSL{{Name: "build", Commands: []string{"git checkout ${{ parameters.branch }}"}}},
+			},
+		},
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newService(t)
+	created, err := service.Create(ctx, &db.CreateScheduleOption{
+		Name:     "nightly",
+		Cron:     "0 2 * * *",
+		Timezone: "Asia/Shanghai",
+		Pipeline: getDSL(),
+		Enabled:  true,
+	})
+	assert.NilError(t, err)
+	// 02:00 in Shanghai is 18:00 in UTC.
+	assert.Equal(t, created.NextRunAt.UTC().Hour(), 18)
+	assert.Assert(t, created.NextRunAt.After(time.Now()))
+	packed, err := db.PackSchedule(created)
+	assert.NilError(t, err)
+	assert.Equal(t, packed.Pipeline.Jobs[0].Name, "nightly")
+	assert.Assert(t, packed.LastRunAt == nil)
+	t.Run("invalid", func(t *testing.T) {
+		for _, c := range []struct {
+			option *db.CreateScheduleOption
+			err    string
+		}{
+			{option: &db.CreateScheduleOption{Cron: "@daily", Pipeline: getDSL()}, err: "name is required"},
+			{option: &db.CreateScheduleOption{Name: "a", Cron: "0 2 * *", Pipeline: getDSL()}, err: "invalid cron"},
+			{
+				option: &db.CreateScheduleOption{Name: "a", Cron: "@daily", Timezone: "Mars/Olympus", Pipeline: getDSL()},
+				err:    "invalid timezone",
+			},
+			{option: &db.CreateScheduleOption{Name: "a", Cron: "@daily"}, err: "pipeline is required"},
+			{
+				option: &db.CreateScheduleOption{Name: "a", Cron: "@daily", Pipeline: &api.PipelineDSL{}},
+				err:    "failed to validate pipeline DSL",
+			},
+			{
+				option: &db.CreateScheduleOption{Name: "a", Cron: "@daily", Pipeline: getDSL(), CatchUpPolicy: 100},
+				err:    "unknown catch up policy",
+			},
+			{option: &db.CreateScheduleOption{Name: "a", Cron: "0 0 30 2 *", Pipeline: getDSL()}, err: "never matches"},
+		} {
+			_, err := service.Create(ctx, c.option)
+			assert.ErrorContains(t, err, c.err)
+			assert.Assert(t, errors.Is(err, ErrInvalid))
+		}
+	})
+	t.Run("update", func(t *testing.T) {
+		updated, err := service.Update(ctx, &db.UpdateScheduleOption{
+			ID:            created.ID,
+			CatchUpPolicy: lo.ToPtr(api.CatchUpPolicyAll),
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, updated.CatchUpPolicy, api.CatchUpPolicyAll)
+		assert.Equal(t, updated.NextRunAt.Unix(), created.NextRunAt.Unix())
+
+		updated, err = service.Update(ctx, &db.UpdateScheduleOption{ID: created.ID, Timezone: lo.ToPtr("")})
+		assert.NilError(t, err)
+		assert.Equal(t, updated.NextRunAt.UTC().Hour(), 2)
+
+		_, err = service.Update(ctx, &db.UpdateScheduleOption{ID: created.ID, Cron: lo.ToPtr("invalid")})
+		assert.Assert(t, errors.Is(err, ErrInvalid))
+		_, err = service.Update(ctx, &db.UpdateScheduleOption{ID: created.ID + 1, Cron: lo.ToPtr("@daily")})
+		assert.Assert(t, db.IsRecordNotFoundError(err))
+	})
+}
@@ -0,0 +1,150 @@
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/andeya/goutil/calendar/cron"
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/lib"
+	"github.com/cox96de/runner/log"
+	"github.com/cox96de/runner/telemetry/trace"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// lateTolerance is how late a run can be fired and is still not considered missed. It should be longer than
+	// the interval of Trigger.
+	lateTolerance = 5 * time.Minute
+	// maxCatchUpRuns is the max number of runs fired at once by CatchUpPolicyAll, the latest ones are fired.
+	maxCatchUpRuns = 10
+	// lockExpiration is the expiration of the lock of a schedule being triggered.
+	lockExpiration = time.Minute
+)
+
+// Trigger fires due runs of enabled schedules. It's called periodically by every replica of the server,
+// a schedule is locked while it's being triggered, so a run is never fired by two replicas.
+func (s *Service) Trigger(ctx context.Context, now time.Time) error {
+	schedules, err := s.db.ListDueSchedules(ctx, now)
+	if err != nil {
+		return errors.WithMessage(err, "failed to list due schedules")
+	}
+	for _, schedule := range schedules {
+		if err = s.trigger(ctx, schedule.ID, now); err != nil {
+			log.ExtractLogger(ctx).Errorf("failed to trigger schedule '%d': %+v", schedule.ID, err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) trigger(ctx context.Context, scheduleID int64, now time.Time) error {
+	logger := log.ExtractLogger(ctx)
+	ctx, span := trace.Start(ctx, "schedule.trigger",
+		trace.WithAttributes(attribute.Int64("schedule_id", scheduleID)))
+	defer span.End()
+	locked, err := s.locker.Lock(ctx, lib.BuildScheduleLockKey(scheduleID), "trigger_schedule", lockExpiration)
+	if err != nil {
+		return errors.WithMessage(err, "failed to lock schedule")
+	}
+	if !locked {
+		// Another replica is triggering it.
+		return nil
+	}
+	defer func() {
+		_, _ = s.locker.Unlock(ctx, lib.BuildScheduleLockKey(scheduleID))
+	}()
+	// The schedule may be fired or updated since it's listed.
+	schedule, err := s.db.GetSchedule(ctx, scheduleID)
+	if err != nil {
+		return errors.WithMessage(err, "failed to get schedule")
+	}
+	if !schedule.Enabled || schedule.NextRunAt.After(now) {
+		return nil
+	}
+	spec, location, err := parse(schedule.Cron, schedule.Timezone)
+	if err != nil {
+		return err
+	}
+	next, err := nextRun(spec, location, now)
+	if err != nil {
+		return err
+	}
+	runs := dueRuns(spec, location, schedule.NextRunAt, now, schedule.CatchUpPolicy)
+	// The next run is advanced before runs are fired, a failed run is not retried rather than fired twice.
+	advanced, err := s.db.AdvanceSchedule(ctx, scheduleID, now, next)
+	if err != nil {
+		return errors.WithMessage(err, "failed to advance schedule")
+	}
+	if !advanced {
+		return nil
+	}
+	dsl, err := db.UnmarshalSchedulePipeline(schedule.Pipeline)
+	if err != nil {
+		return errors.WithMessage(err, "failed to unmarshal pipeline")
+	}
+	if len(runs) == 0 {
+		logger.Infof("missed runs of schedule '%d' since %s are skipped", scheduleID, schedule.NextRunAt)
+	}
+	for _, runAt := range runs {
+		pipelineID, err := s.fire(ctx, dsl)
+		if err != nil {
+			logger.Errorf("failed to fire run of schedule '%d' at %s: %+v", scheduleID, runAt, err)
+			continue
+		}
+		logger.Infof("pipeline '%d' is created by run of schedule '%d' at %s", pipelineID, scheduleID, runAt)
+		if err = s.db.RecordScheduleRun(ctx, scheduleID, runAt, pipelineID); err != nil {
+			logger.Errorf("failed to record run of schedule '%d' at %s: %+v", scheduleID, runAt, err)
+		}
+	}
+	return nil
+}
+
+// dueRuns returns runs to fire by the catch-up policy. first is the earliest run not fired, runs not after now
+// are due, and runs before the latest due one are missed.
+func dueRuns(spec cron.Schedule, location *time.Location, first time.Time, now time.Time,
+	policy api.CatchUpPolicy,
+) []time.Time {
+	runs := []time.Time{first}
+	for {
+		next := spec.Next(runs[len(runs)-1].In(location))
+		if next.IsZero() || next.After(now) {
+			break
+		}
+		runs = append(runs, next.UTC())
+		if len(runs) > maxCatchUpRuns {
+			runs = runs[1:]
+		}
+	}
+	latest := runs[len(runs)-1:]
+	switch policy {
+	case api.CatchUpPolicyAll:
+		return runs
+	case api.CatchUpPolicyLatest:
+		return latest
+	default:
+		if now.Sub(latest[0]) > lateTolerance {
+			return nil
+		}
+		return latest
+	}
+}
+
+// fire creates a pipeline from the DSL of a schedule and dispatches its jobs. It returns the id of the pipeline.
+func (s *Service) fire(ctx context.Context, dsl *api.PipelineDSL) (int64, error) {
+	// Parameters are rendered in place, the DSL is shared by runs.
+	pipeline := proto.Clone(dsl).(*api.PipelineDSL)
+	if err := api.InterpolateParameters(pipeline); err != nil {
+		return 0, errors.WithMessage(err, "failed to interpolate parameters")
+	}
+	response, err := s.pipelineService.CreatePipeline(ctx, pipeline)
+	if err != nil {
+		return 0, errors.WithMessage(err, "failed to create pipeline")
+	}
+	if err = s.dispatchService.Dispatch(ctx, response.CreatedPipeline.ID); err != nil {
+		log.ExtractLogger(ctx).Warnf("failed to dispatch jobs of pipeline '%d': %+v", response.CreatedPipeline.ID, err)
+	}
+	return response.CreatedPipeline.ID, nil
+}
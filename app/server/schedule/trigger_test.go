@@ -0,0 +1,117 @@
+package schedule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andeya/goutil/calendar/cron"
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/lib"
+	"github.com/samber/lo"
+	"gotest.tools/v3/assert"
+)
+
+func TestService_Trigger(t *testing.T) {
+	ctx := context.Background()
+	service, dbClient := newService(t)
+	created, err := service.Create(ctx, &db.CreateScheduleOption{
+		Name:     "nightly",
+		Cron:     "0 2 * * *",
+		Timezone: "Asia/Shanghai",
+		Pipeline: getDSL(),
+		Enabled:  true,
+	})
+	assert.NilError(t, err)
+	// 2024-01-01 02:00 in Shanghai.
+	runAt := time.Date(2023, 12, 31, 18, 0, 0, 0, time.UTC)
+	_, err = dbClient.UpdateSchedule(ctx, &db.UpdateScheduleOption{ID: created.ID, NextRunAt: &runAt})
+	assert.NilError(t, err)
+	countPipelines := func() int {
+		pipelines, err := dbClient.ListPipelines(ctx, &db.ListPipelinesOption{})
+		assert.NilError(t, err)
+		return len(pipelines)
+	}
+	t.Run("not_due", func(t *testing.T) {
+		assert.NilError(t, service.Trigger(ctx, runAt.Add(-time.Second)))
+		assert.Equal(t, countPipelines(), 0)
+	})
+	t.Run("locked", func(t *testing.T) {
+		locked, err := service.locker.Lock(ctx, lib.BuildScheduleLockKey(created.ID), "other", time.Minute)
+		assert.NilError(t, err)
+		assert.Assert(t, locked)
+		assert.NilError(t, service.Trigger(ctx, runAt.Add(time.Minute)))
+		assert.Equal(t, countPipelines(), 0)
+		_, err = service.locker.Unlock(ctx, lib.BuildScheduleLockKey(created.ID))
+		assert.NilError(t, err)
+	})
+	assert.NilError(t, service.Trigger(ctx, runAt.Add(time.Minute)))
+	assert.Equal(t, countPipelines(), 1)
+	schedule, err := dbClient.GetSchedule(ctx, created.ID)
+	assert.NilError(t, err)
+	assert.Equal(t, schedule.NextRunAt.UTC(), runAt.Add(24*time.Hour))
+	assert.Equal(t, schedule.LastRunAt.UTC(), runAt)
+	jobs, err := dbClient.GetJobsByPipelineID(ctx, schedule.LastPipelineID)
+	assert.NilError(t, err)
+	assert.Equal(t, len(jobs), 1)
+	steps, err := dbClient.GetStepsByJobID(ctx, jobs[0].ID)
+	assert.NilError(t, err)
+	assert.Equal(t, string(steps[0].Commands), `["git checkout main"]`)
+	t.Run("fired", func(t *testing.T) {
+		// Another replica triggers with the stale list of due schedules.
+		assert.NilError(t, service.trigger(ctx, created.ID, runAt.Add(time.Minute)))
+		assert.Equal(t, countPipelines(), 1)
+	})
+	t.Run("disabled", func(t *testing.T) {
+		_, err = dbClient.UpdateSchedule(ctx, &db.UpdateScheduleOption{ID: created.ID, Enabled: lo.ToPtr(false)})
+		assert.NilError(t, err)
+		assert.NilError(t, service.Trigger(ctx, runAt.Add(48*time.Hour)))
+		assert.Equal(t, countPipelines(), 1)
+	})
+	t.Run("catch_up_all", func(t *testing.T) {
+		_, err = dbClient.UpdateSchedule(ctx, &db.UpdateScheduleOption{
+			ID:            created.ID,
+			Enabled:       lo.ToPtr(true),
+			CatchUpPolicy: lo.ToPtr(api.CatchUpPolicyAll),
+		})
+		assert.NilError(t, err)
+		assert.NilError(t, service.Trigger(ctx, runAt.Add(72*time.Hour+time.Hour)))
+		assert.Equal(t, countPipelines(), 4)
+		schedule, err := dbClient.GetSchedule(ctx, created.ID)
+		assert.NilError(t, err)
+		assert.Equal(t, schedule.NextRunAt.UTC(), runAt.Add(96*time.Hour))
+		assert.Equal(t, schedule.LastRunAt.UTC(), runAt.Add(72*time.Hour))
+	})
+}
+
+func TestDueRuns(t *testing.T) {
+	spec, err := cron.ParseStandard("0 * * * *")
+	assert.NilError(t, err)
+	first := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	hours := func(hours ...int) []time.Time {
+		return lo.Map(hours, func(h int, _ int) time.Time {
+			return first.Add(time.Duration(h) * time.Hour)
+		})
+	}
+	for _, c := range []struct {
+		name   string
+		now    time.Time
+		policy api.CatchUpPolicy
+		want   []time.Time
+	}{
+		{name: "on_time", now: first.Add(time.Minute), policy: api.CatchUpPolicySkip, want: hours(0)},
+		{name: "skip_late", now: first.Add(10 * time.Minute), policy: api.CatchUpPolicySkip, want: nil},
+		{name: "skip_missed", now: first.Add(2*time.Hour + time.Minute), policy: api.CatchUpPolicySkip, want: hours(2)},
+		{name: "latest", now: first.Add(2*time.Hour + 10*time.Minute), policy: api.CatchUpPolicyLatest, want: hours(2)},
+		{name: "all", now: first.Add(2*time.Hour + 10*time.Minute), policy: api.CatchUpPolicyAll, want: hours(0, 1, 2)},
+		{
+			name: "all_limited", now: first.Add(20 * time.Hour), policy: api.CatchUpPolicyAll,
+			want: hours(11, 12, 13, 14, 15, 16, 17, 18, 19, 20),
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			assert.DeepEqual(t, dueRuns(spec, time.UTC, first, c.now, c.policy), c.want)
+		})
+	}
+}
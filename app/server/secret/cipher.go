@@ -0,0 +1,50 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Cipher encrypts values of secrets with AES-GCM.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher creates a cipher with a 16, 24 or 32 bytes key, which selects AES-128, AES-192 or AES-256.
+func NewCipher(key []byte) (*Cipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.WithMessage(err, "invalid secret key")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to create gcm")
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// Encrypt encrypts plaintext with a random nonce, the nonce is prepended to the result.
+// additionalData is authenticated but not encrypted, the same one must be provided to decrypt.
+func (c *Cipher) Encrypt(plaintext []byte, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize(), c.aead.NonceSize()+len(plaintext)+c.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.WithMessage(err, "failed to generate nonce")
+	}
+	return c.aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// Decrypt decrypts data encrypted by Encrypt.
+func (c *Cipher) Decrypt(data []byte, additionalData []byte) ([]byte, error) {
+	if len(data) < c.aead.NonceSize() {
+		return nil, errors.New("encrypted data is too short")
+	}
+	nonce, ciphertext := data[:c.aead.NonceSize()], data[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to decrypt")
+	}
+	return plaintext, nil
+}
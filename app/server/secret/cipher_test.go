@@ -0,0 +1,28 @@
+package secret
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestCipher(t *testing.T) {
+	_, err := NewCipher([]byte("short"))
+	assert.ErrorContains(t, err, "invalid secret key")
+	c, err := NewCipher(bytes.Repeat([]byte("k"), 32))
+	assert.NilError(t, err)
+	encrypted, err := c.Encrypt([]byte("password"), []byte("a"))
+	assert.NilError(t, err)
+	assert.Assert(t, !bytes.Contains(encrypted, []byte("password")))
+	another, err := c.Encrypt([]byte("password"), []byte("a"))
+	assert.NilError(t, err)
+	assert.Assert(t, !bytes.Equal(encrypted, another), "nonce should be random")
+	decrypted, err := c.Decrypt(encrypted, []byte("a"))
+	assert.NilError(t, err)
+	assert.Equal(t, string(decrypted), "password")
+	_, err = c.Decrypt(encrypted, []byte("b"))
+	assert.ErrorContains(t, err, "failed to decrypt")
+	_, err = c.Decrypt([]byte("x"), []byte("a"))
+	assert.ErrorContains(t, err, "too short")
+}
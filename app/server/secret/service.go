@@ -0,0 +1,160 @@
+// Package secret manages secrets encrypted at rest and resolves them for jobs.
+package secret
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/db"
+	"github.com/cox96de/runner/util"
+	"github.com/samber/lo"
+)
+
+const (
+	// ErrExists is returned when a secret with the same name is created in the same scope.
+	ErrExists = util.StringError("secret already exists")
+	// ErrDisabled is returned when the secret key is not configured.
+	ErrDisabled = util.StringError("secrets are disabled, the secret key is not configured")
+)
+
+// Service manages secrets. Values are encrypted before they are stored.
+type Service struct {
+	db *db.Client
+	// cipher is nil if the secret key is not configured.
+	cipher *Cipher
+}
+
+func NewService(db *db.Client, cipher *Cipher) *Service {
+	return &Service{db: db, cipher: cipher}
+}
+
+// Create creates a secret in the scope of project and label.
+func (s *Service) Create(ctx context.Context, name string, project string, label string, value string,
+) (*db.Secret, error) {
+	if s.cipher == nil {
+		return nil, ErrDisabled
+	}
+	_, err := s.db.GetSecretByScope(ctx, name, project, label)
+	if err == nil {
+		return nil, ErrExists
+	}
+	if !db.IsRecordNotFoundError(err) {
+		return nil, errors.WithMessage(err, "failed to get secret")
+	}
+	encrypted, err := s.cipher.Encrypt([]byte(value), additionalData(name, project, label))
+	if err != nil {
+		return nil, err
+	}
+	secret, err := s.db.CreateSecret(ctx, &db.CreateSecretOption{
+		Name:    name,
+		Project: project,
+		Label:   label,
+		Value:   encrypted,
+	})
+	// The secret might be created concurrently after the check above.
+	if db.IsDuplicateKeyError(err) {
+		return nil, ErrExists
+	}
+	return secret, err
+}
+
+// Update replaces the value of the secret.
+func (s *Service) Update(ctx context.Context, id int64, value string) (*db.Secret, error) {
+	if s.cipher == nil {
+		return nil, ErrDisabled
+	}
+	secret, err := s.db.GetSecret(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := s.cipher.Encrypt([]byte(value), additionalData(secret.Name, secret.Project, secret.Label))
+	if err != nil {
+		return nil, err
+	}
+	if err = s.db.UpdateSecretValue(ctx, id, encrypted); err != nil {
+		return nil, err
+	}
+	return s.db.GetSecret(ctx, id)
+}
+
+// Resolve returns values of secrets referred by a job of the project running on an agent with labels.
+// references are names of secrets keyed by environment variables, the result is keyed by environment variables too.
+// The most specific secret is used if several ones with the same name are available, a secret scoped to the
+// project is more specific than the one scoped to the label.
+func (s *Service) Resolve(ctx context.Context, project string, labels []string, references map[string]string,
+) (map[string]string, error) {
+	if len(references) == 0 {
+		return nil, nil
+	}
+	if s.cipher == nil {
+		return nil, ErrDisabled
+	}
+	secrets, err := s.db.GetSecretsByNames(ctx, lo.Uniq(lo.Values(references)))
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get secrets")
+	}
+	available := make(map[string]*db.Secret)
+	for _, secret := range secrets {
+		if (secret.Project != "" && secret.Project != project) ||
+			(secret.Label != "" && !lo.Contains(labels, secret.Label)) {
+			continue
+		}
+		// Secrets are sorted by id, the earlier one wins if they are equally specific.
+		if current, ok := available[secret.Name]; !ok || specificity(secret) > specificity(current) {
+			available[secret.Name] = secret
+		}
+	}
+	values := make(map[string]string, len(references))
+	for env, name := range references {
+		secret, ok := available[name]
+		if !ok {
+			return nil, errors.Errorf("secret '%s' is not found", name)
+		}
+		value, err := s.cipher.Decrypt(secret.Value, additionalData(secret.Name, secret.Project, secret.Label))
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to decrypt secret '%s'", name)
+		}
+		values[env] = string(value)
+	}
+	return values, nil
+}
+
+// Values returns values of all secrets with the names which are available to jobs of the project, no matter which
+// labels they're scoped to. It's used to mask secrets, so it never fails if secrets are disabled.
+func (s *Service) Values(ctx context.Context, project string, names []string) ([]string, error) {
+	if len(names) == 0 || s.cipher == nil {
+		return nil, nil
+	}
+	secrets, err := s.db.GetSecretsByNames(ctx, lo.Uniq(names))
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get secrets")
+	}
+	values := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		if secret.Project != "" && secret.Project != project {
+			continue
+		}
+		value, err := s.cipher.Decrypt(secret.Value, additionalData(secret.Name, secret.Project, secret.Label))
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to decrypt secret '%s'", secret.Name)
+		}
+		values = append(values, string(value))
+	}
+	return values, nil
+}
+
+func specificity(secret *db.Secret) int {
+	score := 0
+	if secret.Project != "" {
+		score += 2
+	}
+	if secret.Label != "" {
+		score++
+	}
+	return score
+}
+
+// additionalData binds an encrypted value to the scope of the secret, so it can't be decrypted as another secret.
+func additionalData(name string, project string, label string) []byte {
+	return []byte(name + "\x00" + project + "\x00" + label)
+}
@@ -0,0 +1,109 @@
+package secret
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/mock"
+	"gotest.tools/v3/assert"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	dbClient := mock.NewMockDB(t)
+	c, err := NewCipher(bytes.Repeat([]byte("k"), 32))
+	assert.NilError(t, err)
+	service := NewService(dbClient, c)
+	for _, s := range []struct{ name, project, label, value string }{
+		{"token", "", "", "global"},
+		{"token", "", "linux", "linux"},
+		{"token", "runner", "", "runner"},
+		{"password", "", "", "password"},
+	} {
+		_, err = service.Create(ctx, s.name, s.project, s.label, s.value)
+		assert.NilError(t, err)
+	}
+	t.Run("exists", func(t *testing.T) {
+		_, err = service.Create(ctx, "token", "", "linux", "again")
+		assert.ErrorIs(t, err, ErrExists)
+	})
+	t.Run("concurrent", func(t *testing.T) {
+		var (
+			wg      sync.WaitGroup
+			created atomic.Int32
+		)
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := service.Create(ctx, "concurrent", "runner", "", "value")
+				if err == nil {
+					created.Add(1)
+					return
+				}
+				assert.Check(t, errors.Is(err, ErrExists))
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, created.Load(), int32(1))
+	})
+	t.Run("encrypted", func(t *testing.T) {
+		secret, err := dbClient.GetSecretByScope(ctx, "password", "", "")
+		assert.NilError(t, err)
+		assert.Assert(t, !bytes.Contains(secret.Value, []byte("password")))
+	})
+	t.Run("resolve", func(t *testing.T) {
+		for _, c := range []struct {
+			project string
+			labels  []string
+			want    string
+		}{
+			{project: "", labels: nil, want: "global"},
+			{project: "other", labels: []string{"linux"}, want: "linux"},
+			{project: "runner", labels: []string{"linux"}, want: "runner"},
+			{project: "runner", labels: []string{"windows"}, want: "runner"},
+		} {
+			values, err := service.Resolve(ctx, c.project, c.labels, map[string]string{
+				"TOKEN": "token", "PASSWORD": "password",
+			})
+			assert.NilError(t, err)
+			assert.DeepEqual(t, values, map[string]string{"TOKEN": c.want, "PASSWORD": "password"})
+		}
+		_, err := service.Resolve(ctx, "", nil, map[string]string{"KEY": "not_found"})
+		assert.ErrorContains(t, err, "secret 'not_found' is not found")
+	})
+	t.Run("values", func(t *testing.T) {
+		values, err := service.Values(ctx, "other", []string{"token", "not_found"})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, values, []string{"global", "linux"})
+		values, err = service.Values(ctx, "runner", []string{"token"})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, values, []string{"global", "linux", "runner"})
+	})
+	t.Run("update", func(t *testing.T) {
+		secret, err := dbClient.GetSecretByScope(ctx, "password", "", "")
+		assert.NilError(t, err)
+		_, err = service.Update(ctx, secret.ID, "updated")
+		assert.NilError(t, err)
+		values, err := service.Resolve(ctx, "", nil, map[string]string{"PASSWORD": "password"})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, values, map[string]string{"PASSWORD": "updated"})
+	})
+	t.Run("disabled", func(t *testing.T) {
+		disabled := NewService(dbClient, nil)
+		_, err := disabled.Create(ctx, "key", "", "", "value")
+		assert.ErrorIs(t, err, ErrDisabled)
+		_, err = disabled.Resolve(ctx, "", nil, map[string]string{"KEY": "password"})
+		assert.ErrorIs(t, err, ErrDisabled)
+		values, err := disabled.Resolve(ctx, "", nil, nil)
+		assert.NilError(t, err)
+		assert.Assert(t, values == nil)
+		masked, err := disabled.Values(ctx, "", []string{"password"})
+		assert.NilError(t, err)
+		assert.Assert(t, masked == nil)
+	})
+}
@@ -1,10 +1,15 @@
 package main
 
 type Config struct {
+	// Name identifies the agent in the server, it defaults to the hostname.
+	Name        string `mapstructure:"name" yaml:"name"`
 	ServerURL   string `mapstructure:"server_url" yaml:"server_url"`
 	Concurrency int    `mapstructure:"concurrency" yaml:"concurrency"`
-	Label       string `mapstructure:"label" yaml:"label"`
-	Engine      Engine `mapstructure:"engine" yaml:"engine"`
+	// Label is labels of the agent separated by commas, such as `linux,amd64,gpu=false`.
+	Label string `mapstructure:"label" yaml:"label"`
+	// KillGracePeriod is the seconds between SIGTERM and SIGKILL when a running step is stopped.
+	KillGracePeriod int    `mapstructure:"kill_grace_period" yaml:"kill_grace_period"`
+	Engine          Engine `mapstructure:"engine" yaml:"engine"`
 }
 
 type Engine struct {
@@ -4,10 +4,12 @@ import (
 	"context"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/cox96de/runner/util"
+	"github.com/samber/lo"
 	"github.com/spf13/viper"
 
 	"github.com/cockroachdb/errors"
@@ -16,6 +18,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// version is set by `-ldflags "-X main.version=..."` on building.
+var version = "dev"
+
 func main() {
 	command := GetAgentCommand()
 	err := command.Execute()
@@ -47,6 +52,12 @@ func GetAgentCommand() *cobra.Command {
 	}
 	flags := c.Flags()
 	flags.StringVarP(&configFilePath, "config", "c", "", "config file path")
+	checkError(util.BindStringArg(flags, vv, &util.StringArg{
+		ArgKey:    "name",
+		FlagName:  "name",
+		FlagUsage: "the name identifies the agent in the server, defaults to the hostname",
+		Env:       "RUNNER_NAME",
+	}))
 	checkError(util.BindStringArg(flags, vv, &util.StringArg{
 		ArgKey:    "server_url",
 		FlagName:  "server_url",
@@ -63,9 +74,16 @@ func GetAgentCommand() *cobra.Command {
 	checkError(util.BindStringArg(flags, vv, &util.StringArg{
 		ArgKey:    "label",
 		FlagName:  "label",
-		FlagUsage: "the label of agent",
+		FlagUsage: "the labels of agent separated by commas, such as linux,amd64,gpu=false",
 		Env:       "RUNNER_LABEL",
 	}))
+	checkError(util.BindIntArg(flags, vv, &util.IntArg{
+		ArgKey:    "kill_grace_period",
+		FlagName:  "kill_grace_period",
+		FlagValue: 10,
+		FlagUsage: "the seconds between SIGTERM and SIGKILL when a running step is canceled or timed out",
+		Env:       "RUNNER_KILL_GRACE_PERIOD",
+	}))
 	checkError(util.BindStringArg(flags, vv, &util.StringArg{
 		ArgKey:    "engine.name",
 		FlagName:  "engine",
@@ -140,8 +158,20 @@ func RunAgent(config *Config) error {
 	if err != nil {
 		return errors.WithMessage(err, "failed to create server client")
 	}
-	agent := agent.NewAgent(engine, serverClient, config.Label)
-	log.Infof("agent is running on '%s' with label: %s", config.ServerURL, config.Label)
+	hostname, err := os.Hostname()
+	if err != nil {
+		return errors.WithMessage(err, "failed to get hostname")
+	}
+	name := config.Name
+	if name == "" {
+		name = hostname
+	}
+	labels := lo.Compact(lo.Map(strings.Split(config.Label, ","), func(label string, _ int) string {
+		return strings.TrimSpace(label)
+	}))
+	agent := agent.NewAgent(engine, serverClient, labels, time.Duration(config.KillGracePeriod)*time.Second,
+		&agent.Info{Name: name, Hostname: hostname, Version: version, Engine: config.Engine.Name})
+	log.Infof("agent is running on '%s' with labels: %v", config.ServerURL, labels)
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 	ctx, forceCancel := context.WithCancel(context.Background())
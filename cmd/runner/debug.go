@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+type debugOption struct {
+	Container string
+	User      string
+	TTY       bool
+}
+
+func getDebugCommand(composeClient func() api.ServerClient) *cobra.Command {
+	opt := &debugOption{}
+	c := &cobra.Command{
+		Use:   "debug <job_execution_id> [-- command...]",
+		Short: "Run a command interactively in the runner of a running or failed job execution",
+		Long: "Run a command interactively in the runner of a running or failed job execution. The default shell of " +
+			"the runner is started if no command is provided.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			jobExecutionID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return errors.Errorf("invalid job execution id '%s'", args[0])
+			}
+			exitCode, err := runDebug(cmd.Context(), composeClient(), jobExecutionID, args[1:], opt)
+			if err != nil {
+				return err
+			}
+			if exitCode != 0 {
+				os.Exit(exitCode)
+			}
+			return nil
+		},
+	}
+	flags := c.Flags()
+	flags.StringVarP(&opt.Container, "container", "c", "", "the container to run the command in, "+
+		"defaults to the default container of the job")
+	flags.StringVarP(&opt.User, "user", "u", "", "the user to run the command as")
+	flags.BoolVarP(&opt.TTY, "tty", "t", term.IsTerminal(int(os.Stdin.Fd())),
+		"allocate a pseudo terminal, defaults to true if stdin is a terminal")
+	return c
+}
+
+// runDebug runs the command in the runner of the job execution and returns its exit code.
+func runDebug(ctx context.Context, client api.ServerClient, jobExecutionID int64, commands []string,
+	opt *debugOption,
+) (int, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := client.Debug(ctx)
+	if err != nil {
+		return 0, errors.WithMessage(err, "failed to open debug session")
+	}
+	start := &api.DebugStart{
+		Container: opt.Container,
+		Commands:  commands,
+		User:      opt.User,
+		TTY:       opt.TTY,
+	}
+	if opt.TTY {
+		start.Size = getTerminalSize()
+		state, err := term.MakeRaw(int(os.Stdin.Fd()))
+		if err != nil {
+			return 0, errors.WithMessage(err, "failed to set terminal to raw mode")
+		}
+		defer func() {
+			_ = term.Restore(int(os.Stdin.Fd()), state)
+		}()
+		watchTerminalSize(ctx, func(size *api.TerminalSize) {
+			_ = stream.Send(&api.DebugRequest{Resize: size})
+		})
+	}
+	if err = stream.Send(&api.DebugRequest{JobExecutionID: jobExecutionID, Start: start}); err != nil {
+		return 0, errors.WithMessage(err, "failed to start debug session")
+	}
+	go func() {
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := os.Stdin.Read(buf)
+			if n > 0 {
+				if sendErr := stream.Send(&api.DebugRequest{Stdin: buf[:n]}); sendErr != nil {
+					return
+				}
+			}
+			if err != nil {
+				_ = stream.Send(&api.DebugRequest{CloseStdin: true})
+				return
+			}
+		}
+	}()
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return 0, errors.New("debug session is ended without exit status")
+			}
+			return 0, errors.WithMessage(err, "failed to receive from debug session")
+		}
+		if response.Stderr {
+			_, _ = os.Stderr.Write(response.Output)
+		} else {
+			_, _ = os.Stdout.Write(response.Output)
+		}
+		if response.Exited {
+			if response.Error != "" {
+				_, _ = fmt.Fprintf(os.Stderr, "\r\ndebug session is failed: %s\r\n", response.Error)
+			}
+			return int(response.ExitCode), nil
+		}
+	}
+}
+
+// getTerminalSize returns the size of the terminal of stdout, it returns nil if stdout is not a terminal.
+func getTerminalSize() *api.TerminalSize {
+	cols, rows, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		return nil
+	}
+	return &api.TerminalSize{Rows: uint32(rows), Cols: uint32(cols)}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/cox96de/runner/api"
+	"github.com/cox96de/runner/api/httpserverclient"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	command := GetRunnerCommand()
+	if err := command.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+// GetRunnerCommand returns the root command of the runner cli.
+func GetRunnerCommand() *cobra.Command {
+	var serverURL string
+	c := &cobra.Command{
+		Use:          "runner",
+		SilenceUsage: true,
+	}
+	c.PersistentFlags().StringVarP(&serverURL, "server", "s", os.Getenv("RUNNER_SERVER_URL"),
+		"the server url, such as http://127.0.0.1:8080, defaults to the env RUNNER_SERVER_URL")
+	c.AddCommand(getDebugCommand(func() api.ServerClient {
+		client, err := httpserverclient.NewClient(&http.Client{}, serverURL)
+		if err != nil {
+			log.Fatalf("failed to create server client: %v", err)
+		}
+		return client
+	}))
+	return c
+}
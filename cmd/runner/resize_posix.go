@@ -0,0 +1,31 @@
+//go:build !windows
+
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/cox96de/runner/api"
+)
+
+// watchTerminalSize calls resize with the new size when the terminal is resized until ctx is done.
+func watchTerminalSize(ctx context.Context, resize func(size *api.TerminalSize)) {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGWINCH)
+	go func() {
+		defer signal.Stop(sig)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sig:
+				if size := getTerminalSize(); size != nil {
+					resize(size)
+				}
+			}
+		}
+	}()
+}
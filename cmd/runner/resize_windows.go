@@ -0,0 +1,11 @@
+package main
+
+import (
+	"context"
+
+	"github.com/cox96de/runner/api"
+)
+
+func watchTerminalSize(_ context.Context, _ func(size *api.TerminalSize)) {
+	// There is no SIGWINCH on Windows, the terminal size is only sent on start.
+}
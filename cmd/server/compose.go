@@ -1,13 +1,20 @@
 package main
 
 import (
+	"encoding/base64"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awss3 "github.com/aws/aws-sdk-go/service/s3"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/cockroachdb/errors"
 	"github.com/cox96de/runner/app/server"
 	"github.com/cox96de/runner/app/server/eventhook"
 	"github.com/cox96de/runner/app/server/logstorage"
+	"github.com/cox96de/runner/app/server/secret"
 	"github.com/cox96de/runner/composer"
 	"github.com/cox96de/runner/external/redis"
+	"github.com/cox96de/runner/external/s3"
+	"github.com/cox96de/runner/log"
 	"github.com/redis/go-redis/extra/redisotel/v9"
 	goredis "github.com/redis/go-redis/v9"
 	"github.com/uptrace/opentelemetry-go-extra/otelgorm"
@@ -38,6 +45,35 @@ func ComposeLocker(l *Locker) (server.Locker, error) {
 	}
 }
 
+func ComposeNotifier(n *Notify) (server.Notifier, error) {
+	switch n.Backend {
+	case "", "local":
+		return server.NewLocalNotifier(), nil
+	case "redis":
+		r, err := ComposeRedis(n.Redis)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed to compose redis")
+		}
+		return server.NewRedisNotifier(r, n.Channel), nil
+	default:
+		return nil, errors.Errorf("%s notifier is not supported", n.Backend)
+	}
+}
+
+// ComposeSecretCipher creates the cipher of secrets with the base64 encoded key. It returns nil if the key is not
+// set, which disables secrets.
+func ComposeSecretCipher(s *Secret) (*secret.Cipher, error) {
+	if s == nil || s.Key == "" {
+		log.Warningf("secret key is not set, secrets are disabled")
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(s.Key)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to decode secret key")
+	}
+	return secret.NewCipher(key)
+}
+
 func ComposeLogPersistentStorage(l *LogArchive) (server.LogPersistentStorage, error) {
 	var oss server.LogPersistentStorage
 	switch l.Backend {
@@ -49,6 +85,21 @@ func ComposeLogPersistentStorage(l *LogArchive) (server.LogPersistentStorage, er
 	return oss, nil
 }
 
+func ComposeCacheStorage(c *Cache) (server.CacheStorage, error) {
+	switch c.Backend {
+	case "fs":
+		return server.NewLocalCacheStorage(c.BaseDir), nil
+	case "s3":
+		sess, err := session.NewSession()
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed to create aws session")
+		}
+		return server.NewS3CacheStorage(c.Bucket, s3.NewClient(awss3.New(sess))), nil
+	default:
+		return nil, errors.Errorf("%s cache storage is not supported", c.Backend)
+	}
+}
+
 func ComposeRedis(r *composer.Redis) (*goredis.Client, error) {
 	if r.Addr == "internal" {
 		return ComposeInternalRedis()
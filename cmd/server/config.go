@@ -11,6 +11,9 @@ type Config struct {
 	Locker     *Locker     `mapstructure:"locker" yaml:"locker"`
 	LogStorage *LogStorage `mapstructure:"log_storage" yaml:"log_storage"`
 	Event      *Event      `mapstructure:"event" yaml:"event"`
+	Cache      *Cache      `mapstructure:"cache" yaml:"cache"`
+	Notify     *Notify     `mapstructure:"notify" yaml:"notify"`
+	Secret     *Secret     `mapstructure:"secret" yaml:"secret"`
 }
 
 type HTTP struct {
@@ -37,6 +40,34 @@ type LogArchive struct {
 	BaseDir string `mapstructure:"base_dir" yaml:"base_dir"`
 }
 
+// Cache is the config of dependency caches of jobs.
+type Cache struct {
+	// Backend is the storage of cache archives, support fs and s3.
+	Backend string `mapstructure:"backend" yaml:"backend"`
+	// BaseDir is the root dir of cache archives for fs backend.
+	BaseDir string `mapstructure:"base_dir" yaml:"base_dir"`
+	// Bucket is the bucket of cache archives for s3 backend. Credentials and region are loaded from environment.
+	Bucket string `mapstructure:"bucket" yaml:"bucket"`
+	// MaxSize is the default max total size of caches of a label in bytes. Zero means no limit.
+	MaxSize int64 `mapstructure:"max_size" yaml:"max_size"`
+}
+
+// Notify is the config of notifications of queued jobs, which wake up agents waiting for jobs.
+type Notify struct {
+	// Backend is the notifier backend, support local and redis. Use redis if there are several replicas of the server.
+	Backend string          `mapstructure:"backend" yaml:"backend"`
+	Redis   *composer.Redis `mapstructure:"redis" yaml:"redis"`
+	// Channel is the redis channel to publish notifications to.
+	Channel string `mapstructure:"channel" yaml:"channel"`
+}
+
+// Secret is the config of secrets injected into jobs.
+type Secret struct {
+	// Key is the base64 encoded AES key encrypting secrets, it must be 16, 24 or 32 bytes. Secrets are disabled if
+	// it's empty.
+	Key string `mapstructure:"key" yaml:"key"`
+}
+
 type Event struct {
 	HTTPEndPoint string `mapstructure:"http_endpoint" yaml:"http_endpoint"`
 }
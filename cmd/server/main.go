@@ -127,6 +127,62 @@ func GetServerCommand() *cobra.Command {
 		FlagUsage: "the base dir of log archive. For fs backend, it is the root dir of log storage",
 		Env:       "RUNNER_LOG_STORAGE_ARCHIVE_BASE_DIR",
 	}))
+	checkError(util.BindStringArg(flags, vv, &util.StringArg{
+		ArgKey:    "cache.backend",
+		FlagName:  "cache.backend",
+		FlagValue: "fs",
+		FlagUsage: "the backend of cache storage, support fs and s3",
+		Env:       "RUNNER_CACHE_BACKEND",
+	}))
+	checkError(util.BindStringArg(flags, vv, &util.StringArg{
+		ArgKey:    "cache.base_dir",
+		FlagName:  "cache.base_dir",
+		FlagValue: "/data/caches",
+		FlagUsage: "the root dir of cache storage for fs backend",
+		Env:       "RUNNER_CACHE_BASE_DIR",
+	}))
+	checkError(util.BindStringArg(flags, vv, &util.StringArg{
+		ArgKey:    "cache.bucket",
+		FlagName:  "cache.bucket",
+		FlagValue: "",
+		FlagUsage: "the bucket of cache storage for s3 backend",
+		Env:       "RUNNER_CACHE_BUCKET",
+	}))
+	checkError(util.BindIntArg(flags, vv, &util.IntArg{
+		ArgKey:    "cache.max_size",
+		FlagName:  "cache.max_size",
+		FlagValue: 0,
+		FlagUsage: "the default max total size of caches of a label in bytes, 0 means no limit",
+		Env:       "RUNNER_CACHE_MAX_SIZE",
+	}))
+	checkError(util.BindStringArg(flags, vv, &util.StringArg{
+		ArgKey:    "notify.backend",
+		FlagName:  "notify.backend",
+		FlagValue: "local",
+		FlagUsage: "the backend of queued job notifications, support local and redis",
+		Env:       "RUNNER_NOTIFY_BACKEND",
+	}))
+	checkError(util.BindStringArg(flags, vv, &util.StringArg{
+		ArgKey:    "notify.redis.addr",
+		FlagName:  "notify.redis.addr",
+		FlagValue: "internal",
+		FlagUsage: "the redis address for redis notify backend",
+		Env:       "RUNNER_NOTIFY_REDIS_ADDR",
+	}))
+	checkError(util.BindStringArg(flags, vv, &util.StringArg{
+		ArgKey:    "notify.channel",
+		FlagName:  "notify.channel",
+		FlagValue: "runner:job_queued",
+		FlagUsage: "the redis channel for redis notify backend",
+		Env:       "RUNNER_NOTIFY_CHANNEL",
+	}))
+	checkError(util.BindStringArg(flags, vv, &util.StringArg{
+		ArgKey:    "secret.key",
+		FlagName:  "secret.key",
+		FlagValue: "",
+		FlagUsage: "the base64 encoded AES key encrypting secrets, secrets are disabled if it's empty",
+		Env:       "RUNNER_SECRET_KEY",
+	}))
 	checkError(util.BindStringArg(flags, vv, &util.StringArg{
 		ArgKey:    "event.http_endpoint",
 		FlagName:  "event.http_endpoint",
@@ -160,6 +216,18 @@ func RunServer(config *Config) error {
 	if err != nil {
 		return errors.WithMessage(err, "failed to compose cache storage")
 	}
+	jobCacheStorage, err := ComposeCacheStorage(config.Cache)
+	if err != nil {
+		return errors.WithMessage(err, "failed to compose cache storage")
+	}
+	notifier, err := ComposeNotifier(config.Notify)
+	if err != nil {
+		return errors.WithMessage(err, "failed to compose notifier")
+	}
+	secretCipher, err := ComposeSecretCipher(config.Secret)
+	if err != nil {
+		return errors.WithMessage(err, "failed to compose secret cipher")
+	}
 	cloudEventsClient, err := ComposeCloudEventsClient(config.Event)
 	if err != nil {
 		return errors.WithMessage(err, "failed to compose cloud events client")
@@ -170,6 +238,10 @@ func RunServer(config *Config) error {
 		LogCacheStorage:      cacheStorage,
 		Locker:               locker,
 		EventHookSender:      cloudEventsClient,
+		CacheStorage:         jobCacheStorage,
+		CacheMaxSize:         config.Cache.MaxSize,
+		Notifier:             notifier,
+		SecretCipher:         secretCipher,
 	})
 	errgroup := errgroup.Group{}
 	if config.HTTP.Port > 0 {
@@ -207,5 +279,13 @@ func startConJob(service *server.App) {
 		}
 	})
 	checkError(err)
+	err = c.AddFunc("@every 1m", func() {
+		ctx, span := trace.Start(context.Background(), "cronjob.trigger_schedules")
+		defer span.End()
+		if err := service.Scheduler.Trigger(ctx, time.Now()); err != nil {
+			log.Errorf("failed to trigger schedules: %+v", err)
+		}
+	})
+	checkError(err)
 	c.Start()
 }
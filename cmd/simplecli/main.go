@@ -2,10 +2,13 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
@@ -16,6 +19,7 @@ import (
 	"github.com/cox96de/runner/log"
 	"github.com/samber/lo"
 	"github.com/spf13/pflag"
+	"golang.org/x/sync/errgroup"
 	"gopkg.in/yaml.v3"
 )
 
@@ -105,59 +109,59 @@ wait:
 			}
 		}
 	}
-	// Fetch logs.
+	// Stream logs of all steps until the job execution is completed.
+	var printLock sync.Mutex
+	g, gCtx := errgroup.WithContext(ctx)
 	for _, step := range job.Steps {
-		err := watchStep(ctx, client, jobExecution, step, stop)
-		if err != nil {
-			return err
-		}
+		g.Go(func() error {
+			return watchStep(gCtx, client, jobExecution, step, &printLock)
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	getJobExecutionResponse, err := client.GetJobExecution(ctx, &api.GetJobExecutionRequest{
+		JobExecutionID:    jobExecution.ID,
+		WithStepExecution: lo.ToPtr(true),
+	})
+	if err != nil {
+		return err
+	}
+	stepNames := lo.SliceToMap(job.Steps, func(step *api.Step) (int64, string) {
+		return step.ID, step.Name
+	})
+	for _, stepExecution := range getJobExecutionResponse.JobExecution.Steps {
+		color.Green("########### step '%s' execution '%d' exit with status: %s, exit code: %d ###########\n",
+			stepNames[stepExecution.StepID], stepExecution.ID, stepExecution.Status, stepExecution.ExitCode)
 	}
+	color.Green("########### job status transmit to %s ###########\n", getJobExecutionResponse.JobExecution.Status)
 	return nil
 }
 
-func watchStep(ctx context.Context, client api.ServerClient, jobExecution *api.JobExecution, step *api.Step, stop chan struct{}) error {
-	offset := int64(0)
+// watchStep prints logs of the step until the job execution is completed.
+func watchStep(ctx context.Context, client api.ServerClient, jobExecution *api.JobExecution, step *api.Step,
+	printLock sync.Locker,
+) error {
+	stream, err := client.StreamLogLines(ctx, &api.StreamLogLinesRequest{
+		JobExecutionID: jobExecution.ID,
+		Name:           step.Name,
+	})
+	if err != nil {
+		return err
+	}
 	for {
-		select {
-		case <-stop:
-			log.Infof("cancel job execution")
-			_, err := client.CancelJobExecution(context.Background(),
-				&api.CancelJobExecutionRequest{JobExecutionID: jobExecution.ID})
-			if err != nil {
-				log.Errorf("failed to cancel job execution: %v", err)
-			}
-			return nil
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(time.Second):
-			getLogLinesResponse, err := client.GetLogLines(ctx, &api.GetLogLinesRequest{
-				JobExecutionID: jobExecution.ID,
-				Name:           step.Name,
-				Offset:         offset,
-				Limit:          lo.ToPtr(int64(100)),
-			})
-			if err != nil {
-				return err
-			}
-			for _, logLine := range getLogLinesResponse.Lines {
-				fmt.Printf("%s %s\n", greenColor(logLine.Number), logLine.Output)
-			}
-			if len(getLogLinesResponse.Lines) == 0 {
-				getStepExecutionResponse, err := client.GetStepExecution(context.Background(), &api.GetStepExecutionRequest{
-					StepExecutionID: step.Execution.ID,
-				})
-				if err != nil {
-					return err
-				}
-				stepExecution := getStepExecutionResponse.StepExecution
-				if stepExecution.Status.IsCompleted() {
-					color.Green("########### step '%s' execution '%d' exit with status: %s, exit code: %d ###########\n", step.Name, stepExecution.ID,
-						stepExecution.Status, stepExecution.ExitCode)
-					return nil
-				}
+		streamLogLinesResponse, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
 			}
-			offset += int64(len(getLogLinesResponse.Lines))
+			return err
+		}
+		printLock.Lock()
+		for _, logLine := range streamLogLinesResponse.Lines {
+			fmt.Printf("%s %s %s\n", greenColor(step.Name), greenColor(logLine.Number), logLine.Output)
 		}
+		printLock.Unlock()
 	}
 }
 
@@ -0,0 +1,151 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Agent struct {
+	ID int64 `gorm:"column:id;primaryKey;autoIncrement"`
+	// Name is indexed by `index:,unique` rather than `uniqueIndex`, so that it's a varchar in mysql.
+	Name        string `gorm:"column:name;index:,unique"`
+	Hostname    string `gorm:"column:hostname"`
+	Version     string `gorm:"column:version"`
+	Engine      string `gorm:"column:engine"`
+	Labels      []byte `gorm:"column:labels"`
+	Concurrency int32  `gorm:"column:concurrency"`
+	// RunningJobExecutionIDs is a json array of job execution ids reported by the last heartbeat.
+	RunningJobExecutionIDs []byte    `gorm:"column:running_job_execution_ids"`
+	LastHeartbeatAt        time.Time `gorm:"column:last_heartbeat_at"`
+	CreatedAt              time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt              time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (a *Agent) TableName() string {
+	return "agent"
+}
+
+type RegisterAgentOption struct {
+	Name        string
+	Hostname    string
+	Version     string
+	Engine      string
+	Labels      []string
+	Concurrency int32
+}
+
+// RegisterAgent creates an agent, or updates the agent with the same name. Running jobs of the agent are reset.
+func (c *Client) RegisterAgent(ctx context.Context, option *RegisterAgentOption) (*Agent, error) {
+	labels, err := json.Marshal(option.Labels)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to marshal labels")
+	}
+	agent := &Agent{
+		Name:                   option.Name,
+		Hostname:               option.Hostname,
+		Version:                option.Version,
+		Engine:                 option.Engine,
+		Labels:                 labels,
+		Concurrency:            option.Concurrency,
+		RunningJobExecutionIDs: []byte("[]"),
+		LastHeartbeatAt:        time.Now(),
+	}
+	err = c.conn.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"hostname", "version", "engine", "labels", "concurrency", "running_job_execution_ids",
+			"last_heartbeat_at", "updated_at",
+		}),
+	}).Create(agent).Error
+	if err != nil {
+		return nil, err
+	}
+	// The id of the updated agent isn't returned by mysql.
+	agent = &Agent{}
+	if err = c.conn.WithContext(ctx).First(agent, "name = ?", option.Name).Error; err != nil {
+		return nil, err
+	}
+	return agent, nil
+}
+
+// GetAgent returns an agent by its ID.
+func (c *Client) GetAgent(ctx context.Context, id int64) (*Agent, error) {
+	agent := &Agent{}
+	if err := c.conn.WithContext(ctx).First(agent, id).Error; err != nil {
+		return nil, err
+	}
+	return agent, nil
+}
+
+// ListAgents returns all agents sorted by id in ascending order.
+func (c *Client) ListAgents(ctx context.Context) ([]*Agent, error) {
+	var agents []*Agent
+	if err := c.conn.WithContext(ctx).Order("id").Find(&agents).Error; err != nil {
+		return nil, err
+	}
+	return agents, nil
+}
+
+// UpdateAgentHeartbeat records a heartbeat of an agent with its running jobs.
+// It returns a record not found error if the agent doesn't exist.
+func (c *Client) UpdateAgentHeartbeat(ctx context.Context, id int64, runningJobExecutionIDs []int64) error {
+	if runningJobExecutionIDs == nil {
+		runningJobExecutionIDs = []int64{}
+	}
+	running, err := json.Marshal(runningJobExecutionIDs)
+	if err != nil {
+		return errors.WithMessage(err, "failed to marshal running job executions")
+	}
+	result := c.conn.WithContext(ctx).Model(&Agent{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"running_job_execution_ids": running,
+		"last_heartbeat_at":         time.Now(),
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// PackAgent packs an agent into api.Agent. The agent is offline if it has no heartbeat within offlineTimeout.
+func PackAgent(a *Agent, offlineTimeout time.Duration) (*api.Agent, error) {
+	p := &api.Agent{
+		ID:              a.ID,
+		Name:            a.Name,
+		Hostname:        a.Hostname,
+		Version:         a.Version,
+		Engine:          a.Engine,
+		Concurrency:     a.Concurrency,
+		LastHeartbeatAt: timestamppb.New(a.LastHeartbeatAt),
+		CreatedAt:       timestamppb.New(a.CreatedAt),
+		UpdatedAt:       timestamppb.New(a.UpdatedAt),
+	}
+	if len(a.Labels) > 0 {
+		if err := json.Unmarshal(a.Labels, &p.Labels); err != nil {
+			return nil, errors.WithMessage(err, "failed to unmarshal labels")
+		}
+	}
+	if len(a.RunningJobExecutionIDs) > 0 {
+		if err := json.Unmarshal(a.RunningJobExecutionIDs, &p.RunningJobExecutionIDs); err != nil {
+			return nil, errors.WithMessage(err, "failed to unmarshal running job executions")
+		}
+	}
+	switch {
+	case time.Since(a.LastHeartbeatAt) > offlineTimeout:
+		p.Status = api.AgentStatusOffline
+	case len(p.RunningJobExecutionIDs) > 0:
+		p.Status = api.AgentStatusBusy
+	default:
+		p.Status = api.AgentStatusIdle
+	}
+	return p, nil
+}
@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cox96de/runner/api"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_Agent(t *testing.T) {
+	db := NewMockDB(t, &Agent{})
+	ctx := context.Background()
+	agent, err := db.RegisterAgent(ctx, &RegisterAgentOption{
+		Name:        "agent1",
+		Hostname:    "host1",
+		Version:     "v1",
+		Engine:      "shell",
+		Labels:      []string{"linux"},
+		Concurrency: 2,
+	})
+	assert.NilError(t, err)
+	t.Run("heartbeat", func(t *testing.T) {
+		err := db.UpdateAgentHeartbeat(ctx, agent.ID, []int64{1, 2})
+		assert.NilError(t, err)
+		got, err := db.GetAgent(ctx, agent.ID)
+		assert.NilError(t, err)
+		packed, err := PackAgent(got, time.Minute)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, packed.RunningJobExecutionIDs, []int64{1, 2})
+		assert.DeepEqual(t, packed.Labels, []string{"linux"})
+		assert.Equal(t, packed.Status, api.AgentStatusBusy)
+		err = db.UpdateAgentHeartbeat(ctx, agent.ID+1, nil)
+		assert.Assert(t, IsRecordNotFoundError(err))
+	})
+	t.Run("register_again", func(t *testing.T) {
+		registered, err := db.RegisterAgent(ctx, &RegisterAgentOption{Name: "agent1", Hostname: "host2", Version: "v2"})
+		assert.NilError(t, err)
+		assert.Equal(t, registered.ID, agent.ID)
+		agents, err := db.ListAgents(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, len(agents), 1)
+		packed, err := PackAgent(agents[0], time.Minute)
+		assert.NilError(t, err)
+		assert.Equal(t, packed.Hostname, "host2")
+		assert.Equal(t, packed.Status, api.AgentStatusIdle)
+	})
+	t.Run("concurrent", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := db.RegisterAgent(ctx, &RegisterAgentOption{Name: "agent2"})
+				assert.Check(t, err)
+			}()
+		}
+		wg.Wait()
+		agents, err := db.ListAgents(ctx)
+		assert.NilError(t, err)
+		assert.Equal(t, len(agents), 2)
+	})
+	t.Run("offline", func(t *testing.T) {
+		packed, err := PackAgent(&Agent{LastHeartbeatAt: time.Now().Add(-time.Hour)}, time.Minute)
+		assert.NilError(t, err)
+		assert.Equal(t, packed.Status, api.AgentStatusOffline)
+	})
+}
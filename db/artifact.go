@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/cox96de/runner/api"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Artifact struct {
+	ID             int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	PipelineID     int64      `gorm:"column:pipeline_id"`
+	JobID          int64      `gorm:"column:job_id"`
+	JobExecutionID int64      `gorm:"column:job_execution_id"`
+	Name           string     `gorm:"column:name"`
+	Size           int64      `gorm:"column:size"`
+	ExpireAt       *time.Time `gorm:"column:expire_at"`
+	CreatedAt      time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (a *Artifact) TableName() string {
+	return "artifact"
+}
+
+// IsExpired returns true if the artifact is expired at the time.
+func (a *Artifact) IsExpired(now time.Time) bool {
+	return a.ExpireAt != nil && !a.ExpireAt.After(now)
+}
+
+type CreateArtifactOption struct {
+	PipelineID     int64
+	JobID          int64
+	JobExecutionID int64
+	Name           string
+	Size           int64
+	// ExpireAt is nil if the artifact is kept forever.
+	ExpireAt *time.Time
+}
+
+// CreateArtifact creates a new artifact.
+func (c *Client) CreateArtifact(ctx context.Context, option *CreateArtifactOption) (*Artifact, error) {
+	artifact := &Artifact{
+		PipelineID:     option.PipelineID,
+		JobID:          option.JobID,
+		JobExecutionID: option.JobExecutionID,
+		Name:           option.Name,
+		Size:           option.Size,
+		ExpireAt:       option.ExpireAt,
+	}
+	if err := c.conn.WithContext(ctx).Create(artifact).Error; err != nil {
+		return nil, err
+	}
+	return artifact, nil
+}
+
+// GetArtifact returns an artifact by its ID.
+func (c *Client) GetArtifact(ctx context.Context, id int64) (*Artifact, error) {
+	artifact := &Artifact{}
+	if err := c.conn.WithContext(ctx).First(artifact, id).Error; err != nil {
+		return nil, err
+	}
+	return artifact, nil
+}
+
+// GetArtifactByName returns the artifact of a job execution by its name.
+func (c *Client) GetArtifactByName(ctx context.Context, jobExecutionID int64, name string) (*Artifact, error) {
+	artifact := &Artifact{}
+	if err := c.conn.WithContext(ctx).First(artifact, "job_execution_id = ? AND name = ?", jobExecutionID,
+		name).Error; err != nil {
+		return nil, err
+	}
+	return artifact, nil
+}
+
+type ListArtifactsOption struct {
+	PipelineID int64
+	// JobIDs filters artifacts by job id. Nil means no filter.
+	JobIDs []int64
+	// Name filters artifacts by name. Empty means no filter.
+	Name string
+	// ExpiredBefore filters out artifacts expired before it. Nil means no filter.
+	ExpiredBefore *time.Time
+}
+
+// ListArtifacts returns artifacts sorted by id in ascending order.
+func (c *Client) ListArtifacts(ctx context.Context, option *ListArtifactsOption) ([]*Artifact, error) {
+	query := c.conn.WithContext(ctx).Order("id").Where("pipeline_id = ?", option.PipelineID)
+	if option.JobIDs != nil {
+		query = query.Where("job_id in ?", option.JobIDs)
+	}
+	if option.Name != "" {
+		query = query.Where("name = ?", option.Name)
+	}
+	if option.ExpiredBefore != nil {
+		query = query.Where("expire_at IS NULL OR expire_at > ?", *option.ExpiredBefore)
+	}
+	var artifacts []*Artifact
+	if err := query.Find(&artifacts).Error; err != nil {
+		return nil, err
+	}
+	return artifacts, nil
+}
+
+// PackArtifact packs an artifact into api.Artifact.
+func PackArtifact(a *Artifact) *api.Artifact {
+	p := &api.Artifact{
+		ID:             a.ID,
+		PipelineID:     a.PipelineID,
+		JobID:          a.JobID,
+		JobExecutionID: a.JobExecutionID,
+		Name:           a.Name,
+		Size:           a.Size,
+		CreatedAt:      timestamppb.New(a.CreatedAt),
+		UpdatedAt:      timestamppb.New(a.UpdatedAt),
+	}
+	if a.ExpireAt != nil {
+		p.ExpireAt = timestamppb.New(*a.ExpireAt)
+	}
+	return p
+}
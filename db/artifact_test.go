@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_ListArtifacts(t *testing.T) {
+	db := NewMockDB(t, &Artifact{})
+	ctx := context.Background()
+	now := time.Now()
+	var artifacts []*Artifact
+	for _, opt := range []*CreateArtifactOption{
+		{PipelineID: 1, JobID: 1, JobExecutionID: 1, Name: "dist"},
+		{PipelineID: 1, JobID: 2, JobExecutionID: 2, Name: "dist", ExpireAt: lo.ToPtr(now.Add(time.Hour))},
+		{PipelineID: 1, JobID: 2, JobExecutionID: 2, Name: "report", ExpireAt: lo.ToPtr(now.Add(-time.Hour))},
+		{PipelineID: 2, JobID: 3, JobExecutionID: 3, Name: "dist"},
+	} {
+		artifact, err := db.CreateArtifact(ctx, opt)
+		assert.NilError(t, err)
+		artifacts = append(artifacts, artifact)
+	}
+	ids := func(artifacts []*Artifact) []int64 {
+		return lo.Map(artifacts, func(item *Artifact, _ int) int64 {
+			return item.ID
+		})
+	}
+	t.Run("pipeline", func(t *testing.T) {
+		listed, err := db.ListArtifacts(ctx, &ListArtifactsOption{PipelineID: 1})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, ids(listed), ids(artifacts[:3]))
+	})
+	t.Run("job_and_name", func(t *testing.T) {
+		listed, err := db.ListArtifacts(ctx, &ListArtifactsOption{PipelineID: 1, JobIDs: []int64{2}, Name: "dist"})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, ids(listed), ids(artifacts[1:2]))
+	})
+	t.Run("not_expired", func(t *testing.T) {
+		listed, err := db.ListArtifacts(ctx, &ListArtifactsOption{PipelineID: 1, ExpiredBefore: &now})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, ids(listed), ids(artifacts[:2]))
+		assert.Assert(t, artifacts[2].IsExpired(now))
+		assert.Assert(t, !artifacts[1].IsExpired(now))
+	})
+	t.Run("get_by_name", func(t *testing.T) {
+		artifact, err := db.GetArtifactByName(ctx, 2, "report")
+		assert.NilError(t, err)
+		assert.Equal(t, artifact.ID, artifacts[2].ID)
+		_, err = db.GetArtifactByName(ctx, 1, "report")
+		assert.Assert(t, IsRecordNotFoundError(err))
+	})
+}
@@ -0,0 +1,182 @@
+package db
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cox96de/runner/api"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Cache struct {
+	ID    int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	Label string `gorm:"column:label"`
+	// Key is named cache_key in the table, because `key` is a reserved word in mysql.
+	Key string `gorm:"column:cache_key"`
+	// Object is the name of the archive in the cache storage.
+	Object     string    `gorm:"column:object"`
+	Size       int64     `gorm:"column:size"`
+	LastUsedAt time.Time `gorm:"column:last_used_at"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt  time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (c *Cache) TableName() string {
+	return "cache"
+}
+
+type CreateCacheOption struct {
+	Label      string
+	Key        string
+	Object     string
+	Size       int64
+	LastUsedAt time.Time
+}
+
+// CreateCache creates a new cache.
+func (c *Client) CreateCache(ctx context.Context, option *CreateCacheOption) (*Cache, error) {
+	cache := &Cache{
+		Label:      option.Label,
+		Key:        option.Key,
+		Object:     option.Object,
+		Size:       option.Size,
+		LastUsedAt: option.LastUsedAt,
+	}
+	if err := c.conn.WithContext(ctx).Create(cache).Error; err != nil {
+		return nil, err
+	}
+	return cache, nil
+}
+
+// GetCache returns a cache by its ID.
+func (c *Client) GetCache(ctx context.Context, id int64) (*Cache, error) {
+	cache := &Cache{}
+	if err := c.conn.WithContext(ctx).First(cache, id).Error; err != nil {
+		return nil, err
+	}
+	return cache, nil
+}
+
+// GetCacheByKey returns the cache of a label by its key.
+func (c *Client) GetCacheByKey(ctx context.Context, label string, key string) (*Cache, error) {
+	cache := &Cache{}
+	if err := c.conn.WithContext(ctx).First(cache, "label = ? AND cache_key = ?", label, key).Error; err != nil {
+		return nil, err
+	}
+	return cache, nil
+}
+
+// GetLatestCacheByKeyPrefix returns the latest created cache of a label whose key starts with prefix.
+func (c *Client) GetLatestCacheByKeyPrefix(ctx context.Context, label string, prefix string) (*Cache, error) {
+	cache := &Cache{}
+	if err := c.conn.WithContext(ctx).Order("id DESC").Where("label = ?", label).
+		Where(keyPrefixCondition, utf8.RuneCountInString(prefix), prefix).First(cache).Error; err != nil {
+		return nil, err
+	}
+	return cache, nil
+}
+
+// keyPrefixCondition matches the prefix of cache_key. It's used instead of LIKE to avoid escaping wildcards.
+const keyPrefixCondition = "SUBSTR(cache_key, 1, ?) = ?"
+
+type ListCachesOption struct {
+	Label string
+	// KeyPrefix filters caches by the prefix of key. Empty means no filter.
+	KeyPrefix string
+	// OrderByLastUsed sorts caches by last used time in ascending order instead of id.
+	OrderByLastUsed bool
+}
+
+// ListCaches returns caches of a label sorted by id in ascending order.
+func (c *Client) ListCaches(ctx context.Context, option *ListCachesOption) ([]*Cache, error) {
+	query := c.conn.WithContext(ctx).Where("label = ?", option.Label)
+	if option.OrderByLastUsed {
+		query = query.Order("last_used_at").Order("id")
+	} else {
+		query = query.Order("id")
+	}
+	if option.KeyPrefix != "" {
+		query = query.Where(keyPrefixCondition, utf8.RuneCountInString(option.KeyPrefix), option.KeyPrefix)
+	}
+	var caches []*Cache
+	if err := query.Find(&caches).Error; err != nil {
+		return nil, err
+	}
+	return caches, nil
+}
+
+// GetCachesSize returns the total size of caches of a label.
+func (c *Client) GetCachesSize(ctx context.Context, label string) (int64, error) {
+	var size int64
+	if err := c.conn.WithContext(ctx).Model(&Cache{}).Where("label = ?", label).
+		Select("COALESCE(SUM(size), 0)").Scan(&size).Error; err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// UpdateCacheLastUsedAt updates the last used time of a cache.
+func (c *Client) UpdateCacheLastUsedAt(ctx context.Context, id int64, lastUsedAt time.Time) error {
+	return c.conn.WithContext(ctx).Model(&Cache{}).Where("id = ?", id).
+		Update("last_used_at", lastUsedAt).Error
+}
+
+// DeleteCache deletes a cache by its ID.
+func (c *Client) DeleteCache(ctx context.Context, id int64) error {
+	return c.conn.WithContext(ctx).Delete(&Cache{}, id).Error
+}
+
+// CacheLimit is the max total size of caches of a label.
+type CacheLimit struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	Label     string    `gorm:"column:label"`
+	MaxSize   int64     `gorm:"column:max_size"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (c *CacheLimit) TableName() string {
+	return "cache_limit"
+}
+
+// GetCacheLimit returns the cache limit of a label.
+func (c *Client) GetCacheLimit(ctx context.Context, label string) (*CacheLimit, error) {
+	limit := &CacheLimit{}
+	if err := c.conn.WithContext(ctx).First(limit, "label = ?", label).Error; err != nil {
+		return nil, err
+	}
+	return limit, nil
+}
+
+// SetCacheLimit creates or updates the cache limit of a label.
+func (c *Client) SetCacheLimit(ctx context.Context, label string, maxSize int64) (*CacheLimit, error) {
+	limit, err := c.GetCacheLimit(ctx, label)
+	if err != nil {
+		if !IsRecordNotFoundError(err) {
+			return nil, err
+		}
+		limit = &CacheLimit{Label: label, MaxSize: maxSize}
+		if err = c.conn.WithContext(ctx).Create(limit).Error; err != nil {
+			return nil, err
+		}
+		return limit, nil
+	}
+	if err = c.conn.WithContext(ctx).Model(limit).Update("max_size", maxSize).Error; err != nil {
+		return nil, err
+	}
+	return limit, nil
+}
+
+// PackCache packs a cache into api.Cache.
+func PackCache(c *Cache) *api.Cache {
+	return &api.Cache{
+		ID:         c.ID,
+		Label:      c.Label,
+		Key:        c.Key,
+		Size:       c.Size,
+		LastUsedAt: timestamppb.New(c.LastUsedAt),
+		CreatedAt:  timestamppb.New(c.CreatedAt),
+		UpdatedAt:  timestamppb.New(c.UpdatedAt),
+	}
+}
@@ -0,0 +1,77 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_Cache(t *testing.T) {
+	db := NewMockDB(t, &Cache{}, &CacheLimit{})
+	ctx := context.Background()
+	now := time.Now()
+	var caches []*Cache
+	for i, opt := range []*CreateCacheOption{
+		{Label: "linux", Key: "go-a", Object: "a", Size: 1},
+		{Label: "linux", Key: "go-b", Object: "b", Size: 2},
+		{Label: "linux", Key: "npm-a", Object: "c", Size: 4},
+		{Label: "windows", Key: "go-a", Object: "d", Size: 8},
+	} {
+		opt.LastUsedAt = now.Add(-time.Duration(i) * time.Minute)
+		cache, err := db.CreateCache(ctx, opt)
+		assert.NilError(t, err)
+		caches = append(caches, cache)
+	}
+	ids := func(caches []*Cache) []int64 {
+		return lo.Map(caches, func(item *Cache, _ int) int64 {
+			return item.ID
+		})
+	}
+	t.Run("get_by_key", func(t *testing.T) {
+		cache, err := db.GetCacheByKey(ctx, "windows", "go-a")
+		assert.NilError(t, err)
+		assert.Equal(t, cache.ID, caches[3].ID)
+		_, err = db.GetCacheByKey(ctx, "windows", "go-b")
+		assert.Assert(t, IsRecordNotFoundError(err))
+	})
+	t.Run("get_latest_by_key_prefix", func(t *testing.T) {
+		cache, err := db.GetLatestCacheByKeyPrefix(ctx, "linux", "go-")
+		assert.NilError(t, err)
+		assert.Equal(t, cache.ID, caches[1].ID)
+		_, err = db.GetLatestCacheByKeyPrefix(ctx, "linux", "go_")
+		assert.Assert(t, IsRecordNotFoundError(err))
+	})
+	t.Run("list", func(t *testing.T) {
+		listed, err := db.ListCaches(ctx, &ListCachesOption{Label: "linux"})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, ids(listed), ids(caches[:3]))
+		listed, err = db.ListCaches(ctx, &ListCachesOption{Label: "linux", KeyPrefix: "go-"})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, ids(listed), ids(caches[:2]))
+		listed, err = db.ListCaches(ctx, &ListCachesOption{Label: "linux", OrderByLastUsed: true})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, ids(listed), []int64{caches[2].ID, caches[1].ID, caches[0].ID})
+	})
+	t.Run("size", func(t *testing.T) {
+		size, err := db.GetCachesSize(ctx, "linux")
+		assert.NilError(t, err)
+		assert.Equal(t, size, int64(7))
+		size, err = db.GetCachesSize(ctx, "macos")
+		assert.NilError(t, err)
+		assert.Equal(t, size, int64(0))
+	})
+	t.Run("limit", func(t *testing.T) {
+		_, err := db.GetCacheLimit(ctx, "linux")
+		assert.Assert(t, IsRecordNotFoundError(err))
+		_, err = db.SetCacheLimit(ctx, "linux", 10)
+		assert.NilError(t, err)
+		_, err = db.SetCacheLimit(ctx, "linux", 20)
+		assert.NilError(t, err)
+		limit, err := db.GetCacheLimit(ctx, "linux")
+		assert.NilError(t, err)
+		assert.Equal(t, limit.MaxSize, int64(20))
+	})
+}
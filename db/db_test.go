@@ -102,3 +102,16 @@ func TestClient_Transaction(t *testing.T) {
 		assert.DeepEqual(t, innerCreatedJobs[0], jobByID)
 	})
 }
+
+func TestIsDuplicateKeyError(t *testing.T) {
+	client := NewMockDB(t, &Secret{})
+	option := &CreateSecretOption{Name: "token", Project: "runner"}
+	_, err := client.CreateSecret(context.Background(), option)
+	assert.NilError(t, err)
+	_, err = client.CreateSecret(context.Background(), option)
+	assert.Assert(t, IsDuplicateKeyError(err))
+	// The same name in another scope is not duplicated.
+	_, err = client.CreateSecret(context.Background(), &CreateSecretOption{Name: "token", Label: "linux"})
+	assert.NilError(t, err)
+	assert.Assert(t, !IsDuplicateKeyError(errors.New("error")))
+}
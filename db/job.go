@@ -14,16 +14,24 @@ import (
 )
 
 type Job struct {
-	ID               int64     `gorm:"column:id;primaryKey;autoIncrement"`
-	PipelineID       int64     `gorm:"column:pipeline_id"`
-	Name             string    `gorm:"column:name"`
-	RunsOn           []byte    `gorm:"column:runs_on"`
-	WorkingDirectory string    `gorm:"column:working_directory"`
-	EnvVar           []byte    `gorm:"column:env_var"`
-	DependsOn        []byte    `gorm:"column:depends_on"`
-	Timeout          int32     `gorm:"column:timeout"`
-	CreatedAt        time.Time `gorm:"column:created_at;autoCreateTime"`
-	UpdatedAt        time.Time `gorm:"column:updated_at;autoUpdateTime"`
+	ID               int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	PipelineID       int64  `gorm:"column:pipeline_id"`
+	Name             string `gorm:"column:name"`
+	RunsOn           []byte `gorm:"column:runs_on"`
+	WorkingDirectory string `gorm:"column:working_directory"`
+	EnvVar           []byte `gorm:"column:env_var"`
+	// Secrets is a JSON object of names of secrets keyed by environment variables, values are never stored here.
+	Secrets            []byte    `gorm:"column:secrets"`
+	DependsOn          []byte    `gorm:"column:depends_on"`
+	Timeout            int32     `gorm:"column:timeout"`
+	MaxStepParallelism int32     `gorm:"column:max_step_parallelism"`
+	Artifacts          []byte    `gorm:"column:artifacts"`
+	DownloadArtifacts  []byte    `gorm:"column:download_artifacts"`
+	Cache              []byte    `gorm:"column:cache"`
+	KeepAliveOnFailure int32     `gorm:"column:keep_alive_on_failure"`
+	Matrix             []byte    `gorm:"column:matrix"`
+	CreatedAt          time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt          time.Time `gorm:"column:updated_at;autoUpdateTime"`
 }
 
 func (p *Job) TableName() string {
@@ -31,13 +39,21 @@ func (p *Job) TableName() string {
 }
 
 type CreateJobOption struct {
-	PipelineID       int64
-	Name             string
-	RunsOn           *api.RunsOn
-	WorkingDirectory string
-	EnvVar           map[string]string
-	DependsOn        []string
-	Timeout          int32
+	PipelineID         int64
+	Name               string
+	RunsOn             *api.RunsOn
+	WorkingDirectory   string
+	EnvVar             map[string]string
+	Secrets            map[string]string
+	DependsOn          []string
+	Timeout            int32
+	MaxStepParallelism int32
+	Artifacts          []*api.ArtifactUpload
+	DownloadArtifacts  []*api.ArtifactDownload
+	Cache              *api.CacheDSL
+	KeepAliveOnFailure int32
+	// Matrix is the combination the job is expanded from, it's nil if the job isn't expanded from a matrix.
+	Matrix *api.JobMatrix
 }
 
 // CreateJobs creates new jobs.
@@ -46,10 +62,12 @@ func (c *Client) CreateJobs(ctx context.Context, options []*CreateJobOption) ([]
 	var err error
 	for _, opt := range options {
 		job := &Job{
-			Name:             opt.Name,
-			PipelineID:       opt.PipelineID,
-			WorkingDirectory: opt.WorkingDirectory,
-			Timeout:          opt.Timeout,
+			Name:               opt.Name,
+			PipelineID:         opt.PipelineID,
+			WorkingDirectory:   opt.WorkingDirectory,
+			Timeout:            opt.Timeout,
+			MaxStepParallelism: opt.MaxStepParallelism,
+			KeepAliveOnFailure: opt.KeepAliveOnFailure,
 		}
 		job.RunsOn, err = json.Marshal(opt.RunsOn)
 		if err != nil {
@@ -63,6 +81,36 @@ func (c *Client) CreateJobs(ctx context.Context, options []*CreateJobOption) ([]
 		if err != nil {
 			return nil, errors.WithMessage(err, "failed to marshal job.DependsOn")
 		}
+		if len(opt.Secrets) > 0 {
+			job.Secrets, err = json.Marshal(opt.Secrets)
+			if err != nil {
+				return nil, errors.WithMessage(err, "failed to marshal job.Secrets")
+			}
+		}
+		if len(opt.Artifacts) > 0 {
+			job.Artifacts, err = json.Marshal(opt.Artifacts)
+			if err != nil {
+				return nil, errors.WithMessage(err, "failed to marshal job.Artifacts")
+			}
+		}
+		if len(opt.DownloadArtifacts) > 0 {
+			job.DownloadArtifacts, err = json.Marshal(opt.DownloadArtifacts)
+			if err != nil {
+				return nil, errors.WithMessage(err, "failed to marshal job.DownloadArtifacts")
+			}
+		}
+		if opt.Cache != nil {
+			job.Cache, err = json.Marshal(opt.Cache)
+			if err != nil {
+				return nil, errors.WithMessage(err, "failed to marshal job.Cache")
+			}
+		}
+		if opt.Matrix != nil {
+			job.Matrix, err = json.Marshal(opt.Matrix)
+			if err != nil {
+				return nil, errors.WithMessage(err, "failed to marshal job.Matrix")
+			}
+		}
 		jobs = append(jobs, job)
 	}
 	if err := c.conn.WithContext(ctx).Create(jobs).Error; err != nil {
@@ -80,6 +128,24 @@ func (c *Client) GetJobByID(ctx context.Context, id int64) (*Job, error) {
 	return job, nil
 }
 
+// GetJobsByPipelineID returns all jobs of the pipeline.
+func (c *Client) GetJobsByPipelineID(ctx context.Context, pipelineID int64) ([]*Job, error) {
+	var jobs []*Job
+	if err := c.conn.WithContext(ctx).Find(&jobs, "pipeline_id = ?", pipelineID).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// GetJobsByPipelineIDs returns all jobs of the pipelines.
+func (c *Client) GetJobsByPipelineIDs(ctx context.Context, pipelineIDs []int64) ([]*Job, error) {
+	var jobs []*Job
+	if err := c.conn.WithContext(ctx).Find(&jobs, "pipeline_id in ?", pipelineIDs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
 // PackJob packs a job into api.Job.
 // latestExecution is the latest job execution.
 // executions are all job executions.
@@ -106,6 +172,36 @@ func PackJob(j *Job, latestExecution *JobExecution, executions []*JobExecution,
 	if err != nil {
 		return nil, errors.WithMessage(err, "failed to unmarshal job.DependsOn")
 	}
+	var (
+		artifacts         []*api.ArtifactUpload
+		downloadArtifacts []*api.ArtifactDownload
+		cache             *api.CacheDSL
+	)
+	if len(j.Artifacts) > 0 {
+		if err = json.Unmarshal(j.Artifacts, &artifacts); err != nil {
+			return nil, errors.WithMessage(err, "failed to unmarshal job.Artifacts")
+		}
+	}
+	if len(j.DownloadArtifacts) > 0 {
+		if err = json.Unmarshal(j.DownloadArtifacts, &downloadArtifacts); err != nil {
+			return nil, errors.WithMessage(err, "failed to unmarshal job.DownloadArtifacts")
+		}
+	}
+	if len(j.Cache) > 0 {
+		if err = json.Unmarshal(j.Cache, &cache); err != nil {
+			return nil, errors.WithMessage(err, "failed to unmarshal job.Cache")
+		}
+	}
+	var secrets map[string]string
+	if len(j.Secrets) > 0 {
+		if err = json.Unmarshal(j.Secrets, &secrets); err != nil {
+			return nil, errors.WithMessage(err, "failed to unmarshal job.Secrets")
+		}
+	}
+	matrix, err := UnmarshalJobMatrix(j.Matrix)
+	if err != nil {
+		return nil, err
+	}
 	packSteps, err := packSteps(steps, stepExecutions)
 	if err != nil {
 		return nil, errors.WithMessage(err, "failed to pack steps")
@@ -123,23 +219,42 @@ func PackJob(j *Job, latestExecution *JobExecution, executions []*JobExecution,
 		return nil, errors.WithMessage(err, "failed to pack latest job execution")
 	}
 	p := &api.Job{
-		ID:               j.ID,
-		PipelineID:       j.PipelineID,
-		Name:             j.Name,
-		RunsOn:           runsOn,
-		WorkingDirectory: j.WorkingDirectory,
-		EnvVar:           envVar,
-		Executions:       jobExecutions,
-		Execution:        latestExe,
-		Steps:            packSteps,
-		DependsOn:        dependsOn,
-		Timeout:          j.Timeout,
-		CreatedAt:        timestamppb.New(j.CreatedAt),
-		UpdatedAt:        timestamppb.New(j.UpdatedAt),
+		ID:                 j.ID,
+		PipelineID:         j.PipelineID,
+		Name:               j.Name,
+		RunsOn:             runsOn,
+		WorkingDirectory:   j.WorkingDirectory,
+		EnvVar:             envVar,
+		Secrets:            secrets,
+		Executions:         jobExecutions,
+		Execution:          latestExe,
+		Steps:              packSteps,
+		DependsOn:          dependsOn,
+		Timeout:            j.Timeout,
+		MaxStepParallelism: j.MaxStepParallelism,
+		Artifacts:          artifacts,
+		DownloadArtifacts:  downloadArtifacts,
+		Cache:              cache,
+		KeepAliveOnFailure: j.KeepAliveOnFailure,
+		Matrix:             matrix,
+		CreatedAt:          timestamppb.New(j.CreatedAt),
+		UpdatedAt:          timestamppb.New(j.UpdatedAt),
 	}
 	return p, nil
 }
 
+// UnmarshalJobMatrix unmarshals job.Matrix. It returns nil if the job isn't expanded from a matrix.
+func UnmarshalJobMatrix(body []byte) (*api.JobMatrix, error) {
+	if len(body) == 0 {
+		return nil, nil
+	}
+	m := &api.JobMatrix{}
+	if err := json.Unmarshal(body, m); err != nil {
+		return nil, errors.WithMessage(err, "failed to unmarshal job.Matrix")
+	}
+	return m, nil
+}
+
 func UnmarshalRunsOn(body []byte) (*api.RunsOn, error) {
 	r := &api.RunsOn{}
 	err := json.Unmarshal(body, r)
@@ -155,15 +270,21 @@ func PackJobExecution(j *JobExecution, steps []*StepExecution) (*api.JobExecutio
 	if j == nil {
 		return nil, nil
 	}
-	stepExecutions := lo.Map(steps, func(e *StepExecution, _ int) *api.StepExecution {
-		return PackStepExecution(e)
-	})
+	stepExecutions := make([]*api.StepExecution, 0, len(steps))
+	for _, step := range steps {
+		stepExecution, err := PackStepExecution(step)
+		if err != nil {
+			return nil, err
+		}
+		stepExecutions = append(stepExecutions, stepExecution)
+	}
 	// TODO: sort step.
 	p := &api.JobExecution{
 		ID:          j.ID,
 		JobID:       j.JobID,
 		Status:      j.Status,
 		Steps:       stepExecutions,
+		AgentID:     j.AgentID,
 		StartedAt:   api.ConvertTime(j.StartedAt),
 		CompletedAt: api.ConvertTime(j.CompletedAt),
 		CreatedAt:   api.ConvertTime(j.CreatedAt),
@@ -174,18 +295,37 @@ func PackJobExecution(j *JobExecution, steps []*StepExecution) (*api.JobExecutio
 			return nil, errors.WithMessage(err, "failed to unmarshal job execution reason")
 		}
 	}
+	if len(j.Outputs) > 0 {
+		if err := json.Unmarshal(j.Outputs, &p.Outputs); err != nil {
+			return nil, errors.WithMessage(err, "failed to unmarshal job execution outputs")
+		}
+	}
+	if len(j.DependencyOutputs) > 0 {
+		var dependencyOutputs map[string]map[string]string
+		if err := json.Unmarshal(j.DependencyOutputs, &dependencyOutputs); err != nil {
+			return nil, errors.WithMessage(err, "failed to unmarshal job execution dependency outputs")
+		}
+		p.DependencyOutputs = lo.MapValues(dependencyOutputs, func(outputs map[string]string, _ string) *api.JobOutputs {
+			return &api.JobOutputs{Outputs: outputs}
+		})
+	}
 	return p, nil
 }
 
 type JobExecution struct {
-	ID          int64      `gorm:"column:id;primaryKey;autoIncrement"`
-	JobID       int64      `gorm:"column:job_id"`
-	Status      api.Status `gorm:"column:status"`
-	Reason      []byte     `gorm:"column:reason"`
-	StartedAt   *time.Time `gorm:"column:started_at"`
-	CompletedAt *time.Time `gorm:"column:completed_at"`
-	CreatedAt   time.Time  `gorm:"column:created_at;autoCreateTime"`
-	UpdatedAt   time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	ID      int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	JobID   int64      `gorm:"column:job_id"`
+	Status  api.Status `gorm:"column:status"`
+	Reason  []byte     `gorm:"column:reason"`
+	AgentID int64      `gorm:"column:agent_id"`
+	// Outputs is a JSON object of outputs written by steps.
+	Outputs []byte `gorm:"column:outputs"`
+	// DependencyOutputs is a JSON object of outputs of dependencies keyed by job names, recorded when it's queued.
+	DependencyOutputs []byte     `gorm:"column:dependency_outputs"`
+	StartedAt         *time.Time `gorm:"column:started_at"`
+	CompletedAt       *time.Time `gorm:"column:completed_at"`
+	CreatedAt         time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt         time.Time  `gorm:"column:updated_at;autoUpdateTime"`
 }
 
 func (p *JobExecution) TableName() string {
@@ -229,12 +369,40 @@ func (c *Client) GetJobExecutionsByJobID(ctx context.Context, jobID int64) ([]*J
 	return executions, nil
 }
 
+// GetJobExecutionsByJobIDs returns job executions by job id list. The result is grouped by job id.
+func (c *Client) GetJobExecutionsByJobIDs(ctx context.Context, jobIDs []int64) (map[int64][]*JobExecution, error) {
+	var executions []*JobExecution
+	if err := c.conn.WithContext(ctx).Find(&executions, "job_id in ?", jobIDs).Error; err != nil {
+		return nil, err
+	}
+	return lo.GroupBy(executions, func(item *JobExecution) int64 {
+		return item.JobID
+	}), nil
+}
+
+// GetLatestJobExecutions returns the latest job executions of jobs. The result is keyed by job id.
+func (c *Client) GetLatestJobExecutions(ctx context.Context, jobIDs []int64) (map[int64]*JobExecution, error) {
+	executionMap, err := c.GetJobExecutionsByJobIDs(ctx, jobIDs)
+	if err != nil {
+		return nil, err
+	}
+	return lo.MapValues(executionMap, func(executions []*JobExecution, _ int64) *JobExecution {
+		return lo.MaxBy(executions, func(a, b *JobExecution) bool {
+			return a.ID > b.ID
+		})
+	}), nil
+}
+
 type UpdateJobExecutionOption struct {
 	ID          int64
 	Status      *api.Status
 	Reason      *api.Reason
 	StartedAt   *time.Time
 	CompletedAt *time.Time
+	// Outputs are merged into outputs of the job execution.
+	Outputs map[string]string
+	// DependencyOutputs replaces outputs of dependencies of the job execution.
+	DependencyOutputs map[string]map[string]string
 }
 
 // UpdateJobExecution updates a job execution. Don't use this method directly, A helper function in dispatch.
@@ -259,14 +427,31 @@ func (c *Client) UpdateJobExecution(ctx context.Context, option *UpdateJobExecut
 		}
 		jobExecution.Reason = bys
 	}
+	if len(option.Outputs) > 0 {
+		outputs := map[string]string{}
+		if len(jobExecution.Outputs) > 0 {
+			if err = json.Unmarshal(jobExecution.Outputs, &outputs); err != nil {
+				return nil, errors.WithMessage(err, "failed to unmarshal outputs")
+			}
+		}
+		bys, err := json.Marshal(lo.Assign(outputs, option.Outputs))
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed to marshal outputs")
+		}
+		jobExecution.Outputs = bys
+	}
+	if option.DependencyOutputs != nil {
+		bys, err := json.Marshal(option.DependencyOutputs)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed to marshal dependency outputs")
+		}
+		jobExecution.DependencyOutputs = bys
+	}
 	return jobExecution, c.conn.WithContext(ctx).Where("id = ?", option.ID).Save(jobExecution).Error
 }
 
-func (c *Client) ResetJobExecution(ctx context.Context, jobExecutionID int64) error {
-	return c.conn.WithContext(ctx).Model(&JobExecution{}).Where("id = ?", jobExecutionID).Updates(map[string]interface{}{
-		"status":       api.StatusCreated,
-		"reason":       "",
-		"started_at":   nil,
-		"completed_at": nil,
-	}).Error
+// SetJobExecutionAgent records the agent the job execution is dispatched to.
+func (c *Client) SetJobExecutionAgent(ctx context.Context, jobExecutionID int64, agentID int64) error {
+	return c.conn.WithContext(ctx).Model(&JobExecution{}).Where("id = ?", jobExecutionID).
+		Update("agent_id", agentID).Error
 }
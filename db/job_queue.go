@@ -4,32 +4,53 @@ import (
 	"context"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/cox96de/runner/api"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type JobQueue struct {
 	ID             int64      `gorm:"column:id;primaryKey;autoIncrement"`
 	Status         api.Status `gorm:"column:status"`
-	JobExecutionID int64      `gorm:"column:job_execution_id"`
+	JobExecutionID int64      `gorm:"column:job_execution_id;uniqueIndex"`
 	Label          string     `gorm:"column:label"`
-	Heartbeat      time.Time  `gorm:"column:heartbeat;autoCreateTime"`
-	CreatedAt      time.Time  `gorm:"column:created_at;autoCreateTime"`
-	UpdatedAt      time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	// AgentID is the agent which claims the job execution.
+	AgentID   int64     `gorm:"column:agent_id"`
+	Heartbeat time.Time `gorm:"column:heartbeat;autoCreateTime"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
 }
 
 func (p *JobQueue) TableName() string {
 	return "job_queue"
 }
 
+// JobQueueLabel is a label in the label expression of a queued job execution. Label expressions are split into
+// labels, so that they can be matched with labels of agents in the query.
+type JobQueueLabel struct {
+	ID             int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	JobExecutionID int64  `gorm:"column:job_execution_id;index"`
+	Label          string `gorm:"column:label"`
+	// Excluded is true if agents having the label are excluded.
+	Excluded bool `gorm:"column:excluded"`
+}
+
+func (p *JobQueueLabel) TableName() string {
+	return "job_queue_label"
+}
+
 type CreateJobQueueOption struct {
 	JobExecutionID int64
-	Label          string
-	Status         api.Status
+	// Label is the label expression of the job.
+	Label  string
+	Status api.Status
 }
 
 // CreateJobQueues creates new job queue entity.
 func (c *Client) CreateJobQueues(ctx context.Context, options []*CreateJobQueueOption) ([]*JobQueue, error) {
 	jobQueues := make([]*JobQueue, 0, len(options))
+	var labels []*JobQueueLabel
 	for _, opt := range options {
 		job := &JobQueue{
 			JobExecutionID: opt.JobExecutionID,
@@ -37,10 +58,29 @@ func (c *Client) CreateJobQueues(ctx context.Context, options []*CreateJobQueueO
 			Status:         opt.Status,
 		}
 		jobQueues = append(jobQueues, job)
+		if opt.Label == "" {
+			// No label is required, it's matched by any agent. Label expressions of pipelines are never empty.
+			continue
+		}
+		selector, err := api.ParseLabelSelector(opt.Label)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "invalid label of job execution '%d'", opt.JobExecutionID)
+		}
+		for _, label := range selector.Required {
+			labels = append(labels, &JobQueueLabel{JobExecutionID: opt.JobExecutionID, Label: label})
+		}
+		for _, label := range selector.Excluded {
+			labels = append(labels, &JobQueueLabel{JobExecutionID: opt.JobExecutionID, Label: label, Excluded: true})
+		}
 	}
 	if err := c.conn.WithContext(ctx).Create(jobQueues).Error; err != nil {
 		return nil, err
 	}
+	if len(labels) > 0 {
+		if err := c.conn.WithContext(ctx).Create(labels).Error; err != nil {
+			return nil, err
+		}
+	}
 	return jobQueues, nil
 }
 
@@ -64,18 +104,122 @@ func (c *Client) DeleteJobQueueByJobExecutionID(ctx context.Context, jobExecutio
 		Where("job_execution_id = ?", jobExecutionID).Delete(&JobQueue{}).Error; err != nil {
 		return err
 	}
+	if err := c.conn.WithContext(ctx).
+		Where("job_execution_id = ?", jobExecutionID).Delete(&JobQueueLabel{}).Error; err != nil {
+		return err
+	}
 	return nil
 }
 
-func (c *Client) GetQueuedJobExecutionIDs(ctx context.Context, label string, limit int) ([]*JobQueue, error) {
+// GetQueuedJobExecutionIDs returns queued job executions whose label expressions match labels of an agent.
+func (c *Client) GetQueuedJobExecutionIDs(ctx context.Context, labels []string, limit int) ([]*JobQueue, error) {
 	executions := make([]*JobQueue, 0, limit)
-	if err := c.conn.WithContext(ctx).Where("label = ? and status = ? order by id", label, api.StatusQueued).
-		Limit(limit).Find(&executions).Error; err != nil {
+	if len(labels) == 0 {
+		// Every label expression has at least one required label.
+		return executions, nil
+	}
+	if err := queuedJobQueues(c.conn.WithContext(ctx), labels).
+		Order("id").Limit(limit).Find(&executions).Error; err != nil {
 		return nil, err
 	}
 	return executions, nil
 }
 
+// queuedJobQueues scopes the query to queued job queues whose label expressions match labels.
+// A label expression matches if all required labels are in labels, and none of excluded labels is in labels.
+func queuedJobQueues(conn *gorm.DB, labels []string) *gorm.DB {
+	return conn.Model(&JobQueue{}).Where("status = ?", api.StatusQueued).
+		Where("NOT EXISTS (SELECT 1 FROM job_queue_label WHERE job_queue_label.job_execution_id = job_queue.job_execution_id"+
+			" AND job_queue_label.excluded = ? AND job_queue_label.label NOT IN ?)", false, labels).
+		Where("NOT EXISTS (SELECT 1 FROM job_queue_label WHERE job_queue_label.job_execution_id = job_queue.job_execution_id"+
+			" AND job_queue_label.excluded = ? AND job_queue_label.label IN ?)", true, labels)
+}
+
+// ClaimJobQueue claims the earliest queued job execution whose label expression matches labels for the agent.
+// The claimed job queue is moved to preparing status atomically, so a job execution is never claimed twice. The
+// heartbeat is touched too, so the job execution is recycled if the agent never reports it.
+// It returns a record not found error if there is no job to claim.
+func (c *Client) ClaimJobQueue(ctx context.Context, labels []string, agentID int64) (*JobQueue, error) {
+	if len(labels) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	updateField := map[string]interface{}{
+		"status":    api.StatusPreparing,
+		"agent_id":  agentID,
+		"heartbeat": time.Now(),
+	}
+	switch Dialect(c.conn.Dialector.Name()) {
+	case Postgres:
+		candidate := queuedJobQueues(c.conn, labels).Select("id").Order("id").Limit(1).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+		var jobQueues []*JobQueue
+		result := c.conn.WithContext(ctx).Model(&jobQueues).Clauses(clause.Returning{}).
+			Where("id = (?)", candidate).Updates(updateField)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if len(jobQueues) == 0 {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return jobQueues[0], nil
+	case Mysql:
+		jobQueue := &JobQueue{}
+		err := c.conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := queuedJobQueues(tx, labels).Order("id").
+				Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).Take(jobQueue).Error; err != nil {
+				return err
+			}
+			return tx.Model(jobQueue).Updates(updateField).Error
+		})
+		if err != nil {
+			return nil, err
+		}
+		return jobQueue, nil
+	default:
+		return c.claimJobQueue(ctx, labels, updateField)
+	}
+}
+
+// claimJobQueue claims a job queue for databases without row locks, such as sqlite. The status is compared in the
+// update, the next candidate is tried if the job queue is claimed by others in the meantime.
+func (c *Client) claimJobQueue(ctx context.Context, labels []string, updateField map[string]interface{}) (*JobQueue, error) {
+	var claimed *JobQueue
+	err := c.conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var candidates []*JobQueue
+		if err := queuedJobQueues(tx, labels).Order("id").Limit(10).Find(&candidates).Error; err != nil {
+			return err
+		}
+		for _, candidate := range candidates {
+			result := tx.Model(&JobQueue{}).Where("id = ? AND status = ?", candidate.ID, api.StatusQueued).
+				Updates(updateField)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				continue
+			}
+			claimed = &JobQueue{}
+			return tx.First(claimed, candidate.ID).Error
+		}
+		return gorm.ErrRecordNotFound
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+// ReleaseJobQueue moves a job queue claimed by ClaimJobQueue back to queued status, so that it can be claimed again.
+// It's used if the claimed job execution can't be dispatched to the agent.
+func (c *Client) ReleaseJobQueue(ctx context.Context, jobExecutionID int64) error {
+	updateField := map[string]interface{}{
+		"status":   api.StatusQueued,
+		"agent_id": 0,
+	}
+	return c.conn.WithContext(ctx).Model(&JobQueue{}).
+		Where("job_execution_id = ? AND status = ?", jobExecutionID, api.StatusPreparing).Updates(updateField).Error
+}
+
 func (c *Client) TouchHeartbeat(ctx context.Context, jobExecutionID int64) error {
 	updateField := map[string]interface{}{
 		"heartbeat": time.Now(),
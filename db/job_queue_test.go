@@ -2,17 +2,19 @@ package db
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/cox96de/runner/api"
+	"github.com/samber/lo"
 	"gotest.tools/v3/assert"
 )
 
 func TestClient_GetQueuedJobExecutionIDs(t *testing.T) {
-	db := NewMockDB(t, &JobQueue{})
+	db := NewMockDB(t, &JobQueue{}, &JobQueueLabel{})
 	t.Run("empty", func(t *testing.T) {
-		executions, err := db.GetQueuedJobExecutionIDs(context.Background(), "label", 10)
+		executions, err := db.GetQueuedJobExecutionIDs(context.Background(), []string{"label"}, 10)
 		assert.NilError(t, err)
 		assert.Assert(t, len(executions) == 0)
 	})
@@ -40,24 +42,134 @@ func TestClient_GetQueuedJobExecutionIDs(t *testing.T) {
 	})
 	t.Run("with_match_label", func(t *testing.T) {
 		assert.NilError(t, err)
-		executions, err := db.GetQueuedJobExecutionIDs(context.Background(), "label1", 1)
+		executions, err := db.GetQueuedJobExecutionIDs(context.Background(), []string{"label1"}, 1)
 		assert.NilError(t, err)
 		assert.Assert(t, len(executions) == 1)
 		assert.Equal(t, api.StatusQueued, executions[0].Status)
-		jobExecutions, err := db.GetQueuedJobExecutionIDs(context.Background(), "label1", 10)
+		jobExecutions, err := db.GetQueuedJobExecutionIDs(context.Background(), []string{"label1"}, 10)
 		assert.NilError(t, err)
 		assert.Assert(t, len(jobExecutions) == 2)
 	})
 	t.Run("with_match_label2", func(t *testing.T) {
 		assert.NilError(t, err)
-		executions, err := db.GetQueuedJobExecutionIDs(context.Background(), "label2", 10)
+		executions, err := db.GetQueuedJobExecutionIDs(context.Background(), []string{"label2"}, 10)
 		assert.NilError(t, err)
 		assert.Assert(t, len(executions) == 1)
 	})
 }
 
+func TestClient_GetQueuedJobExecutionIDs_LabelExpression(t *testing.T) {
+	db := NewMockDB(t, &JobQueue{}, &JobQueueLabel{})
+	ctx := context.Background()
+	_, err := db.CreateJobQueues(ctx, []*CreateJobQueueOption{
+		{JobExecutionID: 1, Status: api.StatusQueued, Label: "linux"},
+		{JobExecutionID: 2, Status: api.StatusQueued, Label: "linux && amd64"},
+		{JobExecutionID: 3, Status: api.StatusQueued, Label: "linux && !gpu=true"},
+		{JobExecutionID: 4, Status: api.StatusQueued, Label: "windows"},
+	})
+	assert.NilError(t, err)
+	jobExecutionIDs := func(labels ...string) []int64 {
+		jobQueues, err := db.GetQueuedJobExecutionIDs(ctx, labels, 10)
+		assert.NilError(t, err)
+		return lo.Map(jobQueues, func(item *JobQueue, _ int) int64 {
+			return item.JobExecutionID
+		})
+	}
+	assert.DeepEqual(t, jobExecutionIDs("linux"), []int64{1, 3})
+	assert.DeepEqual(t, jobExecutionIDs("linux", "amd64", "gpu=false"), []int64{1, 2, 3})
+	assert.DeepEqual(t, jobExecutionIDs("linux", "amd64", "gpu=true"), []int64{1, 2})
+	assert.DeepEqual(t, jobExecutionIDs("windows", "amd64"), []int64{4})
+	assert.DeepEqual(t, jobExecutionIDs(), []int64{})
+	err = db.DeleteJobQueueByJobExecutionID(ctx, 1)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, jobExecutionIDs("linux"), []int64{3})
+	_, err = db.CreateJobQueues(ctx, []*CreateJobQueueOption{{JobExecutionID: 5, Label: "!linux"}})
+	assert.ErrorContains(t, err, "no required label")
+}
+
+func TestClient_ClaimJobQueue(t *testing.T) {
+	db := NewMockDB(t, &JobQueue{}, &JobQueueLabel{})
+	ctx := context.Background()
+	_, err := db.CreateJobQueues(ctx, []*CreateJobQueueOption{
+		{JobExecutionID: 1, Status: api.StatusQueued, Label: "linux"},
+		{JobExecutionID: 2, Status: api.StatusQueued, Label: "windows"},
+		{JobExecutionID: 3, Status: api.StatusQueued, Label: "linux && !gpu=true"},
+	})
+	assert.NilError(t, err)
+	_, err = db.ClaimJobQueue(ctx, []string{"macos"}, 1)
+	assert.Assert(t, IsRecordNotFoundError(err))
+	_, err = db.ClaimJobQueue(ctx, nil, 1)
+	assert.Assert(t, IsRecordNotFoundError(err))
+	jobQueue, err := db.ClaimJobQueue(ctx, []string{"linux", "gpu=true"}, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, jobQueue.JobExecutionID, int64(1))
+	assert.Equal(t, jobQueue.Status, api.StatusPreparing)
+	assert.Equal(t, jobQueue.AgentID, int64(1))
+	jobQueue, err = db.GetJobQueue(ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, jobQueue.Status, api.StatusPreparing)
+	assert.Equal(t, jobQueue.AgentID, int64(1))
+	// The claimed job is not claimed again.
+	_, err = db.ClaimJobQueue(ctx, []string{"linux", "gpu=true"}, 2)
+	assert.Assert(t, IsRecordNotFoundError(err))
+	jobQueue, err = db.ClaimJobQueue(ctx, []string{"linux"}, 2)
+	assert.NilError(t, err)
+	assert.Equal(t, jobQueue.JobExecutionID, int64(3))
+	// The released job can be claimed again.
+	err = db.ReleaseJobQueue(ctx, 1)
+	assert.NilError(t, err)
+	jobQueue, err = db.ClaimJobQueue(ctx, []string{"linux"}, 3)
+	assert.NilError(t, err)
+	assert.Equal(t, jobQueue.JobExecutionID, int64(1))
+	assert.Equal(t, jobQueue.AgentID, int64(3))
+}
+
+func TestClient_ClaimJobQueue_Concurrent(t *testing.T) {
+	db := NewMockDB(t, &JobQueue{}, &JobQueueLabel{})
+	sqlDB, err := db.conn.DB()
+	assert.NilError(t, err)
+	// Each connection opens a new database in memory, so concurrent queries must share the only connection.
+	sqlDB.SetMaxOpenConns(1)
+	ctx := context.Background()
+	const jobCount = 50
+	options := make([]*CreateJobQueueOption, 0, jobCount)
+	for i := 1; i <= jobCount; i++ {
+		options = append(options, &CreateJobQueueOption{JobExecutionID: int64(i), Status: api.StatusQueued, Label: "linux"})
+	}
+	_, err = db.CreateJobQueues(ctx, options)
+	assert.NilError(t, err)
+	var (
+		lock    sync.Mutex
+		claimed = map[int64]int64{}
+		wg      sync.WaitGroup
+	)
+	for agentID := int64(1); agentID <= 8; agentID++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				jobQueue, err := db.ClaimJobQueue(ctx, []string{"linux"}, agentID)
+				if IsRecordNotFoundError(err) {
+					return
+				}
+				if !assert.Check(t, err) {
+					return
+				}
+				lock.Lock()
+				if owner, ok := claimed[jobQueue.JobExecutionID]; ok {
+					t.Errorf("job execution %d is claimed by agent %d and %d", jobQueue.JobExecutionID, owner, agentID)
+				}
+				claimed[jobQueue.JobExecutionID] = agentID
+				lock.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, len(claimed), jobCount)
+}
+
 func TestClient_ListHeartbeatJobExecutions(t *testing.T) {
-	db := NewMockDB(t, &JobQueue{})
+	db := NewMockDB(t, &JobQueue{}, &JobQueueLabel{})
 	jobQueues, err := db.CreateJobQueues(context.Background(), []*CreateJobQueueOption{{
 		JobExecutionID: 1,
 		Label:          "",
@@ -3,7 +3,6 @@ package db
 import (
 	"context"
 	"testing"
-	"time"
 
 	"github.com/cox96de/runner/api"
 
@@ -43,6 +42,14 @@ func TestClient_CreateJobs(t *testing.T) {
 		assert.NilError(t, err, job.Name)
 		assert.DeepEqual(t, job, jobByID)
 	}
+	t.Run("GetJobsByPipelineID", func(t *testing.T) {
+		jobsByPipelineID, err := db.GetJobsByPipelineID(context.Background(), 1)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, jobsByPipelineID, jobs)
+		jobsByPipelineID, err = db.GetJobsByPipelineID(context.Background(), 2)
+		assert.NilError(t, err)
+		assert.Equal(t, len(jobsByPipelineID), 0)
+	})
 }
 
 func TestClient_CreateJobExecutions(t *testing.T) {
@@ -80,6 +87,24 @@ func TestClient_CreateJobExecutions(t *testing.T) {
 		assert.NilError(t, err)
 		assert.DeepEqual(t, jobExecutions, []*JobExecution{jobExecutions[0]})
 	})
+	t.Run("GetJobExecutionsByJobIDs", func(t *testing.T) {
+		executionMap, err := db.GetJobExecutionsByJobIDs(context.Background(), []int64{1, 2, 3})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, executionMap, map[int64][]*JobExecution{
+			1: {jobExecutions[0]},
+			2: {jobExecutions[1]},
+		})
+	})
+	t.Run("GetLatestJobExecutions", func(t *testing.T) {
+		created, err := db.CreateJobExecutions(context.Background(), []*CreateJobExecutionOption{{JobID: 1}})
+		assert.NilError(t, err)
+		latest, err := db.GetLatestJobExecutions(context.Background(), []int64{1, 2})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, latest, map[int64]*JobExecution{
+			1: created[0],
+			2: jobExecutions[1],
+		})
+	})
 }
 
 func TestClient_UpdateJobExecution(t *testing.T) {
@@ -99,34 +124,24 @@ func TestClient_UpdateJobExecution(t *testing.T) {
 	execution, err := db.GetJobExecution(context.Background(), executions[0].ID)
 	assert.NilError(t, err)
 	assert.Equal(t, api.StatusRunning, execution.Status)
-}
-
-func TestClient_ResetJobExecution(t *testing.T) {
-	db := NewMockDB(t, &JobExecution{})
-	executions, err := db.CreateJobExecutions(context.Background(), []*CreateJobExecutionOption{
-		{
-			JobID:  1,
-			Status: api.StatusCreated,
-		},
-	})
-	assert.NilError(t, err)
-	_, err = db.UpdateJobExecution(context.Background(), &UpdateJobExecutionOption{
-		ID:     executions[0].ID,
-		Status: lo.ToPtr(api.StatusFailed),
-		Reason: &api.Reason{
-			Reason:  api.FailedReasonStepFailed,
-			Message: "step failed",
-		},
-		StartedAt:   lo.ToPtr(time.Now()),
-		CompletedAt: lo.ToPtr(time.Now()),
+	t.Run("outputs", func(t *testing.T) {
+		for _, outputs := range []map[string]string{{"a": "1", "b": "1"}, {"b": "2"}} {
+			_, err = db.UpdateJobExecution(context.Background(), &UpdateJobExecutionOption{
+				ID:      executions[0].ID,
+				Outputs: outputs,
+			})
+			assert.NilError(t, err)
+		}
+		_, err = db.UpdateJobExecution(context.Background(), &UpdateJobExecutionOption{
+			ID:                executions[0].ID,
+			DependencyOutputs: map[string]map[string]string{"build": {"image": "runner:v1"}},
+		})
+		assert.NilError(t, err)
+		execution, err := db.GetJobExecution(context.Background(), executions[0].ID)
+		assert.NilError(t, err)
+		packed, err := PackJobExecution(execution, nil)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, packed.Outputs, map[string]string{"a": "1", "b": "2"})
+		assert.DeepEqual(t, packed.DependencyOutputs["build"].Outputs, map[string]string{"image": "runner:v1"})
 	})
-	assert.NilError(t, err)
-	err = db.ResetJobExecution(context.Background(), executions[0].ID)
-	assert.NilError(t, err)
-	execution, err := db.GetJobExecution(context.Background(), executions[0].ID)
-	assert.NilError(t, err)
-	assert.Equal(t, api.StatusCreated, execution.Status)
-	assert.Equal(t, "", string(execution.Reason))
-	assert.Assert(t, execution.StartedAt == nil)
-	assert.Assert(t, execution.CompletedAt == nil)
 }
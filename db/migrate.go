@@ -9,7 +9,8 @@ import (
 func getAllModels() []interface{} {
 	return []interface{}{
 		&Pipeline{}, &PipelineExecution{}, &Job{}, &JobExecution{}, &Step{}, &StepExecution{},
-		&JobQueue{},
+		&JobQueue{}, &JobQueueLabel{}, &Artifact{}, &Cache{}, &CacheLimit{}, &Agent{}, &Secret{},
+		&Schedule{},
 	}
 }
 
@@ -3,10 +3,15 @@ package db
 import (
 	"context"
 	"time"
+
+	"github.com/cox96de/runner/api"
+	"github.com/samber/lo"
+	"gorm.io/gorm/clause"
 )
 
 type Pipeline struct {
 	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	Project   string    `gorm:"column:project"`
 	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
 }
@@ -15,24 +20,175 @@ func (p *Pipeline) TableName() string {
 	return "pipeline"
 }
 
-// CreatePipeline creates a new pipeline.
-func (c *Client) CreatePipeline(ctx context.Context) (*Pipeline, error) {
-	pipeline := &Pipeline{}
+// CreatePipeline creates a new pipeline in the project.
+func (c *Client) CreatePipeline(ctx context.Context, project string) (*Pipeline, error) {
+	pipeline := &Pipeline{Project: project}
 	if err := c.conn.WithContext(ctx).Create(pipeline).Error; err != nil {
 		return nil, err
 	}
 	return pipeline, nil
 }
 
+// GetPipeline returns a pipeline by its ID.
+func (c *Client) GetPipeline(ctx context.Context, id int64) (*Pipeline, error) {
+	pipeline := &Pipeline{}
+	if err := c.conn.WithContext(ctx).First(pipeline, id).Error; err != nil {
+		return nil, err
+	}
+	return pipeline, nil
+}
+
+// LockPipeline locks the pipeline until the transaction ends. It serializes dispatching of jobs in the pipeline,
+// so it should be called before jobs and executions of the pipeline are read in the transaction.
+// Rows are not locked in sqlite, whose transactions are serialized.
+func (c *Client) LockPipeline(ctx context.Context, id int64) error {
+	return c.conn.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").
+		First(&Pipeline{}, id).Error
+}
+
+type ListPipelinesOption struct {
+	// Cursor filters pipelines whose id is less than it. Zero means no filter.
+	Cursor        int64
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Limit         int
+}
+
+// ListPipelines returns pipelines sorted by id in descending order.
+func (c *Client) ListPipelines(ctx context.Context, option *ListPipelinesOption) ([]*Pipeline, error) {
+	query := c.conn.WithContext(ctx).Order("id desc")
+	if option.Cursor > 0 {
+		query = query.Where("id < ?", option.Cursor)
+	}
+	if option.CreatedAfter != nil {
+		query = query.Where("created_at > ?", *option.CreatedAfter)
+	}
+	if option.CreatedBefore != nil {
+		query = query.Where("created_at < ?", *option.CreatedBefore)
+	}
+	if option.Limit > 0 {
+		query = query.Limit(option.Limit)
+	}
+	var pipelines []*Pipeline
+	if err := query.Find(&pipelines).Error; err != nil {
+		return nil, err
+	}
+	return pipelines, nil
+}
+
 type PipelineExecution struct {
-	ID          int64     `gorm:"column:id;primaryKey;autoIncrement"`
-	PipelineID  int64     `gorm:"column:pipeline_id"`
-	StartedAt   time.Time `gorm:"column:started_at"`
-	CompletedAt time.Time `gorm:"column:completed_at"`
-	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
-	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime"`
+	ID         int64 `gorm:"column:id;primaryKey;autoIncrement"`
+	PipelineID int64 `gorm:"column:pipeline_id"`
+	// StartedAt and CompletedAt are not maintained, the time of a pipeline execution is aggregated from its job
+	// executions by AggregateJobTime.
+	StartedAt   *time.Time `gorm:"column:started_at"`
+	CompletedAt *time.Time `gorm:"column:completed_at"`
+	CreatedAt   time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at;autoUpdateTime"`
 }
 
 func (p *PipelineExecution) TableName() string {
 	return "pipeline_execution"
 }
+
+// CreatePipelineExecution creates a new pipeline execution.
+func (c *Client) CreatePipelineExecution(ctx context.Context, pipelineID int64) (*PipelineExecution, error) {
+	execution := &PipelineExecution{PipelineID: pipelineID}
+	if err := c.conn.WithContext(ctx).Create(execution).Error; err != nil {
+		return nil, err
+	}
+	return execution, nil
+}
+
+// GetPipelineExecutionsByPipelineIDs returns pipeline executions by pipeline id list. The result is grouped by
+// pipeline id.
+func (c *Client) GetPipelineExecutionsByPipelineIDs(ctx context.Context, pipelineIDs []int64) (map[int64][]*PipelineExecution, error) {
+	var executions []*PipelineExecution
+	if err := c.conn.WithContext(ctx).Find(&executions, "pipeline_id in ?", pipelineIDs).Error; err != nil {
+		return nil, err
+	}
+	return lo.GroupBy(executions, func(item *PipelineExecution) int64 {
+		return item.PipelineID
+	}), nil
+}
+
+// PackPipelineExecution packs a pipeline execution into api.PipelineExecution.
+// jobExecutions are the latest executions of all jobs in the pipeline, the status is aggregated from them.
+// execution can be nil for pipelines created before pipeline execution is introduced.
+func PackPipelineExecution(execution *PipelineExecution, jobExecutions []*JobExecution) (*api.PipelineExecution, error) {
+	p := &api.PipelineExecution{
+		Status: AggregateJobStatus(lo.Map(jobExecutions, func(item *JobExecution, _ int) api.Status {
+			return item.Status
+		})),
+	}
+	if execution != nil {
+		p.ID = execution.ID
+	}
+	startedAt, completedAt := AggregateJobTime(jobExecutions)
+	p.StartedAt = api.ConvertTime(startedAt)
+	p.CompletedAt = api.ConvertTime(completedAt)
+	for _, jobExecution := range jobExecutions {
+		e, err := PackJobExecution(jobExecution, nil)
+		if err != nil {
+			return nil, err
+		}
+		p.Jobs = append(p.Jobs, e)
+	}
+	return p, nil
+}
+
+// AggregateJobStatus aggregates the status of jobs into one status:
+//   - If all jobs are completed, it's failed if any job failed, skipped if all jobs are skipped, otherwise succeeded.
+//   - If all jobs are created, it's created.
+//   - If any job is canceling, it's canceling.
+//   - If any job is running or completed, it's running.
+//   - Otherwise, it's queued.
+func AggregateJobStatus(statuses []api.Status) api.Status {
+	if len(statuses) == 0 {
+		return api.UnknownStatus
+	}
+	if lo.EveryBy(statuses, api.Status.IsCompleted) {
+		switch {
+		case lo.Contains(statuses, api.StatusFailed):
+			return api.StatusFailed
+		case lo.Every([]api.Status{api.StatusSkipped}, statuses):
+			return api.StatusSkipped
+		default:
+			return api.StatusSucceeded
+		}
+	}
+	switch {
+	case lo.Every([]api.Status{api.StatusCreated}, statuses):
+		return api.StatusCreated
+	case lo.Contains(statuses, api.StatusCanceling):
+		return api.StatusCanceling
+	case lo.SomeBy(statuses, func(status api.Status) bool {
+		return status.IsRunning() || status.IsCompleted()
+	}):
+		return api.StatusRunning
+	default:
+		return api.StatusQueued
+	}
+}
+
+// AggregateJobTime aggregates the time of jobs. startedAt is the earliest started time of the jobs, it's nil if no
+// job is started. completedAt is the latest completed time of the jobs, it's nil unless all jobs are completed.
+func AggregateJobTime(jobExecutions []*JobExecution) (startedAt *time.Time, completedAt *time.Time) {
+	allCompleted := len(jobExecutions) > 0
+	for _, jobExecution := range jobExecutions {
+		if jobExecution.StartedAt != nil && (startedAt == nil || jobExecution.StartedAt.Before(*startedAt)) {
+			startedAt = jobExecution.StartedAt
+		}
+		if !jobExecution.Status.IsCompleted() {
+			allCompleted = false
+			continue
+		}
+		if jobExecution.CompletedAt != nil && (completedAt == nil || jobExecution.CompletedAt.After(*completedAt)) {
+			completedAt = jobExecution.CompletedAt
+		}
+	}
+	if !allCompleted {
+		completedAt = nil
+	}
+	return startedAt, completedAt
+}
@@ -3,14 +3,122 @@ package db
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/cox96de/runner/api"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"gotest.tools/v3/assert"
 )
 
 func TestClient_CreatePipeline(t *testing.T) {
 	db := NewMockDB(t, &Pipeline{})
-	createdPipeline, err := db.CreatePipeline(context.Background())
+	createdPipeline, err := db.CreatePipeline(context.Background(), "")
 	require.NoError(t, err)
 	assert.Assert(t, createdPipeline.ID > 0)
 }
+
+func TestClient_ListPipelines(t *testing.T) {
+	db := NewMockDB(t, &Pipeline{})
+	var pipelines []*Pipeline
+	for i := 0; i < 3; i++ {
+		p, err := db.CreatePipeline(context.Background(), "")
+		assert.NilError(t, err)
+		pipelines = append(pipelines, p)
+	}
+	t.Run("GetPipeline", func(t *testing.T) {
+		p, err := db.GetPipeline(context.Background(), pipelines[0].ID)
+		assert.NilError(t, err)
+		assert.Equal(t, p.ID, pipelines[0].ID)
+	})
+	t.Run("all", func(t *testing.T) {
+		listed, err := db.ListPipelines(context.Background(), &ListPipelinesOption{})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, listed, []*Pipeline{pipelines[2], pipelines[1], pipelines[0]})
+	})
+	t.Run("cursor", func(t *testing.T) {
+		listed, err := db.ListPipelines(context.Background(), &ListPipelinesOption{
+			Cursor: pipelines[2].ID,
+			Limit:  1,
+		})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, listed, []*Pipeline{pipelines[1]})
+	})
+	t.Run("created_time", func(t *testing.T) {
+		listed, err := db.ListPipelines(context.Background(), &ListPipelinesOption{
+			CreatedAfter:  lo.ToPtr(time.Now().Add(-time.Hour)),
+			CreatedBefore: lo.ToPtr(time.Now().Add(time.Hour)),
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, len(listed), 3)
+		listed, err = db.ListPipelines(context.Background(), &ListPipelinesOption{
+			CreatedBefore: lo.ToPtr(time.Now().Add(-time.Hour)),
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, len(listed), 0)
+	})
+}
+
+func TestClient_CreatePipelineExecution(t *testing.T) {
+	db := NewMockDB(t, &PipelineExecution{})
+	execution, err := db.CreatePipelineExecution(context.Background(), 1)
+	assert.NilError(t, err)
+	assert.Assert(t, execution.ID > 0)
+	executions, err := db.GetPipelineExecutionsByPipelineIDs(context.Background(), []int64{1, 2})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, executions, map[int64][]*PipelineExecution{1: {execution}})
+}
+
+func TestAggregateJobStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []api.Status
+		want     api.Status
+	}{
+		{name: "empty", statuses: nil, want: api.UnknownStatus},
+		{name: "created", statuses: []api.Status{api.StatusCreated, api.StatusCreated}, want: api.StatusCreated},
+		{name: "queued", statuses: []api.Status{api.StatusCreated, api.StatusQueued}, want: api.StatusQueued},
+		{name: "running", statuses: []api.Status{api.StatusCreated, api.StatusRunning}, want: api.StatusRunning},
+		{name: "partial_completed", statuses: []api.Status{api.StatusCreated, api.StatusSucceeded}, want: api.StatusRunning},
+		{name: "canceling", statuses: []api.Status{api.StatusRunning, api.StatusCanceling}, want: api.StatusCanceling},
+		{name: "failed", statuses: []api.Status{api.StatusSucceeded, api.StatusFailed, api.StatusSkipped}, want: api.StatusFailed},
+		{name: "skipped", statuses: []api.Status{api.StatusSkipped, api.StatusSkipped}, want: api.StatusSkipped},
+		{name: "succeeded", statuses: []api.Status{api.StatusSucceeded, api.StatusSkipped}, want: api.StatusSucceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, AggregateJobStatus(tt.statuses), tt.want)
+		})
+	}
+}
+
+func TestAggregateJobTime(t *testing.T) {
+	now := time.Now()
+	earlier, later := now.Add(-time.Minute), now.Add(time.Minute)
+	t.Run("running", func(t *testing.T) {
+		startedAt, completedAt := AggregateJobTime([]*JobExecution{
+			{Status: api.StatusSucceeded, StartedAt: &earlier, CompletedAt: &now},
+			{Status: api.StatusRunning, StartedAt: &now},
+			{Status: api.StatusCreated},
+		})
+		assert.DeepEqual(t, startedAt, &earlier)
+		assert.Assert(t, completedAt == nil)
+	})
+	t.Run("completed", func(t *testing.T) {
+		execution, err := PackPipelineExecution(&PipelineExecution{ID: 1}, []*JobExecution{
+			{Status: api.StatusSucceeded, StartedAt: &now, CompletedAt: &later},
+			{Status: api.StatusFailed, StartedAt: &earlier, CompletedAt: &now},
+			{Status: api.StatusSkipped, CompletedAt: &now},
+		})
+		assert.NilError(t, err)
+		assert.Assert(t, execution.StartedAt != nil)
+		assert.Assert(t, execution.CompletedAt != nil)
+		assert.Assert(t, execution.StartedAt.AsTime().Equal(earlier))
+		assert.Assert(t, execution.CompletedAt.AsTime().Equal(later))
+	})
+	t.Run("not_started", func(t *testing.T) {
+		startedAt, completedAt := AggregateJobTime([]*JobExecution{{Status: api.StatusQueued}})
+		assert.Assert(t, startedAt == nil)
+		assert.Assert(t, completedAt == nil)
+	})
+}
@@ -0,0 +1,199 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cox96de/runner/api"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+type Schedule struct {
+	ID       int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	Name     string `gorm:"column:name"`
+	Cron     string `gorm:"column:cron"`
+	Timezone string `gorm:"column:timezone"`
+	// Pipeline is the json of api.PipelineDSL which pipelines are created from.
+	Pipeline       []byte            `gorm:"column:pipeline"`
+	Enabled        bool              `gorm:"column:enabled"`
+	CatchUpPolicy  api.CatchUpPolicy `gorm:"column:catch_up_policy"`
+	NextRunAt      time.Time         `gorm:"column:next_run_at;index"`
+	LastRunAt      *time.Time        `gorm:"column:last_run_at"`
+	LastPipelineID int64             `gorm:"column:last_pipeline_id"`
+	CreatedAt      time.Time         `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt      time.Time         `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (s *Schedule) TableName() string {
+	return "schedule"
+}
+
+type CreateScheduleOption struct {
+	Name          string
+	Cron          string
+	Timezone      string
+	Pipeline      *api.PipelineDSL
+	Enabled       bool
+	CatchUpPolicy api.CatchUpPolicy
+	NextRunAt     time.Time
+}
+
+func (c *Client) CreateSchedule(ctx context.Context, option *CreateScheduleOption) (*Schedule, error) {
+	pipeline, err := json.Marshal(option.Pipeline)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to marshal pipeline")
+	}
+	schedule := &Schedule{
+		Name:          option.Name,
+		Cron:          option.Cron,
+		Timezone:      option.Timezone,
+		Pipeline:      pipeline,
+		Enabled:       option.Enabled,
+		CatchUpPolicy: option.CatchUpPolicy,
+		NextRunAt:     option.NextRunAt,
+	}
+	if err = c.conn.WithContext(ctx).Create(schedule).Error; err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// GetSchedule returns a schedule by its ID.
+func (c *Client) GetSchedule(ctx context.Context, id int64) (*Schedule, error) {
+	schedule := &Schedule{}
+	if err := c.conn.WithContext(ctx).First(schedule, id).Error; err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// ListSchedules returns all schedules sorted by id in ascending order.
+func (c *Client) ListSchedules(ctx context.Context) ([]*Schedule, error) {
+	var schedules []*Schedule
+	if err := c.conn.WithContext(ctx).Order("id").Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// ListDueSchedules returns enabled schedules whose next run is not after now.
+func (c *Client) ListDueSchedules(ctx context.Context, now time.Time) ([]*Schedule, error) {
+	var schedules []*Schedule
+	if err := c.conn.WithContext(ctx).Order("id").
+		Find(&schedules, "enabled = ? AND next_run_at <= ?", true, now).Error; err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+type UpdateScheduleOption struct {
+	ID            int64
+	Name          *string
+	Cron          *string
+	Timezone      *string
+	Pipeline      *api.PipelineDSL
+	Enabled       *bool
+	CatchUpPolicy *api.CatchUpPolicy
+	NextRunAt     *time.Time
+}
+
+// UpdateSchedule updates fields of a schedule which are set.
+func (c *Client) UpdateSchedule(ctx context.Context, option *UpdateScheduleOption) (*Schedule, error) {
+	schedule, err := c.GetSchedule(ctx, option.ID)
+	if err != nil {
+		return nil, err
+	}
+	if option.Name != nil {
+		schedule.Name = *option.Name
+	}
+	if option.Cron != nil {
+		schedule.Cron = *option.Cron
+	}
+	if option.Timezone != nil {
+		schedule.Timezone = *option.Timezone
+	}
+	if option.Pipeline != nil {
+		schedule.Pipeline, err = json.Marshal(option.Pipeline)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed to marshal pipeline")
+		}
+	}
+	if option.Enabled != nil {
+		schedule.Enabled = *option.Enabled
+	}
+	if option.CatchUpPolicy != nil {
+		schedule.CatchUpPolicy = *option.CatchUpPolicy
+	}
+	if option.NextRunAt != nil {
+		schedule.NextRunAt = *option.NextRunAt
+	}
+	return schedule, c.conn.WithContext(ctx).Save(schedule).Error
+}
+
+// AdvanceSchedule moves the next run of a schedule to next if it's still due at now.
+// It returns false if the schedule isn't due, e.g. the run is fired by others.
+func (c *Client) AdvanceSchedule(ctx context.Context, id int64, now time.Time, next time.Time) (bool, error) {
+	result := c.conn.WithContext(ctx).Model(&Schedule{}).
+		Where("id = ? AND enabled = ? AND next_run_at <= ?", id, true, now).
+		Update("next_run_at", next)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// RecordScheduleRun records the pipeline created by the run of a schedule at runAt.
+func (c *Client) RecordScheduleRun(ctx context.Context, id int64, runAt time.Time, pipelineID int64) error {
+	return c.conn.WithContext(ctx).Model(&Schedule{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"last_run_at":      runAt,
+		"last_pipeline_id": pipelineID,
+	}).Error
+}
+
+// DeleteSchedule deletes a schedule. It returns a record not found error if the schedule doesn't exist.
+func (c *Client) DeleteSchedule(ctx context.Context, id int64) error {
+	result := c.conn.WithContext(ctx).Delete(&Schedule{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// UnmarshalSchedulePipeline unmarshals the pipeline DSL of a schedule.
+func UnmarshalSchedulePipeline(body []byte) (*api.PipelineDSL, error) {
+	pipeline := &api.PipelineDSL{}
+	if err := json.Unmarshal(body, pipeline); err != nil {
+		return nil, err
+	}
+	return pipeline, nil
+}
+
+func PackSchedule(s *Schedule) (*api.Schedule, error) {
+	pipeline, err := UnmarshalSchedulePipeline(s.Pipeline)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to unmarshal pipeline")
+	}
+	p := &api.Schedule{
+		ID:             s.ID,
+		Name:           s.Name,
+		Cron:           s.Cron,
+		Timezone:       s.Timezone,
+		Pipeline:       pipeline,
+		Enabled:        s.Enabled,
+		CatchUpPolicy:  s.CatchUpPolicy,
+		NextRunAt:      timestamppb.New(s.NextRunAt),
+		LastPipelineID: s.LastPipelineID,
+		CreatedAt:      timestamppb.New(s.CreatedAt),
+		UpdatedAt:      timestamppb.New(s.UpdatedAt),
+	}
+	if s.LastRunAt != nil {
+		p.LastRunAt = timestamppb.New(*s.LastRunAt)
+	}
+	return p, nil
+}
@@ -0,0 +1,135 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/cox96de/runner/api"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+type Secret struct {
+	ID int64 `gorm:"column:id;primaryKey;autoIncrement"`
+	// A secret is unique in its scope. The name leads the index, so secrets are looked up by names with it.
+	Name    string `gorm:"column:name;index:idx_secret_scope,unique,priority:1"`
+	Project string `gorm:"column:project;index:idx_secret_scope,unique,priority:2"`
+	Label   string `gorm:"column:label;index:idx_secret_scope,unique,priority:3"`
+	// Value is the encrypted value of the secret.
+	Value     []byte    `gorm:"column:value"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (s *Secret) TableName() string {
+	return "secret"
+}
+
+type CreateSecretOption struct {
+	Name    string
+	Project string
+	Label   string
+	Value   []byte
+}
+
+// CreateSecret creates a secret, the value should be encrypted.
+// It returns a duplicate key error if a secret with the same name exists in the scope.
+func (c *Client) CreateSecret(ctx context.Context, option *CreateSecretOption) (*Secret, error) {
+	secret := &Secret{
+		Name:    option.Name,
+		Project: option.Project,
+		Label:   option.Label,
+		Value:   option.Value,
+	}
+	if err := c.conn.WithContext(ctx).Create(secret).Error; err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// GetSecret returns a secret by its ID.
+func (c *Client) GetSecret(ctx context.Context, id int64) (*Secret, error) {
+	secret := &Secret{}
+	if err := c.conn.WithContext(ctx).First(secret, id).Error; err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// GetSecretByScope returns the secret with the name in the scope exactly.
+func (c *Client) GetSecretByScope(ctx context.Context, name string, project string, label string) (*Secret, error) {
+	secret := &Secret{}
+	if err := c.conn.WithContext(ctx).
+		First(secret, "name = ? AND project = ? AND label = ?", name, project, label).Error; err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+type ListSecretsOption struct {
+	// Project filters secrets scoped to the project exactly. Empty means no filter.
+	Project string
+	// Label filters secrets scoped to the label exactly. Empty means no filter.
+	Label string
+}
+
+// ListSecrets returns secrets sorted by id in ascending order.
+func (c *Client) ListSecrets(ctx context.Context, option *ListSecretsOption) ([]*Secret, error) {
+	query := c.conn.WithContext(ctx).Order("id")
+	if option.Project != "" {
+		query = query.Where("project = ?", option.Project)
+	}
+	if option.Label != "" {
+		query = query.Where("label = ?", option.Label)
+	}
+	var secrets []*Secret
+	if err := query.Find(&secrets).Error; err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
+
+// GetSecretsByNames returns secrets with the names in all scopes.
+func (c *Client) GetSecretsByNames(ctx context.Context, names []string) ([]*Secret, error) {
+	var secrets []*Secret
+	if err := c.conn.WithContext(ctx).Order("id").Find(&secrets, "name IN ?", names).Error; err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
+
+// UpdateSecretValue replaces the value of a secret. It returns a record not found error if the secret doesn't exist.
+func (c *Client) UpdateSecretValue(ctx context.Context, id int64, value []byte) error {
+	result := c.conn.WithContext(ctx).Model(&Secret{}).Where("id = ?", id).Update("value", value)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// DeleteSecret deletes a secret. It returns a record not found error if the secret doesn't exist.
+func (c *Client) DeleteSecret(ctx context.Context, id int64) error {
+	result := c.conn.WithContext(ctx).Delete(&Secret{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// PackSecret packs a secret into api.Secret, the value is never packed.
+func PackSecret(s *Secret) *api.Secret {
+	return &api.Secret{
+		ID:        s.ID,
+		Name:      s.Name,
+		Project:   s.Project,
+		Label:     s.Label,
+		CreatedAt: timestamppb.New(s.CreatedAt),
+		UpdatedAt: timestamppb.New(s.UpdatedAt),
+	}
+}
@@ -24,6 +24,10 @@ type Step struct {
 	EnvVar           []byte    `gorm:"column:env_var"`
 	DependsOn        []byte    `gorm:"column:depends_on"`
 	Script           string    `gorm:"column:script"`
+	If               string    `gorm:"column:if_expression"`
+	Timeout          int32     `gorm:"column:timeout"`
+	Retry            []byte    `gorm:"column:retry"`
+	Artifacts        []byte    `gorm:"column:artifacts"`
 	CreatedAt        time.Time `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt        time.Time `gorm:"column:updated_at;autoUpdateTime"`
 }
@@ -43,6 +47,10 @@ type CreateStepOption struct {
 	DependsOn        []string
 	Commands         []string
 	Script           string
+	If               string
+	Timeout          int32
+	Retry            *api.Retry
+	Artifacts        []*api.ArtifactUpload
 }
 
 // CreateSteps creates new steps.
@@ -57,6 +65,8 @@ func (c *Client) CreateSteps(ctx context.Context, options []*CreateStepOption) (
 			WorkingDirectory: option.WorkingDirectory,
 			Container:        option.Container,
 			Script:           option.Script,
+			If:               option.If,
+			Timeout:          option.Timeout,
 		}
 		var err error
 		step.EnvVar, err = json.Marshal(option.EnvVar)
@@ -71,6 +81,18 @@ func (c *Client) CreateSteps(ctx context.Context, options []*CreateStepOption) (
 		if err != nil {
 			return nil, errors.WithMessage(err, "failed to marshal step.Commands")
 		}
+		if option.Retry != nil {
+			step.Retry, err = json.Marshal(option.Retry)
+			if err != nil {
+				return nil, errors.WithMessage(err, "failed to marshal step.Retry")
+			}
+		}
+		if len(option.Artifacts) > 0 {
+			step.Artifacts, err = json.Marshal(option.Artifacts)
+			if err != nil {
+				return nil, errors.WithMessage(err, "failed to marshal step.Artifacts")
+			}
+		}
 		steps = append(steps, step)
 	}
 	if err := c.conn.WithContext(ctx).Create(steps).Error; err != nil {
@@ -97,6 +119,15 @@ func (c *Client) GetStepsByJobID(ctx context.Context, jobID int64) ([]*Step, err
 	return steps, nil
 }
 
+// GetStepsByPipelineID returns all steps of the pipeline.
+func (c *Client) GetStepsByPipelineID(ctx context.Context, pipelineID int64) ([]*Step, error) {
+	var steps []*Step
+	if err := c.conn.WithContext(ctx).Find(&steps, "pipeline_id = ?", pipelineID).Error; err != nil {
+		return nil, err
+	}
+	return steps, nil
+}
+
 func packSteps(steps []*Step, executions []*StepExecution) ([]*api.Step, error) {
 	executionsByStepID := lo.GroupBy(executions, func(item *StepExecution) int64 {
 		return item.StepID
@@ -123,6 +154,8 @@ func PackStep(step *Step, executions []*StepExecution) (*api.Step, error) {
 		User:             step.User,
 		Container:        step.Container,
 		Script:           step.Script,
+		If:               step.If,
+		Timeout:          step.Timeout,
 		CreatedAt:        api.ConvertTime(step.CreatedAt),
 		UpdatedAt:        api.ConvertTime(step.UpdatedAt),
 	}
@@ -141,9 +174,23 @@ func PackStep(step *Step, executions []*StepExecution) (*api.Step, error) {
 			return nil, errors.WithMessage(err, "failed to unmarshal step.DependsOn")
 		}
 	}
-	s.Executions = lo.Map(executions, func(e *StepExecution, i int) *api.StepExecution {
-		return PackStepExecution(e)
-	})
+	if len(step.Retry) > 0 {
+		if err := json.Unmarshal(step.Retry, &s.Retry); err != nil {
+			return nil, errors.WithMessage(err, "failed to unmarshal step.Retry")
+		}
+	}
+	if len(step.Artifacts) > 0 {
+		if err := json.Unmarshal(step.Artifacts, &s.Artifacts); err != nil {
+			return nil, errors.WithMessage(err, "failed to unmarshal step.Artifacts")
+		}
+	}
+	for _, execution := range executions {
+		packStepExecution, err := PackStepExecution(execution)
+		if err != nil {
+			return nil, err
+		}
+		s.Executions = append(s.Executions, packStepExecution)
+	}
 	if len(s.Executions) == 0 {
 		return nil, errors.Errorf("no executions found")
 	}
@@ -152,18 +199,25 @@ func PackStep(step *Step, executions []*StepExecution) (*api.Step, error) {
 }
 
 // PackStepExecution packs a step execution into api.StepExecution.
-func PackStepExecution(s *StepExecution) *api.StepExecution {
-	return &api.StepExecution{
+func PackStepExecution(s *StepExecution) (*api.StepExecution, error) {
+	p := &api.StepExecution{
 		ID:             s.ID,
 		JobExecutionID: s.JobExecutionID,
 		StepID:         s.StepID,
 		Status:         s.Status,
 		ExitCode:       s.ExitCode,
+		Attempt:        s.Attempt,
 		StartedAt:      api.ConvertTime(s.StartedAt),
 		CompletedAt:    api.ConvertTime(s.CompletedAt),
 		CreatedAt:      api.ConvertTime(s.CreatedAt),
 		UpdatedAt:      api.ConvertTime(s.UpdatedAt),
 	}
+	if len(s.Reason) > 0 {
+		if err := json.Unmarshal(s.Reason, &p.Reason); err != nil {
+			return nil, errors.WithMessage(err, "failed to unmarshal step execution reason")
+		}
+	}
+	return p, nil
 }
 
 type StepExecution struct {
@@ -172,6 +226,8 @@ type StepExecution struct {
 	StepID         int64      `gorm:"column:step_id"`
 	Status         api.Status `gorm:"column:status"`
 	ExitCode       uint32     `gorm:"column:exit_code"`
+	Reason         []byte     `gorm:"column:reason"`
+	Attempt        int32      `gorm:"column:attempt"`
 	StartedAt      *time.Time `gorm:"column:started_at"`
 	CompletedAt    *time.Time `gorm:"column:completed_at"`
 	CreatedAt      time.Time  `gorm:"column:created_at;autoCreateTime"`
@@ -186,6 +242,8 @@ type CreateStepExecutionOption struct {
 	JobExecutionID int64
 	StepID         int64
 	Status         api.Status
+	// Attempt is the sequence number of the step execution in the job execution, starts from 1.
+	Attempt int32
 }
 
 // CreateStepExecutions creates new step executions.
@@ -197,6 +255,7 @@ func (c *Client) CreateStepExecutions(ctx context.Context, options []*CreateStep
 			JobExecutionID: option.JobExecutionID,
 			StepID:         option.StepID,
 			Status:         option.Status,
+			Attempt:        option.Attempt,
 		}
 		executions = append(executions, execution)
 	}
@@ -227,7 +286,7 @@ func (c *Client) GetStepExecutionsByJobExecutionID(ctx context.Context, jobExecu
 // GetStepExecutionsByJobExecutionIDs returns step executions by job execution id list.
 func (c *Client) GetStepExecutionsByJobExecutionIDs(ctx context.Context, jobExecutionIDs []int64) (map[int64][]*StepExecution, error) {
 	var steps []*StepExecution
-	if err := c.conn.WithContext(ctx).Find(&steps, "job_execution_id in ?", jobExecutionIDs).Error; err != nil {
+	if err := c.conn.WithContext(ctx).Order("id").Find(&steps, "job_execution_id in ?", jobExecutionIDs).Error; err != nil {
 		return nil, err
 	}
 	return lo.GroupBy(steps, func(item *StepExecution) int64 {
@@ -239,6 +298,7 @@ type UpdateStepExecutionOption struct {
 	ID          int64
 	Status      *api.Status
 	ExitCode    *uint32
+	Reason      *api.Reason
 	StartedAt   *time.Time
 	CompletedAt *time.Time
 }
@@ -255,6 +315,13 @@ func (c *Client) UpdateStepExecution(ctx context.Context, option *UpdateStepExec
 	if option.ExitCode != nil {
 		stepExecution.ExitCode = *option.ExitCode
 	}
+	if option.Reason != nil {
+		bys, err := json.Marshal(option.Reason)
+		if err != nil {
+			return nil, errors.WithMessage(err, "failed to marshal reason")
+		}
+		stepExecution.Reason = bys
+	}
 	if option.StartedAt != nil {
 		stepExecution.StartedAt = option.StartedAt
 	}
@@ -264,12 +331,3 @@ func (c *Client) UpdateStepExecution(ctx context.Context, option *UpdateStepExec
 	err = c.conn.WithContext(ctx).Model(&stepExecution).Where("id = ?", option.ID).Save(stepExecution).Error
 	return stepExecution, err
 }
-
-func (c *Client) ResetStepExecutions(ctx context.Context, stepExecutionIDs []int64) error {
-	return c.conn.WithContext(ctx).Model(&StepExecution{}).Where("id in ?", stepExecutionIDs).Updates(map[string]interface{}{
-		"status":       api.StatusCreated,
-		"exit_code":    0,
-		"started_at":   nil,
-		"completed_at": nil,
-	}).Error
-}
@@ -0,0 +1,351 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Function is a function which can be called in expressions.
+type Function func(args ...interface{}) (interface{}, error)
+
+// Context is the context used to evaluate an expression.
+type Context struct {
+	// Variables are the root variables, such as `env` and `steps`.
+	// Values can be nil, bool, string, integers, map[string]T or []T.
+	Variables map[string]interface{}
+	// Functions are the functions can be called in expressions.
+	Functions map[string]Function
+}
+
+// Evaluate evaluates the expression with the given context.
+func (e *Expression) Evaluate(ctx *Context) (interface{}, error) {
+	if ctx == nil {
+		ctx = &Context{}
+	}
+	v, err := evaluate(e.root, ctx)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to evaluate expression '%s'", e.source)
+	}
+	return v, nil
+}
+
+// EvaluateBool evaluates the expression and converts the result to bool by IsTruthy.
+func (e *Expression) EvaluateBool(ctx *Context) (bool, error) {
+	v, err := e.Evaluate(ctx)
+	if err != nil {
+		return false, err
+	}
+	return IsTruthy(v), nil
+}
+
+func evaluate(n node, ctx *Context) (interface{}, error) {
+	switch v := n.(type) {
+	case *literalNode:
+		return v.value, nil
+	case *identNode:
+		value, ok := ctx.Variables[v.name]
+		if !ok {
+			return nil, errors.Errorf("unknown variable '%s'", v.name)
+		}
+		value, err := normalize(value)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "invalid variable '%s'", v.name)
+		}
+		return value, nil
+	case *propertyNode:
+		target, err := evaluate(v.target, ctx)
+		if err != nil {
+			return nil, err
+		}
+		property, err := evaluate(v.property, ctx)
+		if err != nil {
+			return nil, err
+		}
+		return index(target, property)
+	case *callNode:
+		fn, ok := ctx.Functions[v.name]
+		if !ok {
+			return nil, errors.Errorf("unknown function '%s'", v.name)
+		}
+		args := make([]interface{}, 0, len(v.args))
+		for _, arg := range v.args {
+			a, err := evaluate(arg, ctx)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, a)
+		}
+		result, err := fn(args...)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to call '%s'", v.name)
+		}
+		result, err = normalize(result)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "invalid result of '%s'", v.name)
+		}
+		return result, nil
+	case *notNode:
+		operand, err := evaluate(v.operand, ctx)
+		if err != nil {
+			return nil, err
+		}
+		return !IsTruthy(operand), nil
+	case *binaryNode:
+		left, err := evaluate(v.left, ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Short circuit.
+		switch v.op {
+		case "&&":
+			if !IsTruthy(left) {
+				return left, nil
+			}
+			return evaluate(v.right, ctx)
+		case "||":
+			if IsTruthy(left) {
+				return left, nil
+			}
+			return evaluate(v.right, ctx)
+		}
+		right, err := evaluate(v.right, ctx)
+		if err != nil {
+			return nil, err
+		}
+		return compare(v.op, left, right)
+	}
+	return nil, errors.Errorf("unknown node %T", n)
+}
+
+// normalize converts all integers to int64. It returns an error with v as is if v overflows int64.
+func normalize(v interface{}) (interface{}, error) {
+	switch i := v.(type) {
+	case int:
+		return int64(i), nil
+	case int32:
+		return int64(i), nil
+	case uint32:
+		return int64(i), nil
+	case uint64:
+		if i > math.MaxInt64 {
+			return v, errors.Errorf("integer %d overflows int64", i)
+		}
+		return int64(i), nil
+	}
+	return v, nil
+}
+
+// index returns target[property]. It returns nil if property is not found.
+func index(target interface{}, property interface{}) (interface{}, error) {
+	if target == nil {
+		return nil, nil
+	}
+	rv := reflect.ValueOf(target)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, nil
+		}
+		key, ok := property.(string)
+		if !ok {
+			return nil, nil
+		}
+		value := rv.MapIndex(reflect.ValueOf(key).Convert(rv.Type().Key()))
+		if !value.IsValid() {
+			return nil, nil
+		}
+		return normalize(value.Interface())
+	case reflect.Slice, reflect.Array:
+		i, ok := property.(int64)
+		if !ok || i < 0 || int(i) >= rv.Len() {
+			return nil, nil
+		}
+		return normalize(rv.Index(int(i)).Interface())
+	}
+	return nil, nil
+}
+
+func compare(op string, left, right interface{}) (bool, error) {
+	switch op {
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	}
+	l, lok := toNumber(left)
+	r, rok := toNumber(right)
+	if !lok || !rok {
+		return false, errors.Errorf("operator '%s' is not supported between %s and %s", op, typeName(left),
+			typeName(right))
+	}
+	switch op {
+	case "<":
+		return l < r, nil
+	case "<=":
+		return l <= r, nil
+	case ">":
+		return l > r, nil
+	case ">=":
+		return l >= r, nil
+	}
+	return false, errors.Errorf("unknown operator '%s'", op)
+}
+
+// equal compares two values. Strings are compared case-insensitively. Numbers are compared with strings
+// by converting the string to number.
+func equal(left, right interface{}) bool {
+	if left == nil || right == nil {
+		return left == nil && right == nil
+	}
+	if ls, ok := left.(string); ok {
+		if rs, ok := right.(string); ok {
+			return strings.EqualFold(ls, rs)
+		}
+	}
+	if lb, ok := left.(bool); ok {
+		rb, ok := right.(bool)
+		return ok && lb == rb
+	}
+	if _, ok := right.(bool); ok {
+		return false
+	}
+	l, lok := toNumber(left)
+	r, rok := toNumber(right)
+	if lok && rok {
+		return l == r
+	}
+	return reflect.DeepEqual(left, right)
+}
+
+func toNumber(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return n, true
+	case string:
+		i, err := strconv.ParseInt(strings.TrimSpace(n), 10, 64)
+		return i, err == nil
+	}
+	return 0, false
+}
+
+func typeName(v interface{}) string {
+	if v == nil {
+		return "null"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// IsTruthy reports whether v is considered as true.
+// false, null, 0 and empty string are false, others are true.
+func IsTruthy(v interface{}) bool {
+	// An integer overflows int64 is not zero, so it's true.
+	v, _ = normalize(v)
+	switch b := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return b
+	case string:
+		return b != ""
+	case int64:
+		return b != 0
+	}
+	return true
+}
+
+// BuiltinFunctions returns common functions for string operation:
+//   - contains(search, item): reports whether search contains item.
+//   - startsWith(s, prefix), endsWith(s, suffix).
+//   - format('{0}-{1}', a, b): replaces {N} with the N-th argument.
+func BuiltinFunctions() map[string]Function {
+	return map[string]Function{
+		"contains": func(args ...interface{}) (interface{}, error) {
+			if len(args) != 2 {
+				return nil, errors.Errorf("expect 2 arguments but got %d", len(args))
+			}
+			rv := reflect.ValueOf(args[0])
+			if args[0] != nil && (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) {
+				for i := 0; i < rv.Len(); i++ {
+					item, err := normalize(rv.Index(i).Interface())
+					if err != nil {
+						return nil, err
+					}
+					if equal(item, args[1]) {
+						return true, nil
+					}
+				}
+				return false, nil
+			}
+			return strings.Contains(strings.ToLower(ToString(args[0])), strings.ToLower(ToString(args[1]))), nil
+		},
+		"startsWith": func(args ...interface{}) (interface{}, error) {
+			if len(args) != 2 {
+				return nil, errors.Errorf("expect 2 arguments but got %d", len(args))
+			}
+			return strings.HasPrefix(strings.ToLower(ToString(args[0])), strings.ToLower(ToString(args[1]))), nil
+		},
+		"endsWith": func(args ...interface{}) (interface{}, error) {
+			if len(args) != 2 {
+				return nil, errors.Errorf("expect 2 arguments but got %d", len(args))
+			}
+			return strings.HasSuffix(strings.ToLower(ToString(args[0])), strings.ToLower(ToString(args[1]))), nil
+		},
+		"format": func(args ...interface{}) (interface{}, error) {
+			if len(args) == 0 {
+				return nil, errors.New("expect at least 1 argument")
+			}
+			return format(ToString(args[0]), args[1:]), nil
+		},
+	}
+}
+
+// ToString converts an evaluated value to string.
+func ToString(v interface{}) string {
+	n, _ := normalize(v)
+	switch s := n.(type) {
+	case nil:
+		return ""
+	case string:
+		return s
+	case bool:
+		return strconv.FormatBool(s)
+	case int64:
+		return strconv.FormatInt(s, 10)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// format replaces {N} in s with the N-th argument. s is scanned once, so placeholders in arguments are kept as is.
+// Placeholders without corresponding argument are kept as is too.
+func format(s string, args []interface{}) string {
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(s, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(s[start:], '}')
+		if end < 0 {
+			break
+		}
+		end += start
+		placeholder := s[start+1 : end]
+		i, err := strconv.Atoi(placeholder)
+		if err != nil || i < 0 || i >= len(args) || strconv.Itoa(i) != placeholder {
+			// Not a placeholder, keep the '{' and scan from the next character.
+			b.WriteString(s[:start+1])
+			s = s[start+1:]
+			continue
+		}
+		b.WriteString(s[:start])
+		b.WriteString(ToString(args[i]))
+		s = s[end+1:]
+	}
+	b.WriteString(s)
+	return b.String()
+}
@@ -0,0 +1,137 @@
+package expr
+
+import (
+	"math"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		expression  string
+		expectError bool
+	}{
+		{name: "function", expression: "success()"},
+		{name: "compare", expression: "env.BRANCH == 'main'"},
+		{name: "index", expression: "steps['build-1'].exit_code != 0"},
+		{name: "logic", expression: "!(failure() || always()) && true"},
+		{name: "args", expression: "contains(env.BRANCH, \"release\")"},
+		{name: "unterminated_string", expression: "env.BRANCH == 'main", expectError: true},
+		{name: "unterminated_paren", expression: "(success()", expectError: true},
+		{name: "bad_operator", expression: "env.A = 'b'", expectError: true},
+		{name: "trailing", expression: "success() success()", expectError: true},
+		{name: "empty", expression: "", expectError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.expression)
+			if tt.expectError {
+				assert.Assert(t, err != nil)
+				return
+			}
+			assert.NilError(t, err)
+		})
+	}
+}
+
+func TestExpression_Functions(t *testing.T) {
+	e, err := Parse("success() && contains(env.A, format('{0}', 'b'))")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, e.Functions(), []string{"success", "contains", "format"})
+}
+
+func TestExpression_Variables(t *testing.T) {
+	e, err := Parse("env.A == 'b' && steps.build.status == 'failed' || steps['test-1'].exit_code > 0 || steps[env.B]")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, e.Variables(), [][]string{
+		{"env", "A"},
+		{"steps", "build", "status"},
+		{"steps", "test-1", "exit_code"},
+		{"env", "B"},
+	})
+}
+
+func TestExpression_Evaluate(t *testing.T) {
+	ctx := &Context{
+		Variables: map[string]interface{}{
+			"env": map[string]string{
+				"BRANCH": "main",
+				"COUNT":  "3",
+			},
+			"steps": map[string]interface{}{
+				"build": map[string]interface{}{
+					"status":    "failed",
+					"exit_code": uint32(2),
+					"size":      uint64(math.MaxInt64 + 1),
+				},
+			},
+			"list": []string{"a", "b"},
+			"big":  uint64(math.MaxUint64),
+		},
+		Functions: BuiltinFunctions(),
+	}
+	ctx.Functions["yes"] = func(args ...interface{}) (interface{}, error) {
+		return true, nil
+	}
+	tests := []struct {
+		expression  string
+		expect      interface{}
+		expectError bool
+	}{
+		{expression: "env.BRANCH == 'main'", expect: true},
+		{expression: "env.BRANCH == 'MAIN'", expect: true},
+		{expression: "env.BRANCH != 'main'", expect: false},
+		{expression: "env.NOT_EXIST", expect: nil},
+		{expression: "env.NOT_EXIST == null", expect: true},
+		{expression: "env.COUNT > 2", expect: true},
+		{expression: "env.COUNT <= 2", expect: false},
+		{expression: "steps.build.exit_code != 0", expect: true},
+		{expression: "steps.build.exit_code == 2", expect: true},
+		{expression: "steps['build'].status", expect: "failed"},
+		{expression: "steps.test.status", expect: nil},
+		{expression: "list[1]", expect: "b"},
+		{expression: "list[2]", expect: nil},
+		{expression: "yes() && !false", expect: true},
+		{expression: "false || 'fallback'", expect: "fallback"},
+		{expression: "contains(env.BRANCH, 'ai')", expect: true},
+		{expression: "contains(list, 'a')", expect: true},
+		{expression: "startsWith(env.BRANCH, 'ma') && endsWith(env.BRANCH, 'in')", expect: true},
+		{expression: "format('{0}-{1}', env.BRANCH, 1)", expect: "main-1"},
+		{expression: "format('{0}{1}', '{1}', 'x')", expect: "{1}x"},
+		{expression: "format('{{0}}-{1}-{01}-{2}', 'a', 'b')", expect: "{a}-b-{01}-{2}"},
+		{expression: "big", expectError: true},
+		{expression: "steps.build.size", expectError: true},
+		{expression: "true == 1", expect: false},
+		{expression: "unknown.a", expectError: true},
+		{expression: "unknown()", expectError: true},
+		{expression: "env.BRANCH > 1", expectError: true},
+		{expression: "contains('a')", expectError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			e, err := Parse(tt.expression)
+			assert.NilError(t, err)
+			result, err := e.Evaluate(ctx)
+			if tt.expectError {
+				assert.Assert(t, err != nil)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, result, tt.expect)
+		})
+	}
+}
+
+func TestIsTruthy(t *testing.T) {
+	assert.Assert(t, !IsTruthy(nil))
+	assert.Assert(t, !IsTruthy(false))
+	assert.Assert(t, !IsTruthy(""))
+	assert.Assert(t, !IsTruthy(0))
+	assert.Assert(t, IsTruthy(true))
+	assert.Assert(t, IsTruthy("a"))
+	assert.Assert(t, IsTruthy(int64(1)))
+	assert.Assert(t, IsTruthy(uint64(math.MaxUint64)))
+	assert.Assert(t, IsTruthy(map[string]string{}))
+}
@@ -0,0 +1,494 @@
+// Package expr implements a small expression language, it is used to evaluate conditions such as
+// `success() && env.BRANCH == 'main'`.
+//
+// The language supports:
+//   - literals: strings ('single' or "double" quoted), integers, true, false and null.
+//   - property access: env.BRANCH, steps.build.exit_code, steps['build-1'].status.
+//   - function calls: success(), contains(env.BRANCH, 'release').
+//   - operators: !, ==, !=, <, <=, >, >=, && and ||, grouped by parentheses.
+package expr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+	tokenLeftBracket
+	tokenRightBracket
+	tokenDot
+	tokenComma
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLeftParen, value: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRightParen, value: ")", pos: i})
+			i++
+		case c == '[':
+			tokens = append(tokens, token{kind: tokenLeftBracket, value: "[", pos: i})
+			i++
+		case c == ']':
+			tokens = append(tokens, token{kind: tokenRightBracket, value: "]", pos: i})
+			i++
+		case c == '.':
+			tokens = append(tokens, token{kind: tokenDot, value: ".", pos: i})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{kind: tokenComma, value: ",", pos: i})
+			i++
+		case c == '\'' || c == '"':
+			end := i + 1
+			b := &strings.Builder{}
+			for ; end < len(s); end++ {
+				if s[end] == '\\' && end+1 < len(s) {
+					end++
+					b.WriteByte(s[end])
+					continue
+				}
+				if s[end] == c {
+					break
+				}
+				b.WriteByte(s[end])
+			}
+			if end >= len(s) {
+				return nil, errors.Errorf("unterminated string at %d", i)
+			}
+			tokens = append(tokens, token{kind: tokenString, value: b.String(), pos: i})
+			i = end + 1
+		case c >= '0' && c <= '9' || c == '-' && i+1 < len(s) && s[i+1] >= '0' && s[i+1] <= '9':
+			end := i + 1
+			for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, value: s[i:end], pos: i})
+			i = end
+		case isIdentStart(c):
+			end := i + 1
+			for end < len(s) && isIdentPart(s[end]) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, value: s[i:end], pos: i})
+			i = end
+		default:
+			op := ""
+			for _, candidate := range []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!"} {
+				if strings.HasPrefix(s[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, errors.Errorf("unexpected character '%c' at %d", c, i)
+			}
+			tokens = append(tokens, token{kind: tokenOperator, value: op, pos: i})
+			i += len(op)
+		}
+	}
+	tokens = append(tokens, token{kind: tokenEOF, pos: len(s)})
+	return tokens, nil
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || c == '-' || (c >= '0' && c <= '9')
+}
+
+// Expression is a parsed expression.
+type Expression struct {
+	source string
+	root   node
+}
+
+// Parse parses s into an Expression.
+func Parse(s string) (*Expression, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to parse expression '%s'", s)
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to parse expression '%s'", s)
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, errors.Errorf("failed to parse expression '%s': unexpected '%s' at %d", s, t.value, t.pos)
+	}
+	return &Expression{source: s, root: root}, nil
+}
+
+// String returns the source of the expression.
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Functions returns names of all functions called in the expression.
+func (e *Expression) Functions() []string {
+	var names []string
+	walk(e.root, func(n node) {
+		if c, ok := n.(*callNode); ok {
+			names = append(names, c.name)
+		}
+	})
+	return names
+}
+
+// Variables returns all variable references in the expression. Each reference is returned as a path,
+// e.g. `steps.build.status` returns []string{"steps", "build", "status"}.
+// Only the constant part of the path is returned if the path contains a dynamic index.
+func (e *Expression) Variables() [][]string {
+	var paths [][]string
+	var visit func(n node) []string
+	visit = func(n node) []string {
+		switch v := n.(type) {
+		case *identNode:
+			return []string{v.name}
+		case *propertyNode:
+			path := visit(v.target)
+			if lit, ok := v.property.(*literalNode); ok && path != nil {
+				if s, ok := lit.value.(string); ok {
+					return append(path, s)
+				}
+			}
+			return nil
+		}
+		return nil
+	}
+	walk(e.root, func(n node) {
+		switch v := n.(type) {
+		case *identNode:
+			paths = append(paths, []string{v.name})
+		case *propertyNode:
+			if path := visit(v); path != nil {
+				paths = append(paths, path)
+			}
+		}
+	})
+	return longestPaths(paths)
+}
+
+// longestPaths removes paths which are prefix of other paths.
+func longestPaths(paths [][]string) [][]string {
+	result := make([][]string, 0, len(paths))
+	for i, p := range paths {
+		isPrefix := false
+		for j, other := range paths {
+			if i == j || len(other) <= len(p) {
+				continue
+			}
+			if strings.Join(other[:len(p)], ".") == strings.Join(p, ".") {
+				isPrefix = true
+				break
+			}
+		}
+		if !isPrefix {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) expect(kind tokenKind, value string) error {
+	t := p.next()
+	if t.kind != kind {
+		if t.kind == tokenEOF {
+			return errors.Errorf("expect '%s' but got end of expression", value)
+		}
+		return errors.Errorf("expect '%s' but got '%s' at %d", value, t.value, t.pos)
+	}
+	return nil
+}
+
+func (p *parser) isOperator(ops ...string) (string, bool) {
+	t := p.peek()
+	if t.kind != tokenOperator {
+		return "", false
+	}
+	for _, op := range ops {
+		if t.value == op {
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.isOperator("||"); !ok {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: "||", left: left, right: right}
+	}
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.isOperator("&&"); !ok {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: "&&", left: left, right: right}
+	}
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.isOperator("==", "!=", "<", "<=", ">", ">=")
+		if !ok {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: op, left: left, right: right}
+	}
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if _, ok := p.isOperator("!"); ok {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{operand: operand}, nil
+	}
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() (node, error) {
+	n, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch p.peek().kind {
+		case tokenDot:
+			p.next()
+			t := p.next()
+			if t.kind != tokenIdent {
+				return nil, errors.Errorf("expect property name after '.' at %d", t.pos)
+			}
+			n = &propertyNode{target: n, property: &literalNode{value: t.value}}
+		case tokenLeftBracket:
+			p.next()
+			index, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err = p.expect(tokenRightBracket, "]"); err != nil {
+				return nil, err
+			}
+			n = &propertyNode{target: n, property: index}
+		default:
+			return n, nil
+		}
+	}
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenString:
+		return &literalNode{value: t.value}, nil
+	case tokenNumber:
+		i, err := strconv.ParseInt(t.value, 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid number '%s' at %d", t.value, t.pos)
+		}
+		return &literalNode{value: i}, nil
+	case tokenLeftParen:
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect(tokenRightParen, ")"); err != nil {
+			return nil, err
+		}
+		return n, nil
+	case tokenIdent:
+		switch t.value {
+		case "true":
+			return &literalNode{value: true}, nil
+		case "false":
+			return &literalNode{value: false}, nil
+		case "null":
+			return &literalNode{value: nil}, nil
+		}
+		if p.peek().kind != tokenLeftParen {
+			return &identNode{name: t.value}, nil
+		}
+		p.next()
+		call := &callNode{name: t.value}
+		if p.peek().kind == tokenRightParen {
+			p.next()
+			return call, nil
+		}
+		for {
+			arg, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			call.args = append(call.args, arg)
+			if p.peek().kind == tokenComma {
+				p.next()
+				continue
+			}
+			if err = p.expect(tokenRightParen, ")"); err != nil {
+				return nil, err
+			}
+			return call, nil
+		}
+	case tokenEOF:
+		return nil, errors.New("unexpected end of expression")
+	}
+	return nil, errors.Errorf("unexpected '%s' at %d", t.value, t.pos)
+}
+
+type node interface {
+	children() []node
+	fmt.Stringer
+}
+
+type literalNode struct {
+	value interface{}
+}
+
+func (n *literalNode) children() []node { return nil }
+
+func (n *literalNode) String() string {
+	if s, ok := n.value.(string); ok {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprintf("%v", n.value)
+}
+
+type identNode struct {
+	name string
+}
+
+func (n *identNode) children() []node { return nil }
+
+func (n *identNode) String() string { return n.name }
+
+type propertyNode struct {
+	target   node
+	property node
+}
+
+func (n *propertyNode) children() []node { return []node{n.target, n.property} }
+
+func (n *propertyNode) String() string {
+	return n.target.String() + "[" + n.property.String() + "]"
+}
+
+type callNode struct {
+	name string
+	args []node
+}
+
+func (n *callNode) children() []node { return n.args }
+
+func (n *callNode) String() string {
+	args := make([]string, 0, len(n.args))
+	for _, arg := range n.args {
+		args = append(args, arg.String())
+	}
+	return n.name + "(" + strings.Join(args, ", ") + ")"
+}
+
+type notNode struct {
+	operand node
+}
+
+func (n *notNode) children() []node { return []node{n.operand} }
+
+func (n *notNode) String() string { return "!" + n.operand.String() }
+
+type binaryNode struct {
+	op    string
+	left  node
+	right node
+}
+
+func (n *binaryNode) children() []node { return []node{n.left, n.right} }
+
+func (n *binaryNode) String() string {
+	return "(" + n.left.String() + " " + n.op + " " + n.right.String() + ")"
+}
+
+func walk(n node, fn func(n node)) {
+	fn(n)
+	for _, child := range n.children() {
+		walk(child, fn)
+	}
+}
@@ -0,0 +1,64 @@
+package lib
+
+import (
+	"encoding/base64"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// MaskedValue is the replacement of sensitive values.
+const MaskedValue = "***"
+
+// Masker replaces sensitive values in text with MaskedValue.
+// Besides the values, their base64 and URL encoded variants are replaced too. A multi-line value is masked line by
+// line, because text is usually masked in lines.
+// A nil Masker masks nothing.
+type Masker struct {
+	replacer *strings.Replacer
+}
+
+// NewMasker creates a Masker for values. Empty values are ignored. It returns nil if there is nothing to mask.
+func NewMasker(values []string) *Masker {
+	var patterns []string
+	for _, value := range values {
+		for _, line := range strings.FieldsFunc(value, func(r rune) bool { return r == '\n' || r == '\r' }) {
+			patterns = append(patterns, maskVariants(line)...)
+		}
+	}
+	patterns = lo.Uniq(lo.Compact(patterns))
+	if len(patterns) == 0 {
+		return nil
+	}
+	// Longer patterns come first, so a value is replaced as a whole rather than by a shorter value it contains.
+	sort.SliceStable(patterns, func(i, j int) bool {
+		return len(patterns[i]) > len(patterns[j])
+	})
+	oldNew := make([]string, 0, len(patterns)*2)
+	for _, pattern := range patterns {
+		oldNew = append(oldNew, pattern, MaskedValue)
+	}
+	return &Masker{replacer: strings.NewReplacer(oldNew...)}
+}
+
+func maskVariants(value string) []string {
+	return []string{
+		value,
+		base64.StdEncoding.EncodeToString([]byte(value)),
+		base64.RawStdEncoding.EncodeToString([]byte(value)),
+		base64.URLEncoding.EncodeToString([]byte(value)),
+		base64.RawURLEncoding.EncodeToString([]byte(value)),
+		url.QueryEscape(value),
+		url.PathEscape(value),
+	}
+}
+
+// Mask returns s with sensitive values replaced.
+func (m *Masker) Mask(s string) string {
+	if m == nil {
+		return s
+	}
+	return m.replacer.Replace(s)
+}
@@ -0,0 +1,26 @@
+package lib
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestMasker(t *testing.T) {
+	masker := NewMasker([]string{"p@ss word", "", "line1\nline2", "p@ss"})
+	for input, want := range map[string]string{
+		"password is p@ss word": "password is ***",
+		"short p@ss":            "short ***",
+		"query p%40ss+word":     "query ***",
+		"path p@ss%20word":      "path ***",
+		"key line1 and line2":   "key *** and ***",
+		"basic " + base64.StdEncoding.EncodeToString([]byte("p@ss word")): "basic ***",
+		"nothing": "nothing",
+	} {
+		assert.Equal(t, masker.Mask(input), want, input)
+	}
+	assert.Assert(t, NewMasker([]string{""}) == nil)
+	var nilMasker *Masker
+	assert.Equal(t, nilMasker.Mask("p@ss"), "p@ss")
+}
@@ -30,8 +30,13 @@ func NewMockDBConn(t *testing.T) *gorm.DB {
 		},
 	)
 	assert.NilError(t, err)
-	err = migrateModels(conn, &db.Pipeline{}, &db.Job{}, &db.JobExecution{}, &db.Step{}, &db.StepExecution{},
-		&db.JobQueue{})
+	sqlDB, err := conn.DB()
+	assert.NilError(t, err)
+	// Each connection opens a new database in memory, so concurrent queries must share the only connection.
+	sqlDB.SetMaxOpenConns(1)
+	err = migrateModels(conn, &db.Pipeline{}, &db.PipelineExecution{}, &db.Job{}, &db.JobExecution{}, &db.Step{}, &db.StepExecution{},
+		&db.JobQueue{}, &db.JobQueueLabel{}, &db.Artifact{}, &db.Cache{}, &db.CacheLimit{}, &db.Agent{}, &db.Secret{},
+		&db.Schedule{})
 	assert.NilError(t, err)
 	return conn
 }
@@ -9,6 +9,6 @@ import (
 
 func TestNewMockDB(t *testing.T) {
 	db := NewMockDB(t)
-	_, err := db.CreatePipeline(context.Background())
+	_, err := db.CreatePipeline(context.Background(), "")
 	assert.NilError(t, err)
 }
@@ -1,6 +1,7 @@
 CREATE TABLE `pipeline`
 (
     `id`         bigint AUTO_INCREMENT,
+    `project`    longtext,
     `created_at` datetime(3) NULL,
     `updated_at` datetime(3) NULL,
     PRIMARY KEY (`id`)
@@ -17,28 +18,38 @@ CREATE TABLE `pipeline_execution`
 );
 CREATE TABLE `job`
 (
-    `id`                bigint AUTO_INCREMENT,
-    `pipeline_id`       bigint,
-    `name`              longtext,
-    `runs_on`           longblob,
-    `working_directory` longtext,
-    `env_var`           longblob,
-    `depends_on`        longblob,
-    `timeout`           int,
-    `created_at`        datetime(3) NULL,
-    `updated_at`        datetime(3) NULL,
+    `id`                    bigint AUTO_INCREMENT,
+    `pipeline_id`           bigint,
+    `name`                  longtext,
+    `runs_on`               longblob,
+    `working_directory`     longtext,
+    `env_var`               longblob,
+    `secrets`               longblob,
+    `depends_on`            longblob,
+    `timeout`               int,
+    `max_step_parallelism`  int,
+    `artifacts`             longblob,
+    `download_artifacts`    longblob,
+    `cache`                 longblob,
+    `keep_alive_on_failure` int,
+    `matrix`                longblob,
+    `created_at`            datetime(3) NULL,
+    `updated_at`            datetime(3) NULL,
     PRIMARY KEY (`id`)
 );
 CREATE TABLE `job_execution`
 (
-    `id`           bigint AUTO_INCREMENT,
-    `job_id`       bigint,
-    `status`       int,
-    `reason`       longblob,
-    `started_at`   datetime(3) NULL,
-    `completed_at` datetime(3) NULL,
-    `created_at`   datetime(3) NULL,
-    `updated_at`   datetime(3) NULL,
+    `id`                 bigint AUTO_INCREMENT,
+    `job_id`             bigint,
+    `status`             int,
+    `reason`             longblob,
+    `agent_id`           bigint,
+    `outputs`            longblob,
+    `dependency_outputs` longblob,
+    `started_at`         datetime(3) NULL,
+    `completed_at`       datetime(3) NULL,
+    `created_at`         datetime(3) NULL,
+    `updated_at`         datetime(3) NULL,
     PRIMARY KEY (`id`)
 );
 CREATE TABLE `step`
@@ -53,6 +64,11 @@ CREATE TABLE `step`
     `commands`          longblob,
     `env_var`           longblob,
     `depends_on`        longblob,
+    `script`            longtext,
+    `if_expression`     longtext,
+    `timeout`           int,
+    `retry`             longblob,
+    `artifacts`         longblob,
     `created_at`        datetime(3) NULL,
     `updated_at`        datetime(3) NULL,
     PRIMARY KEY (`id`)
@@ -64,6 +80,8 @@ CREATE TABLE `step_execution`
     `step_id`          bigint,
     `status`           int,
     `exit_code`        int unsigned,
+    `reason`           longblob,
+    `attempt`          int,
     `started_at`       datetime(3) NULL,
     `completed_at`     datetime(3) NULL,
     `created_at`       datetime(3) NULL,
@@ -76,8 +94,98 @@ CREATE TABLE `job_queue`
     `status`           int,
     `job_execution_id` bigint,
     `label`            longtext,
+    `agent_id`         bigint,
     `heartbeat`        datetime(3) NULL,
     `created_at`       datetime(3) NULL,
     `updated_at`       datetime(3) NULL,
     PRIMARY KEY (`id`)
-);
\ No newline at end of file
+);
+CREATE UNIQUE INDEX `idx_job_queue_job_execution_id` ON `job_queue` (`job_execution_id`);
+CREATE TABLE `job_queue_label`
+(
+    `id`               bigint AUTO_INCREMENT,
+    `job_execution_id` bigint,
+    `label`            longtext,
+    `excluded`         boolean,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_job_queue_label_job_execution_id` ON `job_queue_label` (`job_execution_id`);
+CREATE TABLE `artifact`
+(
+    `id`               bigint AUTO_INCREMENT,
+    `pipeline_id`      bigint,
+    `job_id`           bigint,
+    `job_execution_id` bigint,
+    `name`             longtext,
+    `size`             bigint,
+    `expire_at`        datetime(3) NULL,
+    `created_at`       datetime(3) NULL,
+    `updated_at`       datetime(3) NULL,
+    PRIMARY KEY (`id`)
+);
+CREATE TABLE `cache`
+(
+    `id`           bigint AUTO_INCREMENT,
+    `label`        longtext,
+    `cache_key`    longtext,
+    `object`       longtext,
+    `size`         bigint,
+    `last_used_at` datetime(3) NULL,
+    `created_at`   datetime(3) NULL,
+    `updated_at`   datetime(3) NULL,
+    PRIMARY KEY (`id`)
+);
+CREATE TABLE `cache_limit`
+(
+    `id`         bigint AUTO_INCREMENT,
+    `label`      longtext,
+    `max_size`   bigint,
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`)
+);
+CREATE TABLE `agent`
+(
+    `id`                        bigint AUTO_INCREMENT,
+    `name`                      varchar(191),
+    `hostname`                  longtext,
+    `version`                   longtext,
+    `engine`                    longtext,
+    `labels`                    longblob,
+    `concurrency`               int,
+    `running_job_execution_ids` longblob,
+    `last_heartbeat_at`         datetime(3) NULL,
+    `created_at`                datetime(3) NULL,
+    `updated_at`                datetime(3) NULL,
+    PRIMARY KEY (`id`)
+);
+CREATE UNIQUE INDEX `idx_agent_name` ON `agent` (`name`);
+CREATE TABLE `secret`
+(
+    `id`         bigint AUTO_INCREMENT,
+    `name`       varchar(191),
+    `project`    varchar(191),
+    `label`      varchar(191),
+    `value`      longblob,
+    `created_at` datetime(3) NULL,
+    `updated_at` datetime(3) NULL,
+    PRIMARY KEY (`id`)
+);
+CREATE UNIQUE INDEX `idx_secret_scope` ON `secret` (`name`, `project`, `label`);
+CREATE TABLE `schedule`
+(
+    `id`               bigint AUTO_INCREMENT,
+    `name`             longtext,
+    `cron`             longtext,
+    `timezone`         longtext,
+    `pipeline`         longblob,
+    `enabled`          boolean,
+    `catch_up_policy`  int,
+    `next_run_at`      datetime(3) NULL,
+    `last_run_at`      datetime(3) NULL,
+    `last_pipeline_id` bigint,
+    `created_at`       datetime(3) NULL,
+    `updated_at`       datetime(3) NULL,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_schedule_next_run_at` ON `schedule` (`next_run_at`);
\ No newline at end of file
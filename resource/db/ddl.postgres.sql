@@ -1,6 +1,7 @@
 CREATE TABLE "pipeline"
 (
     "id"         bigserial,
+    "project"    text,
     "created_at" timestamptz,
     "updated_at" timestamptz,
     PRIMARY KEY ("id")
@@ -17,28 +18,38 @@ CREATE TABLE "pipeline_execution"
 );
 CREATE TABLE "job"
 (
-    "id"                bigserial,
-    "pipeline_id"       bigint,
-    "name"              text,
-    "runs_on"           bytea,
-    "working_directory" text,
-    "env_var"           bytea,
-    "depends_on"        bytea,
-    "timeout"           integer,
-    "created_at"        timestamptz,
-    "updated_at"        timestamptz,
+    "id"                    bigserial,
+    "pipeline_id"           bigint,
+    "name"                  text,
+    "runs_on"               bytea,
+    "working_directory"     text,
+    "env_var"               bytea,
+    "secrets"               bytea,
+    "depends_on"            bytea,
+    "timeout"               integer,
+    "max_step_parallelism"  integer,
+    "artifacts"             bytea,
+    "download_artifacts"    bytea,
+    "cache"                 bytea,
+    "keep_alive_on_failure" integer,
+    "matrix"                bytea,
+    "created_at"            timestamptz,
+    "updated_at"            timestamptz,
     PRIMARY KEY ("id")
 );
 CREATE TABLE "job_execution"
 (
-    "id"           bigserial,
-    "job_id"       bigint,
-    "status"       integer,
-    "reason"       bytea,
-    "started_at"   timestamptz,
-    "completed_at" timestamptz,
-    "created_at"   timestamptz,
-    "updated_at"   timestamptz,
+    "id"                 bigserial,
+    "job_id"             bigint,
+    "status"             integer,
+    "reason"             bytea,
+    "agent_id"           bigint,
+    "outputs"            bytea,
+    "dependency_outputs" bytea,
+    "started_at"         timestamptz,
+    "completed_at"       timestamptz,
+    "created_at"         timestamptz,
+    "updated_at"         timestamptz,
     PRIMARY KEY ("id")
 );
 CREATE TABLE "step"
@@ -53,6 +64,11 @@ CREATE TABLE "step"
     "commands"          bytea,
     "env_var"           bytea,
     "depends_on"        bytea,
+    "script"            text,
+    "if_expression"     text,
+    "timeout"           integer,
+    "retry"             bytea,
+    "artifacts"         bytea,
     "created_at"        timestamptz,
     "updated_at"        timestamptz,
     PRIMARY KEY ("id")
@@ -64,6 +80,8 @@ CREATE TABLE "step_execution"
     "step_id"          bigint,
     "status"           integer,
     "exit_code"        bigint,
+    "reason"           bytea,
+    "attempt"          integer,
     "started_at"       timestamptz,
     "completed_at"     timestamptz,
     "created_at"       timestamptz,
@@ -76,8 +94,98 @@ CREATE TABLE "job_queue"
     "status"           integer,
     "job_execution_id" bigint,
     "label"            text,
+    "agent_id"         bigint,
     "heartbeat"        timestamptz,
     "created_at"       timestamptz,
     "updated_at"       timestamptz,
     PRIMARY KEY ("id")
-)
\ No newline at end of file
+);
+CREATE UNIQUE INDEX "idx_job_queue_job_execution_id" ON "job_queue" ("job_execution_id");
+CREATE TABLE "job_queue_label"
+(
+    "id"               bigserial,
+    "job_execution_id" bigint,
+    "label"            text,
+    "excluded"         boolean,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_job_queue_label_job_execution_id" ON "job_queue_label" ("job_execution_id");
+CREATE TABLE "artifact"
+(
+    "id"               bigserial,
+    "pipeline_id"      bigint,
+    "job_id"           bigint,
+    "job_execution_id" bigint,
+    "name"             text,
+    "size"             bigint,
+    "expire_at"        timestamptz,
+    "created_at"       timestamptz,
+    "updated_at"       timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE TABLE "cache"
+(
+    "id"           bigserial,
+    "label"        text,
+    "cache_key"    text,
+    "object"       text,
+    "size"         bigint,
+    "last_used_at" timestamptz,
+    "created_at"   timestamptz,
+    "updated_at"   timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE TABLE "cache_limit"
+(
+    "id"         bigserial,
+    "label"      text,
+    "max_size"   bigint,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE TABLE "agent"
+(
+    "id"                        bigserial,
+    "name"                      text,
+    "hostname"                  text,
+    "version"                   text,
+    "engine"                    text,
+    "labels"                    bytea,
+    "concurrency"               integer,
+    "running_job_execution_ids" bytea,
+    "last_heartbeat_at"         timestamptz,
+    "created_at"                timestamptz,
+    "updated_at"                timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_agent_name" ON "agent" ("name");
+CREATE TABLE "secret"
+(
+    "id"         bigserial,
+    "name"       text,
+    "project"    text,
+    "label"      text,
+    "value"      bytea,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_secret_scope" ON "secret" ("name", "project", "label");
+CREATE TABLE "schedule"
+(
+    "id"               bigserial,
+    "name"             text,
+    "cron"             text,
+    "timezone"         text,
+    "pipeline"         bytea,
+    "enabled"          boolean,
+    "catch_up_policy"  integer,
+    "next_run_at"      timestamptz,
+    "last_run_at"      timestamptz,
+    "last_pipeline_id" bigint,
+    "created_at"       timestamptz,
+    "updated_at"       timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_schedule_next_run_at" ON "schedule" ("next_run_at");
\ No newline at end of file
@@ -1,6 +1,7 @@
 CREATE TABLE `pipeline`
 (
     `id`         integer,
+    `project`    text,
     `created_at` datetime,
     `updated_at` datetime,
     PRIMARY KEY (`id`)
@@ -17,28 +18,38 @@ CREATE TABLE `pipeline_execution`
 );
 CREATE TABLE `job`
 (
-    `id`                integer,
-    `pipeline_id`       integer,
-    `name`              text,
-    `runs_on`           blob,
-    `working_directory` text,
-    `env_var`           blob,
-    `depends_on`        blob,
-    `timeout`           integer,
-    `created_at`        datetime,
-    `updated_at`        datetime,
+    `id`                    integer,
+    `pipeline_id`           integer,
+    `name`                  text,
+    `runs_on`               blob,
+    `working_directory`     text,
+    `env_var`               blob,
+    `secrets`               blob,
+    `depends_on`            blob,
+    `timeout`               integer,
+    `max_step_parallelism`  integer,
+    `artifacts`             blob,
+    `download_artifacts`    blob,
+    `cache`                 blob,
+    `keep_alive_on_failure` integer,
+    `matrix`                blob,
+    `created_at`            datetime,
+    `updated_at`            datetime,
     PRIMARY KEY (`id`)
 );
 CREATE TABLE `job_execution`
 (
-    `id`           integer,
-    `job_id`       integer,
-    `status`       integer,
-    `reason`       blob,
-    `started_at`   datetime,
-    `completed_at` datetime,
-    `created_at`   datetime,
-    `updated_at`   datetime,
+    `id`                 integer,
+    `job_id`             integer,
+    `status`             integer,
+    `reason`             blob,
+    `agent_id`           integer,
+    `outputs`            blob,
+    `dependency_outputs` blob,
+    `started_at`         datetime,
+    `completed_at`       datetime,
+    `created_at`         datetime,
+    `updated_at`         datetime,
     PRIMARY KEY (`id`)
 );
 CREATE TABLE `step`
@@ -53,6 +64,11 @@ CREATE TABLE `step`
     `commands`          blob,
     `env_var`           blob,
     `depends_on`        blob,
+    `script`            text,
+    `if_expression`     text,
+    `timeout`           integer,
+    `retry`             blob,
+    `artifacts`         blob,
     `created_at`        datetime,
     `updated_at`        datetime,
     PRIMARY KEY (`id`)
@@ -64,6 +80,8 @@ CREATE TABLE `step_execution`
     `step_id`          integer,
     `status`           integer,
     `exit_code`        integer,
+    `reason`           blob,
+    `attempt`          integer,
     `started_at`       datetime,
     `completed_at`     datetime,
     `created_at`       datetime,
@@ -76,8 +94,98 @@ CREATE TABLE `job_queue`
     `status`           integer,
     `job_execution_id` integer,
     `label`            text,
+    `agent_id`         integer,
     `heartbeat`        datetime,
     `created_at`       datetime,
     `updated_at`       datetime,
     PRIMARY KEY (`id`)
-)
\ No newline at end of file
+);
+CREATE UNIQUE INDEX `idx_job_queue_job_execution_id` ON `job_queue` (`job_execution_id`);
+CREATE TABLE `job_queue_label`
+(
+    `id`               integer,
+    `job_execution_id` integer,
+    `label`            text,
+    `excluded`         numeric,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_job_queue_label_job_execution_id` ON `job_queue_label` (`job_execution_id`);
+CREATE TABLE `artifact`
+(
+    `id`               integer,
+    `pipeline_id`      integer,
+    `job_id`           integer,
+    `job_execution_id` integer,
+    `name`             text,
+    `size`             integer,
+    `expire_at`        datetime,
+    `created_at`       datetime,
+    `updated_at`       datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE TABLE `cache`
+(
+    `id`           integer,
+    `label`        text,
+    `cache_key`    text,
+    `object`       text,
+    `size`         integer,
+    `last_used_at` datetime,
+    `created_at`   datetime,
+    `updated_at`   datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE TABLE `cache_limit`
+(
+    `id`         integer,
+    `label`      text,
+    `max_size`   integer,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE TABLE `agent`
+(
+    `id`                        integer,
+    `name`                      text,
+    `hostname`                  text,
+    `version`                   text,
+    `engine`                    text,
+    `labels`                    blob,
+    `concurrency`               integer,
+    `running_job_execution_ids` blob,
+    `last_heartbeat_at`         datetime,
+    `created_at`                datetime,
+    `updated_at`                datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE UNIQUE INDEX `idx_agent_name` ON `agent` (`name`);
+CREATE TABLE `secret`
+(
+    `id`         integer,
+    `name`       text,
+    `project`    text,
+    `label`      text,
+    `value`      blob,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE UNIQUE INDEX `idx_secret_scope` ON `secret` (`name`, `project`, `label`);
+CREATE TABLE `schedule`
+(
+    `id`               integer,
+    `name`             text,
+    `cron`             text,
+    `timezone`         text,
+    `pipeline`         blob,
+    `enabled`          numeric,
+    `catch_up_policy`  integer,
+    `next_run_at`      datetime,
+    `last_run_at`      datetime,
+    `last_pipeline_id` integer,
+    `created_at`       datetime,
+    `updated_at`       datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX `idx_schedule_next_run_at` ON `schedule` (`next_run_at`);
\ No newline at end of file